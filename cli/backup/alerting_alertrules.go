@@ -2,7 +2,7 @@ package backup
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"log/slog"
 
 	"github.com/bep/simplecobra"
@@ -18,9 +18,9 @@ import (
 
 var ignoreAlertRuleFilters bool
 
-func getAlertRulesFilter(cfg *domain.GDGAppConfiguration, grafanaService service.GrafanaService) filters.V2Filter {
+func getAlertRulesFilter(cfg *domain.GDGAppConfiguration, grafanaService service.GrafanaService) (filters.V2Filter, error) {
 	if ignoreAlertRuleFilters {
-		return nil
+		return nil, nil
 	}
 	return service.NewAlertRuleFilter(cfg, grafanaService)
 }
@@ -62,9 +62,12 @@ func newUploadAlertRulesCmd() simplecobra.Commander {
 				slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())),
 				slog.String("context", rootCmd.ConfigSvc().GetContext()))
 
-			err := rootCmd.GrafanaSvc().UploadAlertRules(getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc()))
+			filter, err := getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc())
 			if err != nil {
-				log.Fatal("unable to upload Orgs rule alerts", slog.Any("err", err))
+				return err
+			}
+			if err = rootCmd.GrafanaSvc().UploadAlertRules(filter); err != nil {
+				return fmt.Errorf("unable to upload Orgs rule alerts: %w", err)
 			}
 			slog.Info("Rules have been successfully uploaded to grafana")
 			return nil
@@ -86,9 +89,13 @@ func newClearAlertRulesCmd() simplecobra.Commander {
 				slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())),
 				slog.String("context", rootCmd.ConfigSvc().GetContext()))
 
-			files, err := rootCmd.GrafanaSvc().ClearAlertRules(getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc()))
+			filter, err := getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc())
+			if err != nil {
+				return err
+			}
+			files, err := rootCmd.GrafanaSvc().ClearAlertRules(filter)
 			if err != nil {
-				log.Fatal("unable to deleting Orgs rule alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to deleting Orgs rule alerts: %w", err)
 			}
 			if len(files) > 0 {
 				rootCmd.TableObj.AppendHeader(table.Row{"title"})
@@ -120,9 +127,13 @@ func newListAlertRulesCmd() simplecobra.Commander {
 				slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())),
 				slog.String("context", rootCmd.ConfigSvc().GetContext()))
 
-			rules, err := rootCmd.GrafanaSvc().ListAlertRules(getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc()))
+			filter, err := getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc())
 			if err != nil {
-				log.Fatal("unable to retrieve Orgs rule alerts", slog.Any("err", err))
+				return err
+			}
+			rules, err := rootCmd.GrafanaSvc().ListAlertRules(filter)
+			if err != nil {
+				return fmt.Errorf("unable to retrieve Orgs rule alerts: %w", err)
 			}
 			if len(rules) == 0 {
 				slog.Info("No alert rules found")
@@ -158,9 +169,13 @@ func newDownloadAlertRulesCmd() simplecobra.Commander {
 				slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())),
 				slog.String("context", rootCmd.ConfigSvc().GetContext()))
 
-			files, err := rootCmd.GrafanaSvc().DownloadAlertRules(getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc()))
+			filter, err := getAlertRulesFilter(rootCmd.ConfigSvc(), rootCmd.GrafanaSvc())
+			if err != nil {
+				return err
+			}
+			files, err := rootCmd.GrafanaSvc().DownloadAlertRules(filter)
 			if err != nil {
-				log.Fatal("unable to retrieve Orgs rule alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to retrieve Orgs rule alerts: %w", err)
 			}
 			if err != nil {
 				slog.Error("unable to download alert rules")
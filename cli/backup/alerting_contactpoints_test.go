@@ -32,7 +32,7 @@ func TestUploadContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "ERR unable to upload contact points err=\"Unable to download data data\""))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				testSvc.EXPECT().UploadContactPoints().Return(nil, fmt.Errorf("Unable to download data data"))
 			},
 		},
@@ -46,7 +46,7 @@ func TestUploadContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "slack"))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				testSvc.EXPECT().UploadContactPoints().Return([]string{"discord", "slack"}, nil)
 			},
 		},
@@ -98,7 +98,7 @@ func TestDownloadContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "ERR unable to download contact points"))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				testSvc.EXPECT().DownloadContactPoints().Return("", fmt.Errorf("Unable to download data data"))
 			},
 		},
@@ -109,7 +109,7 @@ func TestDownloadContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "INF contact points successfully downloaded file=fileName"))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				testSvc.EXPECT().DownloadContactPoints().Return("fileName", nil)
 			},
 		},
@@ -161,7 +161,7 @@ func TestListContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "No contact points found"))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				testSvc.EXPECT().ListContactPoints().Return(nil, nil)
 			},
 		},
@@ -178,7 +178,7 @@ func TestListContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "slackType"))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				resp := []*models.EmbeddedContactPoint{
 					{
 						UID:      "discordUid",
@@ -244,7 +244,7 @@ func TestClearContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "ERR unable to clear Contact Points"))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				testSvc.EXPECT().ClearContactPoints().Return(nil, fmt.Errorf("Errror!!!"))
 			},
 		},
@@ -255,7 +255,7 @@ func TestClearContactPoints(t *testing.T) {
 				assert.True(t, strings.Contains(output, "Contact Points successfully removed"))
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 				testSvc.EXPECT().ClearContactPoints().Return(nil, nil)
 			},
 		},
@@ -267,7 +267,7 @@ func TestClearContactPoints(t *testing.T) {
 			},
 			setupMocks: func(testSvc *mocks.GrafanaService) {
 				testSvc.EXPECT().ClearContactPoints().Return([]string{"discord", "slack"}, nil)
-				testSvc.EXPECT().InitOrganizations().Return()
+				testSvc.EXPECT().InitOrganizations().Return(nil)
 			},
 		},
 	}
@@ -3,7 +3,7 @@ package backup
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"fmt"
 	"log/slog"
 
 	"github.com/bep/simplecobra"
@@ -56,7 +56,7 @@ func newListContactPointsCmd() simplecobra.Commander {
 
 			logWarning()
 			if err != nil {
-				log.Fatal("unable to retrieve Orgs contact points", slog.Any("err", err))
+				return fmt.Errorf("unable to retrieve Orgs contact points: %w", err)
 			}
 			if len(contactPoints) == 0 {
 				slog.Info("No contact points found")
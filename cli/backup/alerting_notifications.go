@@ -2,7 +2,7 @@ package backup
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"log/slog"
 
 	"github.com/bep/simplecobra"
@@ -49,7 +49,7 @@ func newUploadAlertNotificationCmd() simplecobra.Commander {
 
 			files, err := rootCmd.GrafanaSvc().UploadAlertNotifications()
 			if err != nil {
-				log.Fatal("unable to upload Orgs notification policies alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to upload Orgs notification policies alerts: %w", err)
 			}
 			rootCmd.TableObj.AppendHeader(table.Row{"receiver", "matchers"})
 			for _, link := range files.Routes {
@@ -77,7 +77,7 @@ func newClearAlertNotificationCmd() simplecobra.Commander {
 
 			err := rootCmd.GrafanaSvc().ClearAlertNotifications()
 			if err != nil {
-				log.Fatal("unable to deleting Orgs notification policies alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to deleting Orgs notification policies alerts: %w", err)
 			}
 
 			slog.Info("All notifications policies have been cleared")
@@ -103,7 +103,7 @@ func newListAlertNotificationCmd() simplecobra.Commander {
 
 			data, err := rootCmd.GrafanaSvc().ListAlertNotifications()
 			if err != nil {
-				log.Fatal("unable to retrieve Orgs notification policies", slog.Any("err", err))
+				return fmt.Errorf("unable to retrieve Orgs notification policies: %w", err)
 			}
 			if len(data.Routes) == 0 {
 				slog.Info("No alert notifications policies found")
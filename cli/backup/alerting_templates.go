@@ -2,7 +2,7 @@ package backup
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"log/slog"
 
 	"github.com/bep/simplecobra"
@@ -49,7 +49,7 @@ func newUploadAlertTemplatesCmd() simplecobra.Commander {
 
 			files, err := rootCmd.GrafanaSvc().UploadAlertTemplates()
 			if err != nil {
-				log.Fatal("unable to upload Orgs templates alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to upload Orgs templates alerts: %w", err)
 			}
 			rootCmd.TableObj.AppendHeader(table.Row{"title"})
 			for _, link := range files {
@@ -77,7 +77,7 @@ func newClearAlertTemplatesCmd() simplecobra.Commander {
 
 			files, err := rootCmd.GrafanaSvc().ClearAlertTemplates()
 			if err != nil {
-				log.Fatal("unable to deleting Orgs templates alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to deleting Orgs templates alerts: %w", err)
 			}
 			rootCmd.TableObj.AppendHeader(table.Row{"name"})
 			for _, link := range files {
@@ -106,7 +106,7 @@ func newListAlertTemplatesCmd() simplecobra.Commander {
 
 			rules, err := rootCmd.GrafanaSvc().ListAlertTemplates()
 			if err != nil {
-				log.Fatal("unable to retrieve Orgs rule alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to retrieve Orgs rule alerts: %w", err)
 			}
 			if len(rules) == 0 {
 				slog.Info("No alert rules found")
@@ -141,7 +141,7 @@ func newDownloadAlertTemplatesCmd() simplecobra.Commander {
 
 			file, err := rootCmd.GrafanaSvc().DownloadAlertTemplates()
 			if err != nil {
-				log.Fatal("unable to retrieve Orgs templates alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to retrieve Orgs templates alerts: %w", err)
 			}
 			if err != nil {
 				slog.Error("unable to download alert templates")
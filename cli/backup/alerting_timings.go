@@ -3,7 +3,7 @@ package backup
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"fmt"
 	"log/slog"
 	"os"
 
@@ -52,7 +52,7 @@ func newListTimingsCmd() simplecobra.Commander {
 
 			timingsList, err := rootCmd.GrafanaSvc().ListAlertTimings()
 			if err != nil {
-				log.Fatal("unable to list Orgs mute timings ", slog.Any("err", err))
+				return fmt.Errorf("unable to list Orgs mute timings: %w", err)
 			}
 			if len(timingsList) == 0 {
 				slog.Info("No mute timings found")
@@ -152,7 +152,7 @@ func newUploadTimingsCmd() simplecobra.Commander {
 
 			files, err := rootCmd.GrafanaSvc().UploadAlertTimings()
 			if err != nil {
-				log.Fatal("unable to upload Orgs alerts timings", slog.Any("err", err))
+				return fmt.Errorf("unable to upload Orgs alerts timings: %w", err)
 			}
 			for _, link := range files {
 				rootCmd.TableObj.AppendRow(table.Row{link})
@@ -176,7 +176,7 @@ func newClearTimingsCmd() simplecobra.Commander {
 			slog.Info("Delete all alert timings")
 			err := rootCmd.GrafanaSvc().ClearAlertTimings()
 			if err != nil {
-				log.Fatal("unable to deleting Orgs templates alerts", slog.Any("err", err))
+				return fmt.Errorf("unable to deleting Orgs templates alerts: %w", err)
 			} else {
 				slog.Info("alert timings successfully cleared")
 			}
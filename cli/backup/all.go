@@ -0,0 +1,542 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/internal/tools"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+const (
+	allSkipFlag    = "skip"
+	allIncludeFlag = "include"
+
+	allRunSkipped = "skipped"
+
+	allActionDownload = "download"
+	allActionUpload   = "upload"
+	allActionClear    = "clear"
+)
+
+// allAction runs a single action for a resource and returns the number of entries processed
+type allAction func(rootCmd *support.RootCommand, filter filters.V2Filter) (int, error)
+
+// allResource a resource managed by `backup all`, actions left unset aren't supported by the resource
+type allResource struct {
+	name       string
+	group      string
+	orgScoped  bool
+	enterprise bool
+	// filter builds the filter passed to every action, no filter is used when nil
+	filter   func(rootCmd *support.RootCommand) (filters.V2Filter, error)
+	download allAction
+	upload   allAction
+	clear    allAction
+}
+
+// allRunResult summarizes the outcome of an action for a single resource
+type allRunResult struct {
+	Organization string `json:"organization,omitempty"`
+	Resource     string `json:"resource"`
+	Status       string `json:"status"`
+	Entries      int    `json:"entries"`
+	Error        string `json:"error,omitempty"`
+}
+
+func countOf[T any](items []T, err error) (int, error) {
+	return len(items), err
+}
+
+func countFile(file string, err error) (int, error) {
+	if file == "" {
+		return 0, err
+	}
+	return 1, err
+}
+
+func dashboardFilter(r *support.RootCommand) (filters.V2Filter, error) {
+	return service.NewDashboardFilter(r.ConfigSvc(), "", "", "")
+}
+
+func connectionFilter(r *support.RootCommand) (filters.V2Filter, error) {
+	return service.NewConnectionFilter("")
+}
+
+// getAllResources returns every resource in the order they need to be restored in, entities are always created before
+// the entities referring to them.  Clearing happens in the reverse order.
+func getAllResources() []allResource {
+	return []allResource{
+		{
+			name: "organizations",
+			filter: func(r *support.RootCommand) (filters.V2Filter, error) {
+				return service.NewOrganizationFilter("")
+			},
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadOrganizations(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadOrganizations(filter, nil))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllOrganizations(filter))
+			},
+		},
+		{
+			name: "users",
+			filter: func(r *support.RootCommand) (filters.V2Filter, error) {
+				return service.NewUserFilter("")
+			},
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadUsers(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadUsers(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllUsers(filter))
+			},
+		},
+		{
+			name: "sso",
+			download: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadSSOSettings())
+			},
+			upload: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadSSOSettings())
+			},
+		},
+		{
+			name:      "teams",
+			orgScoped: true,
+			filter: func(r *support.RootCommand) (filters.V2Filter, error) {
+				return service.NewTeamFilter("")
+			},
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				teams, err := r.GrafanaSvc().DownloadTeams(filter)
+				return len(teams), err
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				teams, err := r.GrafanaSvc().UploadTeams(filter)
+				return len(teams), err
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteTeam(filter))
+			},
+		},
+		{
+			name:       "roles",
+			orgScoped:  true,
+			enterprise: true,
+			filter: func(r *support.RootCommand) (filters.V2Filter, error) {
+				return service.NewRoleFilter("")
+			},
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadRoles(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadRoles(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllRoles(filter))
+			},
+		},
+		{
+			name:      "folders",
+			orgScoped: true,
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadFolders(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadFolders(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllFolders(filter))
+			},
+		},
+		{
+			name:      "connections",
+			orgScoped: true,
+			filter:    connectionFilter,
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadConnections(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadConnections(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllConnections(filter))
+			},
+		},
+		{
+			name:      "libraryelements",
+			orgScoped: true,
+			filter: func(r *support.RootCommand) (filters.V2Filter, error) {
+				return service.NewLibraryElementFilter(r.ConfigSvc())
+			},
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadLibraryElements(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadLibraryElements(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllLibraryElements(filter))
+			},
+		},
+		{
+			name:      "dashboards",
+			orgScoped: true,
+			filter:    dashboardFilter,
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadDashboards(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadDashboards(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllDashboards(filter))
+			},
+		},
+		{
+			name:      "folders-permissions",
+			group:     "permissions",
+			orgScoped: true,
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadFolderPermissions(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadFolderPermissions(filter))
+			},
+		},
+		{
+			name:       "dashboards-permissions",
+			group:      "permissions",
+			orgScoped:  true,
+			enterprise: true,
+			filter:     dashboardFilter,
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadDashboardPermissions(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadDashboardPermissions(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return 0, r.GrafanaSvc().ClearDashboardPermissions(filter)
+			},
+		},
+		{
+			name:       "connections-permissions",
+			group:      "permissions",
+			orgScoped:  true,
+			enterprise: true,
+			filter:     connectionFilter,
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadConnectionPermissions(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadConnectionPermissions(filter))
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DeleteAllConnectionPermissions(filter))
+			},
+		},
+		{
+			name:      "alerting-templates",
+			group:     "alerting",
+			orgScoped: true,
+			download: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countFile(r.GrafanaSvc().DownloadAlertTemplates())
+			},
+			upload: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadAlertTemplates())
+			},
+			clear: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().ClearAlertTemplates())
+			},
+		},
+		{
+			name:      "alerting-contactpoints",
+			group:     "alerting",
+			orgScoped: true,
+			download: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countFile(r.GrafanaSvc().DownloadContactPoints())
+			},
+			upload: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadContactPoints())
+			},
+			clear: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().ClearContactPoints())
+			},
+		},
+		{
+			name:      "alerting-mute-timings",
+			group:     "alerting",
+			orgScoped: true,
+			download: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countFile(r.GrafanaSvc().DownloadAlertTimings())
+			},
+			upload: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().UploadAlertTimings())
+			},
+			clear: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return 0, r.GrafanaSvc().ClearAlertTimings()
+			},
+		},
+		{
+			name:      "alerting-policies",
+			group:     "alerting",
+			orgScoped: true,
+			download: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return countFile(r.GrafanaSvc().DownloadAlertNotifications())
+			},
+			upload: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				route, err := r.GrafanaSvc().UploadAlertNotifications()
+				return lo.Ternary(route != nil, 1, 0), err
+			},
+			clear: func(r *support.RootCommand, _ filters.V2Filter) (int, error) {
+				return 0, r.GrafanaSvc().ClearAlertNotifications()
+			},
+		},
+		{
+			name:      "alerting-rules",
+			group:     "alerting",
+			orgScoped: true,
+			filter: func(r *support.RootCommand) (filters.V2Filter, error) {
+				return service.NewAlertRuleFilter(r.ConfigSvc(), r.GrafanaSvc())
+			},
+			download: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().DownloadAlertRules(filter))
+			},
+			upload: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return 0, r.GrafanaSvc().UploadAlertRules(filter)
+			},
+			clear: func(r *support.RootCommand, filter filters.V2Filter) (int, error) {
+				return countOf(r.GrafanaSvc().ClearAlertRules(filter))
+			},
+		},
+	}
+}
+
+// getAction returns the function implementing the given action, nil if the resource does not support it
+func (r allResource) getAction(action string) allAction {
+	switch action {
+	case allActionDownload:
+		return r.download
+	case allActionUpload:
+		return r.upload
+	case allActionClear:
+		return r.clear
+	}
+	return nil
+}
+
+// matches returns true if the resource is referenced by its name or group
+func (r allResource) matches(names []string) bool {
+	return slices.Contains(names, r.name) || (r.group != "" && slices.Contains(names, r.group))
+}
+
+// selectAllResources applies the --include and --skip flags to the list of resources
+func selectAllResources(command *cobra.Command) ([]allResource, error) {
+	resources := getAllResources()
+	include, _ := command.Flags().GetStringSlice(allIncludeFlag)
+	skip, _ := command.Flags().GetStringSlice(allSkipFlag)
+	known := lo.Uniq(lo.Compact(lo.FlatMap(resources, func(item allResource, _ int) []string {
+		return []string{item.name, item.group}
+	})))
+	for _, name := range append(slices.Clone(include), skip...) {
+		if !slices.Contains(known, name) {
+			return nil, fmt.Errorf("unknown resource '%s', valid resources are: %s", name, strings.Join(known, ", "))
+		}
+	}
+	return lo.Filter(resources, func(item allResource, _ int) bool {
+		return (len(include) == 0 || item.matches(include)) && !item.matches(skip)
+	}), nil
+}
+
+func newAllCommand() simplecobra.Commander {
+	description := "Manage every resource of a Grafana instance at once"
+	return &support.SimpleCommand{
+		NameP: "all",
+		Short: description,
+		Long: description + ".  Resources are restored in dependency order: organizations, users, sso, teams, roles, " +
+			"folders, connections, library elements, dashboards, permissions and alerting.  Clearing happens in the reverse order.",
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"everything"}
+			cmd.PersistentFlags().StringSlice(allSkipFlag, nil, "resources or groups (permissions, alerting) to skip, ie. --skip users,alerting")
+			cmd.PersistentFlags().StringSlice(allIncludeFlag, nil, "only process the given resources or groups, ie. --include folders,dashboards")
+			cmd.PersistentFlags().Bool(allOrgsFlag, false, "run the command against every organization (requires grafana admin)")
+			cmd.PersistentFlags().StringSlice(orgsFlag, nil, "run the command against the given organizations, ie. --orgs main,other")
+			cmd.PersistentFlags().BoolVarP(&skipConfirmAction, "skip-confirmation", "", false, "when set to true, bypass confirmation prompts")
+		},
+		CommandsList: []simplecobra.Commander{
+			newAllActionCmd(allActionDownload, "download every resource from grafana", []string{"d"}),
+			newAllActionCmd(allActionUpload, "upload every resource to grafana", []string{"u", "up"}),
+			newAllActionCmd(allActionClear, "delete every resource from grafana", []string{"c"}),
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			return cd.CobraCommand.Help()
+		},
+	}
+}
+
+func newAllActionCmd(action, description string, aliases []string) simplecobra.Commander {
+	return &support.SimpleCommand{
+		NameP: action,
+		Short: description,
+		Long:  description + ".  A failure for one resource is reported in the summary and does not stop the remaining ones.",
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = aliases
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			resources, err := selectAllResources(cd.CobraCommand)
+			if err != nil {
+				return err
+			}
+			if action != allActionDownload && !skipConfirmAction {
+				tools.GetUserConfirmation(fmt.Sprintf("WARNING: this will %s %s in grafana.  Do you wish to continue (y/n) ", action,
+					strings.Join(lo.Map(resources, func(item allResource, _ int) string { return item.name }), ", ")), "", true)
+			}
+			results, err := runAllResources(cd.CobraCommand, rootCmd, action, resources)
+			if err != nil {
+				return err
+			}
+			return renderAllResults(cd.CobraCommand, rootCmd, action, results)
+		},
+	}
+}
+
+// runAllResources runs the action for every resource, instance wide resources are processed once and organization
+// resources once per target organization.  Clearing runs in the reverse order.
+func runAllResources(command *cobra.Command, rootCmd *support.RootCommand, action string, resources []allResource) ([]allRunResult, error) {
+	instanceResources, orgResources := lo.FilterReject(resources, func(item allResource, _ int) bool { return !item.orgScoped })
+	isEnterprise := false
+	if lo.ContainsBy(resources, func(item allResource) bool { return item.enterprise }) {
+		var err error
+		if isEnterprise, err = rootCmd.GrafanaSvc().IsEnterprise(); err != nil {
+			return nil, err
+		}
+	}
+
+	var results []allRunResult
+	runResources := func(orgName string, items []allResource) {
+		for _, item := range items {
+			results = append(results, runAllResource(rootCmd, action, orgName, item, isEnterprise))
+		}
+	}
+	runOrgResources := func() error {
+		if len(orgResources) == 0 {
+			return nil
+		}
+		// organizations may have just been uploaded, the target organizations are resolved once they exist
+		orgNames, err := getTargetOrganizations(command, rootCmd)
+		if err != nil {
+			return err
+		}
+		if len(orgNames) == 0 {
+			runResources(GetOrganizationName(rootCmd.ConfigSvc()), orgResources)
+			return nil
+		}
+		for _, orgName := range orgNames {
+			err = inOrganization(rootCmd, orgName, func() error {
+				runResources(orgName, orgResources)
+				return nil
+			})
+			if err != nil {
+				slog.Error("unable to switch organization", "organization", orgName, "err", err)
+				for _, item := range orgResources {
+					results = append(results, allRunResult{Organization: orgName, Resource: item.name, Status: orgRunFailed, Error: err.Error()})
+				}
+			}
+		}
+		return nil
+	}
+
+	if action == allActionClear {
+		slices.Reverse(instanceResources)
+		slices.Reverse(orgResources)
+		if err := runOrgResources(); err != nil {
+			return nil, err
+		}
+		runResources("", instanceResources)
+		return results, nil
+	}
+	runResources("", instanceResources)
+	if err := runOrgResources(); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// runAllResource runs the action for a single resource, recording failures rather than aborting
+func runAllResource(rootCmd *support.RootCommand, action, orgName string, item allResource, isEnterprise bool) allRunResult {
+	result := allRunResult{Organization: orgName, Resource: item.name, Status: orgRunSuccess}
+	run := item.getAction(action)
+	switch {
+	case run == nil:
+		result.Status = allRunSkipped
+		result.Error = fmt.Sprintf("%s is not supported", action)
+		return result
+	case item.enterprise && !isEnterprise:
+		result.Status = allRunSkipped
+		result.Error = "requires Grafana Enterprise"
+		return result
+	}
+	slog.Info("Processing resource", "action", action, "resource", item.name, "organization", orgName)
+	var filter filters.V2Filter
+	var err error
+	if item.filter != nil {
+		filter, err = item.filter(rootCmd)
+	}
+	if err == nil {
+		result.Entries, err = run(rootCmd, filter)
+	}
+	switch {
+	case errors.Is(err, service.ErrAdminRequired):
+		result.Status = allRunSkipped
+		result.Error = err.Error()
+	case err != nil:
+		slog.Error("resource failed", "action", action, "resource", item.name, "organization", orgName, "err", err)
+		result.Status = orgRunFailed
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// renderAllResults prints the consolidated summary and returns an error if any resource failed
+func renderAllResults(command *cobra.Command, rootCmd *support.RootCommand, action string, results []allRunResult) error {
+	output, _ := command.Flags().GetString("output")
+	if output == "json" {
+		data, err := json.MarshalIndent(results, "", "    ")
+		if err != nil {
+			return fmt.Errorf("unable to render result to JSON: %w", err)
+		}
+		fmt.Print(string(data))
+	} else {
+		rootCmd.ResetTable(fmt.Sprintf("Summary: %s", action))
+		rootCmd.TableObj.AppendHeader(table.Row{"organization", "resource", "status", "entries", "error"})
+		for _, result := range results {
+			rootCmd.TableObj.AppendRow(table.Row{result.Organization, result.Resource, result.Status, result.Entries, result.Error})
+		}
+		rootCmd.TableObj.Render()
+	}
+	failed := lo.CountBy(results, func(item allRunResult) bool { return item.Status == orgRunFailed })
+	if failed > 0 {
+		return &support.CommandError{
+			Code: support.ExitPartialFailure,
+			Err:  fmt.Errorf("%s failed for %d of %d resources", action, failed, len(results)),
+		}
+	}
+	return nil
+}
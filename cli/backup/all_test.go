@@ -0,0 +1,98 @@
+package backup_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/esnet/gdg/cli"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/internal/service/mocks"
+	"github.com/esnet/gdg/pkg/test_tooling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAllDownloadCommand(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	testSvc.EXPECT().IsEnterprise().Return(false, nil)
+	testSvc.EXPECT().DownloadFolders(mock.Anything).Return([]string{"folders/a.json", "folders/b.json"}, nil)
+	testSvc.EXPECT().DownloadDashboards(mock.Anything).Return([]string{"dashboards/General/a.json"}, nil)
+	testSvc.EXPECT().DownloadFolderPermissions(mock.Anything).Return(nil, nil)
+
+	r, w, cleanup := test_tooling.InterceptStdout()
+	defer cleanup()
+	err := cli.Execute([]string{"backup", "all", "download", "--include", "folders,dashboards,permissions"}, GetOptionMockSvc(testSvc)())
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	out, _ := io.ReadAll(r)
+	outStr := string(out)
+	assert.True(t, strings.Contains(outStr, "Summary: download"))
+	assert.True(t, strings.Contains(outStr, "dashboards-permissions"))
+	assert.True(t, strings.Contains(outStr, "requires Grafana Enterprise"))
+	testSvc.AssertNotCalled(t, "DownloadUsers", mock.Anything)
+	testSvc.AssertNotCalled(t, "DownloadDashboardPermissions", mock.Anything)
+}
+
+func TestAllUploadContinuesOnFailure(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	var order []string
+	testSvc.EXPECT().UploadAlertRules(mock.Anything).RunAndReturn(func(_ filters.V2Filter) error {
+		order = append(order, "alerting-rules")
+		return nil
+	})
+	testSvc.EXPECT().UploadDashboards(mock.Anything).RunAndReturn(func(_ filters.V2Filter) ([]string, error) {
+		order = append(order, "dashboards")
+		return nil, errors.New("grafana is unavailable")
+	})
+
+	r, w, cleanup := test_tooling.InterceptStdout()
+	defer cleanup()
+	err := cli.Execute([]string{"backup", "all", "upload", "--include", "alerting-rules,dashboards", "--skip-confirmation",
+		"--output", "json"}, GetOptionMockSvc(testSvc)())
+	assert.ErrorContains(t, err, "upload failed for 1 of 2 resources")
+	assert.Equal(t, support.ExitPartialFailure, support.ExitCode(err))
+	assert.NoError(t, w.Close())
+
+	out, _ := io.ReadAll(r)
+	assert.True(t, strings.Contains(string(out), "grafana is unavailable"))
+	assert.Equal(t, []string{"dashboards", "alerting-rules"}, order)
+}
+
+func TestAllClearReverseOrder(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	var order []string
+	testSvc.EXPECT().DeleteAllUsers(mock.Anything).RunAndReturn(func(_ filters.V2Filter) ([]string, error) {
+		order = append(order, "users")
+		return nil, nil
+	})
+	testSvc.EXPECT().DeleteAllFolders(mock.Anything).RunAndReturn(func(_ filters.V2Filter) ([]string, error) {
+		order = append(order, "folders")
+		return nil, nil
+	})
+	testSvc.EXPECT().DeleteAllDashboards(mock.Anything).RunAndReturn(func(_ filters.V2Filter) ([]string, error) {
+		order = append(order, "dashboards")
+		return nil, nil
+	})
+
+	_, w, cleanup := test_tooling.InterceptStdout()
+	defer cleanup()
+	err := cli.Execute([]string{"backup", "all", "clear", "--include", "users,folders,dashboards", "--skip-confirmation"},
+		GetOptionMockSvc(testSvc)())
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	assert.Equal(t, []string{"dashboards", "folders", "users"}, order)
+}
+
+func TestAllUnknownResource(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	err := cli.Execute([]string{"backup", "all", "download", "--skip", "widgets"}, GetOptionMockSvc(testSvc)())
+	assert.ErrorContains(t, err, "unknown resource 'widgets'")
+}
@@ -18,24 +18,30 @@ func NewBackupCommand() simplecobra.Commander {
 limited to clear/delete, list, download and upload.  Any other functionality will be found under the tools.`,
 		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
 			cmd.Aliases = []string{"b"}
+			cmd.PersistentFlags().String("promotion", "", "promotion rules file applied to uploads, overrides the promotion_file of the context")
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			return cd.CobraCommand.Help()
 		},
 		InitCFunc: func(cd *simplecobra.Commandeer, r *support.RootCommand) error {
 			r.InitConfiguration(cd.CobraCommand)
-			r.GrafanaSvc().InitOrganizations()
-			return nil
+			if promotion, _ := cd.CobraCommand.Flags().GetString("promotion"); promotion != "" {
+				r.ConfigSvc().GetDefaultGrafanaConfig().PromotionFile = promotion
+			}
+			return r.GrafanaSvc().InitOrganizations()
 		},
 		CommandsList: []simplecobra.Commander{
-			newDashboardCommand(),
-			newConnectionsCommand(),
-			newFolderCommand(),
-			newLibraryElementsCommand(),
+			withOrganizations(newDashboardCommand()),
+			withOrganizations(newConnectionsCommand()),
+			withOrganizations(newFolderCommand()),
+			withOrganizations(newLibraryElementsCommand()),
 			newOrganizationsCommand(),
-			newTeamsCommand(),
+			withOrganizations(newTeamsCommand()),
 			newUsersCommand(),
-			newAlertingCommand(),
+			withOrganizations(newAlertingCommand()),
+			withOrganizations(newRolesCommand()),
+			newSSOCommand(),
+			newAllCommand(),
 		},
 	}
 }
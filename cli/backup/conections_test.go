@@ -31,8 +31,8 @@ func TestConnectionCommand(t *testing.T) {
 		},
 	}
 
-	testSvc.EXPECT().InitOrganizations().Return()
-	testSvc.EXPECT().ListConnections(mock.Anything).Return(resp)
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	testSvc.EXPECT().ListConnections(mock.Anything).Return(resp, nil)
 
 	optionMockSvc := func() support.RootOption {
 		return func(response *support.RootCommand) {
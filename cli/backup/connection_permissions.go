@@ -56,10 +56,16 @@ func newConnectionsPermissionListCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			connectionFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(connectionFilter)
+			filters, err := service.NewConnectionFilter(connectionFilter)
+			if err != nil {
+				return err
+			}
 			slog.Info("Listing Connection Permissions for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "uid", "name", "slug", "type", "default", "url"})
-			connections := rootCmd.GrafanaSvc().ListConnectionPermissions(filters)
+			connections, err := rootCmd.GrafanaSvc().ListConnectionPermissions(filters)
+			if err != nil {
+				return err
+			}
 
 			if len(connections) == 0 {
 				slog.Info("No connections found")
@@ -115,8 +121,14 @@ func newConnectionsPermissionClearCmd() simplecobra.Commander {
 			), "", true)
 			rootCmd.TableObj.AppendHeader(table.Row{"cleared connection permissions"})
 			connectionFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(connectionFilter)
-			connections := rootCmd.GrafanaSvc().DeleteAllConnectionPermissions(filters)
+			filters, err := service.NewConnectionFilter(connectionFilter)
+			if err != nil {
+				return err
+			}
+			connections, err := rootCmd.GrafanaSvc().DeleteAllConnectionPermissions(filters)
+			if err != nil {
+				return err
+			}
 
 			if len(connections) == 0 {
 				slog.Info("No connections found")
@@ -146,8 +158,14 @@ func newConnectionsPermissionDownloadCmd() simplecobra.Commander {
 				"context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"filename"})
 			connectionFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(connectionFilter)
-			connections := rootCmd.GrafanaSvc().DownloadConnectionPermissions(filters)
+			filters, err := service.NewConnectionFilter(connectionFilter)
+			if err != nil {
+				return err
+			}
+			connections, err := rootCmd.GrafanaSvc().DownloadConnectionPermissions(filters)
+			if err != nil {
+				return err
+			}
 			slog.Info("Downloading connections permissions")
 
 			if len(connections) == 0 {
@@ -176,8 +194,14 @@ func newConnectionsPermissionUploadCmd() simplecobra.Commander {
 			slog.Info("Uploading connections permissions")
 			rootCmd.TableObj.AppendHeader(table.Row{"connection permission applied"})
 			connectionFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(connectionFilter)
-			connections := rootCmd.GrafanaSvc().UploadConnectionPermissions(filters)
+			filters, err := service.NewConnectionFilter(connectionFilter)
+			if err != nil {
+				return err
+			}
+			connections, err := rootCmd.GrafanaSvc().UploadConnectionPermissions(filters)
+			if err != nil {
+				return err
+			}
 
 			if len(connections) == 0 {
 				slog.Info("No connections found")
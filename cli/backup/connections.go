@@ -50,8 +50,14 @@ func newClearConnectionsCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Delete connections", slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())))
 			dashboardFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(dashboardFilter)
-			savedFiles := rootCmd.GrafanaSvc().DeleteAllConnections(filters)
+			filters, err := service.NewConnectionFilter(dashboardFilter)
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().DeleteAllConnections(filters)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			for _, file := range savedFiles {
 				rootCmd.TableObj.AppendRow(table.Row{"datasource", file})
@@ -74,8 +80,14 @@ func newUploadConnectionsCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Uploading connections", slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())))
 			dashboardFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(dashboardFilter)
-			exportedList := rootCmd.GrafanaSvc().UploadConnections(filters)
+			filters, err := service.NewConnectionFilter(dashboardFilter)
+			if err != nil {
+				return err
+			}
+			exportedList, err := rootCmd.GrafanaSvc().UploadConnections(filters)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			for _, file := range exportedList {
 				rootCmd.TableObj.AppendRow(table.Row{"datasource", file})
@@ -100,8 +112,14 @@ func newDownloadConnectionsCmd() simplecobra.Commander {
 				slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())),
 				"context", rootCmd.ConfigSvc().GetContext())
 			dashboardFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(dashboardFilter)
-			savedFiles := rootCmd.GrafanaSvc().DownloadConnections(filters)
+			filters, err := service.NewConnectionFilter(dashboardFilter)
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().DownloadConnections(filters)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			for _, file := range savedFiles {
 				rootCmd.TableObj.AppendRow(table.Row{"connection", file})
@@ -124,8 +142,14 @@ func newListConnectionsCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "uid", "name", "slug", "type", "default", "url"})
 			dashboardFilter, _ := cd.CobraCommand.Flags().GetString("connection")
-			filters := service.NewConnectionFilter(dashboardFilter)
-			dsListing := rootCmd.GrafanaSvc().ListConnections(filters)
+			filters, err := service.NewConnectionFilter(dashboardFilter)
+			if err != nil {
+				return err
+			}
+			dsListing, err := rootCmd.GrafanaSvc().ListConnections(filters)
+			if err != nil {
+				return err
+			}
 			slog.Info("Listing connections for context",
 				slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())),
 				slog.String("context", rootCmd.ConfigSvc().GetContext()))
@@ -78,9 +78,15 @@ func newClearDashboardsCmd() simplecobra.Commander {
 					"continue (y/n) ", strings.Join(rootCmd.ConfigSvc().GetDefaultGrafanaConfig().GetMonitoredFolders(false), ", "),
 				), "", true)
 			}
-			filter := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			filter, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
 
-			deletedDashboards := rootCmd.GrafanaSvc().DeleteAllDashboards(filter)
+			deletedDashboards, err := rootCmd.GrafanaSvc().DeleteAllDashboards(filter)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			for _, file := range deletedDashboards {
 				rootCmd.TableObj.AppendRow(table.Row{"dashboard", file})
@@ -113,7 +119,10 @@ func newUploadDashboardsCmd() simplecobra.Commander {
 					"continue (y/n) ", strings.Join(rootCmd.ConfigSvc().GetDefaultGrafanaConfig().GetMonitoredFolders(false), ", "),
 				), "", true)
 			}
-			filter := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			filter, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
 
 			files, err := rootCmd.GrafanaSvc().UploadDashboards(filter)
 			if err != nil {
@@ -149,8 +158,14 @@ func newDownloadDashboardsCmd() simplecobra.Commander {
 			cmd.Aliases = []string{"d"}
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			filter := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
-			savedFiles := rootCmd.GrafanaSvc().DownloadDashboards(filter)
+			filter, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().DownloadDashboards(filter)
+			if err != nil {
+				return err
+			}
 			slog.Info("Downloading dashboards for context",
 				slog.String("Organization", GetOrganizationName(rootCmd.ConfigSvc())),
 				"context", rootCmd.ConfigSvc().GetContext())
@@ -190,8 +205,14 @@ func newListDashboardsCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "Title", "Slug", "Folder", "NestedPath", "UID", "Tags", "URL"})
 
-			filters := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
-			boards := rootCmd.GrafanaSvc().ListDashboards(filters)
+			filters, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			boards, err := rootCmd.GrafanaSvc().ListDashboards(filters)
+			if err != nil {
+				return err
+			}
 
 			printCount := func(count int) {
 				slog.Info("Listing dashboards for context",
@@ -57,11 +57,13 @@ func newDashboardPermissionListCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Listing Dashboard Permissions for context", "context", rootCmd.ConfigSvc().GetContext())
-			filters := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			filters, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
 			permissions, err := rootCmd.GrafanaSvc().ListDashboardPermissions(filters)
 			if err != nil {
-				slog.Error("Failed to retrieve Dashboard Permissions", "error", err)
-				os.Exit(1)
+				return fmt.Errorf("failed to retrieve dashboard permissions: %w", err)
 			}
 
 			if len(permissions) == 0 {
@@ -118,13 +120,14 @@ func newDashboardPermissionClearCmd() simplecobra.Commander {
 				"(Or all permission matching your filters).  Do you wish to continue (y/n) ", rootCmd.ConfigSvc().ContextName,
 			), "", true)
 			rootCmd.TableObj.AppendHeader(table.Row{"cleared Dashboard permissions"})
-			filters := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
-			err := rootCmd.GrafanaSvc().ClearDashboardPermissions(filters)
+			filters, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
 			if err != nil {
-				slog.Error("Failed to retrieve Dashboard Permissions", "error", err)
-			} else {
-				slog.Info("All dashboard permissions have been cleared")
+				return err
+			}
+			if err = rootCmd.GrafanaSvc().ClearDashboardPermissions(filters); err != nil {
+				return fmt.Errorf("failed to clear dashboard permissions: %w", err)
 			}
+			slog.Info("All dashboard permissions have been cleared")
 			return nil
 		},
 	}
@@ -143,11 +146,13 @@ func newDashboardPermissionDownloadCmd() simplecobra.Commander {
 			slog.Info("Download Connections for context",
 				"context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"filename"})
-			filters := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			filters, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
 			permissions, err := rootCmd.GrafanaSvc().DownloadDashboardPermissions(filters)
 			if err != nil {
-				slog.Error("Failed to retrieve Dashboard Permissions", "error", err)
-				os.Exit(1)
+				return fmt.Errorf("failed to retrieve dashboard permissions: %w", err)
 			}
 			slog.Info("Downloading Dashboard permissions")
 
@@ -176,11 +181,13 @@ func newDashboardPermissionUploadCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Uploading dashboard permissions")
 			rootCmd.TableObj.AppendHeader(table.Row{"dashboard permission"})
-			filters := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			filters, err := service.NewDashboardFilter(rootCmd.ConfigSvc(), parseDashboardGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
 			permissions, err := rootCmd.GrafanaSvc().UploadDashboardPermissions(filters)
 			if err != nil {
-				slog.Error("Failed to retrieve Dashboard Permissions", "error", err)
-				os.Exit(1)
+				return fmt.Errorf("failed to retrieve dashboard permissions: %w", err)
 			}
 
 			if len(permissions) == 0 {
@@ -54,7 +54,14 @@ func newFolderPermissionListCmd() simplecobra.Commander {
 
 			slog.Info("Listing Folders for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"folderUid", "folder ID", "folder Name", "UserID", "Team Name", "Role", "Permission Name"}, rowConfigAutoMerge)
-			folders := rootCmd.GrafanaSvc().ListFolderPermissions(getFolderFilter(rootCmd.ConfigSvc()))
+			filter, err := getFolderFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			folders, err := rootCmd.GrafanaSvc().ListFolderPermissions(filter)
+			if err != nil {
+				return err
+			}
 
 			if len(folders) == 0 {
 				slog.Info("No folders found")
@@ -92,7 +99,14 @@ func newFolderPermissionDownloadCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Downloading Folder Permissions for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"filename"})
-			folders := rootCmd.GrafanaSvc().DownloadFolderPermissions(getFolderFilter(rootCmd.ConfigSvc()))
+			filter, err := getFolderFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			folders, err := rootCmd.GrafanaSvc().DownloadFolderPermissions(filter)
+			if err != nil {
+				return err
+			}
 			slog.Info("Downloading folder permissions")
 
 			if len(folders) == 0 {
@@ -120,7 +134,14 @@ func newFolderPermissionUploadCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Uploading folder permissions")
 			rootCmd.TableObj.AppendHeader(table.Row{"file name"})
-			folders := rootCmd.GrafanaSvc().UploadFolderPermissions(getFolderFilter(rootCmd.ConfigSvc()))
+			filter, err := getFolderFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			folders, err := rootCmd.GrafanaSvc().UploadFolderPermissions(filter)
+			if err != nil {
+				return err
+			}
 
 			if len(folders) == 0 {
 				slog.Info("No folders found")
@@ -20,9 +20,9 @@ import (
 
 var useFolderFilters bool
 
-func getFolderFilter(cfg *domain.GDGAppConfiguration) filters.V2Filter {
+func getFolderFilter(cfg *domain.GDGAppConfiguration) (filters.V2Filter, error) {
 	if !useFolderFilters {
-		return nil
+		return nil, nil
 	}
 	return service.NewFolderFilter(cfg)
 }
@@ -69,7 +69,14 @@ func newFolderClearCmd() simplecobra.Commander {
 			}
 			rootCmd.TableObj.AppendHeader(table.Row{"title"})
 
-			folders := rootCmd.GrafanaSvc().DeleteAllFolders(getFolderFilter(rootCmd.ConfigSvc()))
+			filter, err := getFolderFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			folders, err := rootCmd.GrafanaSvc().DeleteAllFolders(filter)
+			if err != nil {
+				return err
+			}
 			if len(folders) == 0 {
 				slog.Info("No Folders found")
 			} else {
@@ -95,7 +102,14 @@ func newFolderListCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Listing Folders for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"uid", "title", "nestedPath"})
-			folders := rootCmd.GrafanaSvc().ListFolders(getFolderFilter(rootCmd.ConfigSvc()))
+			filter, err := getFolderFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			folders, err := rootCmd.GrafanaSvc().ListFolders(filter)
+			if err != nil {
+				return err
+			}
 
 			if len(folders) == 0 {
 				slog.Info("No folders found")
@@ -123,7 +137,14 @@ func newFolderDownloadCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Listing Folders for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"file"})
-			folders := rootCmd.GrafanaSvc().DownloadFolders(getFolderFilter(rootCmd.ConfigSvc()))
+			filter, err := getFolderFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			folders, err := rootCmd.GrafanaSvc().DownloadFolders(filter)
+			if err != nil {
+				return err
+			}
 			if len(folders) == 0 {
 				slog.Info("No folders found")
 			} else {
@@ -149,7 +170,14 @@ func newFolderUploadCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Uploading Folders for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"file"})
-			folders := rootCmd.GrafanaSvc().UploadFolders(getFolderFilter(rootCmd.ConfigSvc()))
+			filter, err := getFolderFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			folders, err := rootCmd.GrafanaSvc().UploadFolders(filter)
+			if err != nil {
+				return err
+			}
 			if len(folders) == 0 {
 				slog.Info("No folders found")
 			} else {
@@ -2,7 +2,7 @@ package backup
 
 import (
 	"context"
-	"log"
+	"errors"
 	"log/slog"
 
 	"github.com/esnet/gdg/internal/service"
@@ -45,7 +45,14 @@ func newLibraryElementsClearCmd() simplecobra.Commander {
 			cmd.Aliases = []string{"c"}
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			deletedLibraries := rootCmd.GrafanaSvc().DeleteAllLibraryElements(service.NewLibraryElementFilter(rootCmd.ConfigSvc()))
+			filter, err := service.NewLibraryElementFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			deletedLibraries, err := rootCmd.GrafanaSvc().DeleteAllLibraryElements(filter)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			for _, file := range deletedLibraries {
 				rootCmd.TableObj.AppendRow(table.Row{"library", file})
@@ -73,7 +80,14 @@ func newLibraryElementsListCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "UID", "Nested Folder", "Folder", "Name", "Type"})
 
-			elements := rootCmd.GrafanaSvc().ListLibraryElements(service.NewLibraryElementFilter(rootCmd.ConfigSvc()))
+			filter, err := service.NewLibraryElementFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			elements, err := rootCmd.GrafanaSvc().ListLibraryElements(filter)
+			if err != nil {
+				return err
+			}
 
 			slog.Info("Listing library for context", "count", len(elements), "context", rootCmd.ConfigSvc().GetContext())
 			for _, link := range elements {
@@ -100,7 +114,14 @@ func newLibraryElementsDownloadCmd() simplecobra.Commander {
 			cmd.Aliases = []string{"d"}
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			savedFiles := rootCmd.GrafanaSvc().DownloadLibraryElements(service.NewLibraryElementFilter(rootCmd.ConfigSvc()))
+			filter, err := service.NewLibraryElementFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().DownloadLibraryElements(filter)
+			if err != nil {
+				return err
+			}
 			slog.Info("Downloading library for context", "count", len(savedFiles), "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			for _, file := range savedFiles {
@@ -122,7 +143,14 @@ func newLibraryElementsUploadCmd() simplecobra.Commander {
 			cmd.Aliases = []string{"u"}
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			elements := rootCmd.GrafanaSvc().UploadLibraryElements(service.NewLibraryElementFilter(rootCmd.ConfigSvc()))
+			filter, err := service.NewLibraryElementFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			elements, err := rootCmd.GrafanaSvc().UploadLibraryElements(filter)
+			if err != nil {
+				return err
+			}
 			slog.Info("exporting lib elements", "count", len(elements),
 				"context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"Name"})
@@ -150,12 +178,19 @@ func newLibraryElementsListConnectionsCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			if len(args) != 1 {
-				log.Fatal("Wrong number of arguments, requires library element UUID")
+				return errors.New("Wrong number of arguments, requires library element UUID")
 			}
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "UID", "Slug", "Title", "Folder"})
 
 			libElementUid := args[0]
-			elements := rootCmd.GrafanaSvc().ListLibraryElementsConnections(service.NewLibraryElementFilter(rootCmd.ConfigSvc()), libElementUid)
+			filter, err := service.NewLibraryElementFilter(rootCmd.ConfigSvc())
+			if err != nil {
+				return err
+			}
+			elements, err := rootCmd.GrafanaSvc().ListLibraryElementsConnections(filter, libElementUid)
+			if err != nil {
+				return err
+			}
 			slog.Info("Listing library connections for context", "count", len(elements),
 				"context", rootCmd.ConfigSvc().GetContext())
 			for _, link := range elements {
@@ -2,12 +2,14 @@ package backup
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"sort"
 
 	"github.com/bep/simplecobra"
 	"github.com/esnet/gdg/cli/support"
 	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/tools"
 	"github.com/gosimple/slug"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
@@ -39,6 +41,7 @@ func newOrganizationsCommand() simplecobra.Commander {
 			newOrganizationsListCmd(),
 			newOrganizationsDownloadCmd(),
 			newOrganizationsUploadCmd(),
+			newOrganizationsClearCmd(),
 		},
 	}
 }
@@ -54,7 +57,10 @@ func newOrganizationsListCmd() simplecobra.Commander {
 			cmd.PersistentFlags().BoolP("with-preferences", "", false, "when set to true, Attempts to retrieve Orgs Preferences (Warning, this is slow due to Grafana current API design)")
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			filter := service.NewOrganizationFilter(parseOrganizationGlobalFlags(cd.CobraCommand)...)
+			filter, err := service.NewOrganizationFilter(parseOrganizationGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
 			includePreferences, _ := cd.CobraCommand.Flags().GetBool("with-preferences")
 
 			headerRow := table.Row{"id", "organization Name", "org slug ID"}
@@ -62,7 +68,10 @@ func newOrganizationsListCmd() simplecobra.Commander {
 				headerRow = append(headerRow, table.Row{"HomeDashboardUID", "Theme", "WeekStart"}...)
 			}
 			rootCmd.TableObj.AppendHeader(headerRow)
-			listOrganizations := rootCmd.GrafanaSvc().ListOrganizations(filter, includePreferences)
+			listOrganizations, err := rootCmd.GrafanaSvc().ListOrganizations(filter, includePreferences)
+			if err != nil {
+				return err
+			}
 			slog.Info("Listing organizations for context",
 				slog.Any("count", len(listOrganizations)),
 				slog.Any("context", rootCmd.ConfigSvc().GetContext()))
@@ -107,8 +116,14 @@ func newOrganizationsDownloadCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Downloading organizations for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"file"})
-			filter := service.NewOrganizationFilter(parseOrganizationGlobalFlags(cd.CobraCommand)...)
-			listOrganizations := rootCmd.GrafanaSvc().DownloadOrganizations(filter)
+			filter, err := service.NewOrganizationFilter(parseOrganizationGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			listOrganizations, err := rootCmd.GrafanaSvc().DownloadOrganizations(filter)
+			if err != nil {
+				return err
+			}
 			if len(listOrganizations) == 0 {
 				slog.Info("No organizations found")
 			} else {
@@ -130,12 +145,20 @@ func newOrganizationsUploadCmd() simplecobra.Commander {
 		Long:  description,
 		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
 			cmd.Aliases = []string{"u"}
+			cmd.PersistentFlags().StringToString("rename", nil, "rename organizations on upload, ie. --rename \"old name=new name\"")
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			slog.Info("Uploading Folders for context: ", "context", rootCmd.ConfigSvc().GetContext())
+			slog.Info("Uploading Organizations for context: ", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"file"})
-			filter := service.NewOrganizationFilter(parseOrganizationGlobalFlags(cd.CobraCommand)...)
-			organizations := rootCmd.GrafanaSvc().UploadOrganizations(filter)
+			filter, err := service.NewOrganizationFilter(parseOrganizationGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			renames, _ := cd.CobraCommand.Flags().GetStringToString("rename")
+			organizations, err := rootCmd.GrafanaSvc().UploadOrganizations(filter, renames)
+			if err != nil {
+				return err
+			}
 			if len(organizations) == 0 {
 				slog.Info("No Organizations were uploaded")
 			} else {
@@ -148,3 +171,38 @@ func newOrganizationsUploadCmd() simplecobra.Commander {
 		},
 	}
 }
+
+func newOrganizationsClearCmd() simplecobra.Commander {
+	description := "delete all Organizations, intended for test environments"
+	return &support.SimpleCommand{
+		NameP: "clear",
+		Short: description,
+		Long:  description + ".  The default organization and the one configured for the context are never deleted.",
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"c"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			tools.GetUserConfirmation(fmt.Sprintf("WARNING: this will delete all organizations and their content from: '%s' "+
+				"(Or all organizations matching your filters).  Do you wish to continue (y/n) ", rootCmd.ConfigSvc().ContextName,
+			), "", true)
+			rootCmd.TableObj.AppendHeader(table.Row{"type", "name"})
+			filter, err := service.NewOrganizationFilter(parseOrganizationGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			organizations, err := rootCmd.GrafanaSvc().DeleteAllOrganizations(filter)
+			if err != nil {
+				return err
+			}
+			if len(organizations) == 0 {
+				slog.Info("No Organizations were deleted")
+			} else {
+				for _, org := range organizations {
+					rootCmd.TableObj.AppendRow(table.Row{"organization", org})
+				}
+				rootCmd.Render(cd.CobraCommand, organizations)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,179 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+
+	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+const (
+	allOrgsFlag = "all-orgs"
+	orgsFlag    = "orgs"
+
+	orgRunSuccess = "success"
+	orgRunFailed  = "failed"
+)
+
+// orgRunResult summarizes the outcome of a command for a single organization
+type orgRunResult struct {
+	Organization string `json:"organization"`
+	Status       string `json:"status"`
+	Entries      int    `json:"entries"`
+	Error        string `json:"error,omitempty"`
+	Data         any    `json:"data,omitempty"`
+}
+
+// withOrganizations adds the --all-orgs and --orgs flags to an org scoped command, and wraps every sub command so
+// it can be repeated across multiple organizations.
+func withOrganizations(cmd simplecobra.Commander) simplecobra.Commander {
+	simpleCmd, ok := cmd.(*support.SimpleCommand)
+	if !ok {
+		return cmd
+	}
+	withCFunc := simpleCmd.WithCFunc
+	simpleCmd.WithCFunc = func(cmd *cobra.Command, r *support.RootCommand) {
+		if withCFunc != nil {
+			withCFunc(cmd, r)
+		}
+		cmd.PersistentFlags().Bool(allOrgsFlag, false, "run the command against every organization (requires grafana admin)")
+		cmd.PersistentFlags().StringSlice(orgsFlag, nil, "run the command against the given organizations, ie. --orgs main,other")
+	}
+	wrapOrgRunFunc(simpleCmd)
+	return simpleCmd
+}
+
+// wrapOrgRunFunc recursively wraps the RunFunc of all leaf commands
+func wrapOrgRunFunc(cmd *support.SimpleCommand) {
+	if len(cmd.CommandsList) > 0 {
+		for _, child := range cmd.CommandsList {
+			if simpleCmd, ok := child.(*support.SimpleCommand); ok {
+				wrapOrgRunFunc(simpleCmd)
+			}
+		}
+		return
+	}
+	runFunc := cmd.RunFunc
+	cmd.RunFunc = func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+		orgNames, err := getTargetOrganizations(cd.CobraCommand, rootCmd)
+		if err != nil {
+			return err
+		}
+		if len(orgNames) == 0 {
+			return runFunc(ctx, cd, rootCmd, args)
+		}
+		return runForOrganizations(ctx, cd, rootCmd, args, orgNames, runFunc)
+	}
+}
+
+// getTargetOrganizations returns the organizations requested via --all-orgs or --orgs, nil if neither is set
+func getTargetOrganizations(command *cobra.Command, rootCmd *support.RootCommand) ([]string, error) {
+	allOrgs, _ := command.Flags().GetBool(allOrgsFlag)
+	orgNames, _ := command.Flags().GetStringSlice(orgsFlag)
+	if allOrgs && len(orgNames) > 0 {
+		return nil, fmt.Errorf("--%s and --%s are mutually exclusive", allOrgsFlag, orgsFlag)
+	}
+	if !allOrgs {
+		return lo.Uniq(lo.Compact(orgNames)), nil
+	}
+	filter, err := service.NewOrganizationFilter()
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := rootCmd.GrafanaSvc().ListOrganizations(filter, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(orgs) == 0 {
+		return nil, errors.New("no organizations found, listing all organizations requires a grafana admin")
+	}
+	return lo.Map(orgs, func(item *domain.OrgsDTOWithPreferences, _ int) string {
+		return item.Organization.Name
+	}), nil
+}
+
+// runForOrganizations invokes the command once per organization and renders a consolidated report.  A failure in one
+// organization is recorded and does not stop the remaining ones.
+func runForOrganizations(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string,
+	orgNames []string, runFunc func(context.Context, *simplecobra.Commandeer, *support.RootCommand, []string) error,
+) error {
+	output, _ := cd.CobraCommand.Flags().GetString("output")
+	jsonOutput := output == "json"
+	rootCmd.BufferOutput(jsonOutput)
+	defer rootCmd.BufferOutput(false)
+
+	results := make([]orgRunResult, 0, len(orgNames))
+	for _, orgName := range orgNames {
+		result := orgRunResult{Organization: orgName, Status: orgRunSuccess}
+		rootCmd.ResetTable(fmt.Sprintf("Organization: %s", orgName))
+		err := inOrganization(rootCmd, orgName, func() error {
+			slog.Info("Running command for organization", "organization", orgName)
+			return runFunc(ctx, cd, rootCmd, args)
+		})
+		if err != nil {
+			slog.Error("command failed for organization", "organization", orgName, "err", err)
+			result.Status = orgRunFailed
+			result.Error = err.Error()
+		}
+		result.Entries = countEntries(rootCmd.Rendered())
+		if jsonOutput {
+			result.Data = rootCmd.Rendered()
+		}
+		results = append(results, result)
+	}
+
+	failed := lo.CountBy(results, func(item orgRunResult) bool { return item.Status == orgRunFailed })
+	if jsonOutput {
+		data, err := json.MarshalIndent(results, "", "    ")
+		if err != nil {
+			return fmt.Errorf("unable to render result to JSON: %w", err)
+		}
+		fmt.Print(string(data))
+	} else {
+		rootCmd.ResetTable("Organizations Summary")
+		rootCmd.TableObj.AppendHeader(table.Row{"organization", "status", "entries", "error"})
+		for _, result := range results {
+			rootCmd.TableObj.AppendRow(table.Row{result.Organization, result.Status, result.Entries, result.Error})
+		}
+		rootCmd.TableObj.Render()
+	}
+	if failed > 0 {
+		return fmt.Errorf("command failed for %d of %d organizations", failed, len(results))
+	}
+	return nil
+}
+
+// countEntries returns the number of entries in a rendered result
+func countEntries(data any) int {
+	if data == nil {
+		return 0
+	}
+	val := reflect.ValueOf(data)
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return val.Len()
+	default:
+		return 1
+	}
+}
+
+// inOrganization invokes fn with the Grafana service and configuration scoped to the given organization, the user's
+// active organization is left untouched.
+func inOrganization(rootCmd *support.RootCommand, orgName string, fn func() error) error {
+	restore, err := rootCmd.UseOrganization(orgName)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	return fn()
+}
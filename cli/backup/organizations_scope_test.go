@@ -0,0 +1,73 @@
+package backup_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/esnet/gdg/cli"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/mocks"
+	"github.com/esnet/gdg/internal/tools/ptr"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/esnet/gdg/pkg/test_tooling"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAllOrgsCommand(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	getMockSvc := func() service.GrafanaService {
+		return testSvc
+	}
+	orgs := []*domain.OrgsDTOWithPreferences{
+		{Organization: &models.OrgDTO{ID: 1, Name: "Main Org."}},
+		{Organization: &models.OrgDTO{ID: 2, Name: "testing"}},
+		{Organization: &models.OrgDTO{ID: 3, Name: "broken"}},
+	}
+	roles := []*domain.RoleWithAssignments{
+		{Role: &models.RoleDTO{UID: ptr.Of("customRoleUid"), Name: ptr.Of("custom:dashboards:reader")}},
+	}
+
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	testSvc.EXPECT().ListOrganizations(mock.Anything, false).Return(orgs, nil)
+	testSvc.EXPECT().WithOrganization("broken").Return(nil, errors.New("user does not have access to org: 'broken'"))
+	testSvc.EXPECT().WithOrganization(mock.Anything).Return(testSvc, nil)
+	testSvc.EXPECT().ListRoles(mock.Anything).Return(roles, nil).Times(2)
+
+	optionMockSvc := func() support.RootOption {
+		return func(response *support.RootCommand) {
+			response.SetUpTest(getMockSvc())
+		}
+	}
+	r, w, cleanup := test_tooling.InterceptStdout()
+
+	err := cli.Execute([]string{"backup", "roles", "list", "--all-orgs"}, optionMockSvc())
+	assert.ErrorContains(t, err, "command failed for 1 of 3 organizations")
+	defer cleanup()
+	assert.NoError(t, w.Close())
+
+	out, _ := io.ReadAll(r)
+	outStr := string(out)
+	assert.Equal(t, 2, strings.Count(outStr, "customRoleUid"))
+	assert.True(t, strings.Contains(outStr, "Organization: Main Org."))
+	assert.True(t, strings.Contains(outStr, "Organizations Summary"))
+	assert.True(t, strings.Contains(outStr, "user does not have access to org: 'broken'"))
+	testSvc.AssertNumberOfCalls(t, "WithOrganization", 3)
+}
+
+func TestOrgsFlagsMutuallyExclusive(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	optionMockSvc := func() support.RootOption {
+		return func(response *support.RootCommand) {
+			response.SetUpTest(testSvc)
+		}
+	}
+	err := cli.Execute([]string{"backup", "roles", "list", "--all-orgs", "--orgs", "main"}, optionMockSvc())
+	assert.ErrorContains(t, err, "mutually exclusive")
+	testSvc.AssertNotCalled(t, "ListRoles", mock.Anything)
+}
@@ -0,0 +1,42 @@
+package backup_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/esnet/gdg/cli"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/mocks"
+	"github.com/esnet/gdg/pkg/test_tooling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOrganizationsUploadRename(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	getMockSvc := func() service.GrafanaService {
+		return testSvc
+	}
+
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	testSvc.EXPECT().UploadOrganizations(mock.Anything, map[string]string{"Moo": "Cow", "testing": "staging"}).Return([]string{"Cow", "staging"}, nil)
+
+	optionMockSvc := func() support.RootOption {
+		return func(response *support.RootCommand) {
+			response.SetUpTest(getMockSvc())
+		}
+	}
+	r, w, cleanup := test_tooling.InterceptStdout()
+
+	err := cli.Execute([]string{"backup", "organizations", "upload", "--rename", "Moo=Cow,testing=staging"}, optionMockSvc())
+	assert.Nil(t, err)
+	defer cleanup()
+	assert.NoError(t, w.Close())
+
+	out, _ := io.ReadAll(r)
+	outStr := string(out)
+	assert.True(t, strings.Contains(outStr, "Cow"))
+	assert.True(t, strings.Contains(outStr, "staging"))
+}
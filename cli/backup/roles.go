@@ -0,0 +1,185 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/tools"
+	"github.com/esnet/gdg/internal/tools/ptr"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func parseRoleGlobalFlags(command *cobra.Command) string {
+	roleName, _ := command.Flags().GetString("role")
+	return roleName
+}
+
+func newRolesCommand() simplecobra.Commander {
+	description := "Manage custom RBAC roles and their assignments (Enterprise only)"
+	return &support.SimpleCommand{
+		NameP: "roles",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"role", "r"}
+			cmd.PersistentFlags().StringP("role", "r", "", "filter by role name")
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			return cd.CobraCommand.Help()
+		},
+		CommandsList: []simplecobra.Commander{
+			newRolesListCmd(),
+			newRolesDownloadCmd(),
+			newRolesUploadCmd(),
+			newRolesClearCmd(),
+		},
+	}
+}
+
+func newRolesListCmd() simplecobra.Commander {
+	description := "list custom roles"
+	return &support.SimpleCommand{
+		NameP: "list",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"l"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Listing roles for context", "context", rootCmd.ConfigSvc().GetContext())
+			rootCmd.TableObj.AppendHeader(table.Row{"uid", "name", "display name", "permissions", "users", "teams", "service accounts"})
+			filter, err := service.NewRoleFilter(parseRoleGlobalFlags(cd.CobraCommand))
+			if err != nil {
+				return err
+			}
+			roles, err := rootCmd.GrafanaSvc().ListRoles(filter)
+			if err != nil {
+				return err
+			}
+			if len(roles) == 0 {
+				slog.Info("No roles found")
+				return nil
+			}
+			for _, role := range roles {
+				rootCmd.TableObj.AppendRow(table.Row{
+					ptr.ValueOrDefault(role.Role.UID, ""),
+					ptr.ValueOrDefault(role.Role.Name, ""),
+					ptr.ValueOrDefault(role.Role.DisplayName, ""),
+					len(role.Role.Permissions),
+					strings.Join(role.Assignments.Users, ", "),
+					strings.Join(role.Assignments.Teams, ", "),
+					strings.Join(role.Assignments.ServiceAccounts, ", "),
+				})
+			}
+			rootCmd.Render(cd.CobraCommand, roles)
+			return nil
+		},
+	}
+}
+
+func newRolesDownloadCmd() simplecobra.Commander {
+	description := "download custom roles and their assignments"
+	return &support.SimpleCommand{
+		NameP: "download",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"d"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Downloading roles for context", "context", rootCmd.ConfigSvc().GetContext())
+			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
+			filter, err := service.NewRoleFilter(parseRoleGlobalFlags(cd.CobraCommand))
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().DownloadRoles(filter)
+			if err != nil {
+				return err
+			}
+			if len(savedFiles) == 0 {
+				slog.Info("No roles found")
+				return nil
+			}
+			for _, file := range savedFiles {
+				rootCmd.TableObj.AppendRow(table.Row{"role", file})
+			}
+			rootCmd.Render(cd.CobraCommand, savedFiles)
+			return nil
+		},
+	}
+}
+
+func newRolesUploadCmd() simplecobra.Commander {
+	description := "upload custom roles and re-apply their assignments"
+	return &support.SimpleCommand{
+		NameP: "upload",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"u"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Uploading roles for context", "context", rootCmd.ConfigSvc().GetContext())
+			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
+			filter, err := service.NewRoleFilter(parseRoleGlobalFlags(cd.CobraCommand))
+			if err != nil {
+				return err
+			}
+			files, err := rootCmd.GrafanaSvc().UploadRoles(filter)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				slog.Info("No roles were uploaded")
+				return nil
+			}
+			for _, file := range files {
+				rootCmd.TableObj.AppendRow(table.Row{"role", file})
+			}
+			rootCmd.Render(cd.CobraCommand, files)
+			return nil
+		},
+	}
+}
+
+func newRolesClearCmd() simplecobra.Commander {
+	description := "delete all custom roles"
+	return &support.SimpleCommand{
+		NameP: "clear",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"c"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			tools.GetUserConfirmation(fmt.Sprintf("WARNING: this will delete all custom roles from: '%s' "+
+				"(Or all roles matching your filters).  Do you wish to continue (y/n) ", rootCmd.ConfigSvc().ContextName,
+			), "", true)
+			rootCmd.TableObj.AppendHeader(table.Row{"type", "name"})
+			filter, err := service.NewRoleFilter(parseRoleGlobalFlags(cd.CobraCommand))
+			if err != nil {
+				return err
+			}
+			roles, err := rootCmd.GrafanaSvc().DeleteAllRoles(filter)
+			if err != nil {
+				return err
+			}
+			if len(roles) == 0 {
+				slog.Info("No roles were deleted")
+				return nil
+			}
+			for _, role := range roles {
+				rootCmd.TableObj.AppendRow(table.Row{"role", role})
+			}
+			rootCmd.Render(cd.CobraCommand, roles)
+			return nil
+		},
+	}
+}
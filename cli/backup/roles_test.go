@@ -0,0 +1,58 @@
+package backup_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/esnet/gdg/cli"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/mocks"
+	"github.com/esnet/gdg/internal/tools/ptr"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/esnet/gdg/pkg/test_tooling"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRolesListCommand(t *testing.T) {
+	testSvc := new(mocks.GrafanaService)
+	getMockSvc := func() service.GrafanaService {
+		return testSvc
+	}
+	resp := []*domain.RoleWithAssignments{
+		{
+			Role: &models.RoleDTO{
+				UID:  ptr.Of("customRoleUid"),
+				Name: ptr.Of("custom:dashboards:reader"),
+			},
+			Assignments: domain.RoleAssignments{
+				Users: []string{"bob"},
+				Teams: []string{"engineers"},
+			},
+		},
+	}
+
+	testSvc.EXPECT().InitOrganizations().Return(nil)
+	testSvc.EXPECT().ListRoles(mock.Anything).Return(resp, nil)
+
+	optionMockSvc := func() support.RootOption {
+		return func(response *support.RootCommand) {
+			response.SetUpTest(getMockSvc())
+		}
+	}
+	r, w, cleanup := test_tooling.InterceptStdout()
+
+	err := cli.Execute([]string{"backup", "roles", "list"}, optionMockSvc())
+	assert.Nil(t, err)
+	defer cleanup()
+	assert.NoError(t, w.Close())
+
+	out, _ := io.ReadAll(r)
+	outStr := string(out)
+	assert.True(t, strings.Contains(outStr, "customRoleUid"))
+	assert.True(t, strings.Contains(outStr, "custom:dashboards:reader"))
+	assert.True(t, strings.Contains(outStr, "engineers"))
+}
@@ -0,0 +1,143 @@
+package backup
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newSSOCommand() simplecobra.Commander {
+	description := "Manage SSO provider settings"
+	return &support.SimpleCommand{
+		NameP: "sso",
+		Short: description,
+		Long:  description,
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			return cd.CobraCommand.Help()
+		},
+		CommandsList: []simplecobra.Commander{
+			newSSOListCmd(),
+			newSSODownloadCmd(),
+			newSSOUploadCmd(),
+			newSSODiffCmd(),
+		},
+	}
+}
+
+func newSSOListCmd() simplecobra.Commander {
+	description := "list SSO providers configured in grafana"
+	return &support.SimpleCommand{
+		NameP: "list",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"l"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Listing SSO settings for context", "context", rootCmd.ConfigSvc().GetContext())
+			rootCmd.TableObj.AppendHeader(table.Row{"id", "provider", "source"})
+			providers, err := rootCmd.GrafanaSvc().ListSSOSettings()
+			if err != nil {
+				return err
+			}
+			if len(providers) == 0 {
+				slog.Info("No SSO providers found")
+				return nil
+			}
+			for _, provider := range providers {
+				rootCmd.TableObj.AppendRow(table.Row{provider.ID, provider.Provider, provider.Source})
+			}
+			rootCmd.Render(cd.CobraCommand, providers)
+			return nil
+		},
+	}
+}
+
+func newSSODownloadCmd() simplecobra.Commander {
+	description := "download SSO provider settings"
+	return &support.SimpleCommand{
+		NameP: "download",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"d"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Downloading SSO settings for context", "context", rootCmd.ConfigSvc().GetContext())
+			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
+			files, err := rootCmd.GrafanaSvc().DownloadSSOSettings()
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				slog.Info("No SSO providers found")
+				return nil
+			}
+			for _, file := range files {
+				rootCmd.TableObj.AppendRow(table.Row{"sso", file})
+			}
+			rootCmd.Render(cd.CobraCommand, files)
+			return nil
+		},
+	}
+}
+
+func newSSOUploadCmd() simplecobra.Commander {
+	description := "upload SSO provider settings"
+	return &support.SimpleCommand{
+		NameP: "upload",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"u"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Uploading SSO settings for context", "context", rootCmd.ConfigSvc().GetContext())
+			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
+			files, err := rootCmd.GrafanaSvc().UploadSSOSettings()
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				slog.Info("No SSO settings were uploaded")
+				return nil
+			}
+			for _, file := range files {
+				rootCmd.TableObj.AppendRow(table.Row{"sso", file})
+			}
+			rootCmd.Render(cd.CobraCommand, files)
+			return nil
+		},
+	}
+}
+
+func newSSODiffCmd() simplecobra.Commander {
+	description := "compare local SSO provider settings with grafana"
+	return &support.SimpleCommand{
+		NameP: "diff",
+		Short: description,
+		Long:  description,
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Comparing SSO settings for context", "context", rootCmd.ConfigSvc().GetContext())
+			rootCmd.TableObj.AppendHeader(table.Row{"provider", "status", "fields"})
+			diffs, err := rootCmd.GrafanaSvc().DiffSSOSettings()
+			if err != nil {
+				return err
+			}
+			if len(diffs) == 0 {
+				slog.Info("No SSO settings found")
+				return nil
+			}
+			for _, diff := range diffs {
+				rootCmd.TableObj.AppendRow(table.Row{diff.Provider, diff.Status, strings.Join(diff.Fields, ", ")})
+			}
+			rootCmd.Render(cd.CobraCommand, diffs)
+			return nil
+		},
+	}
+}
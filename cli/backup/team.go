@@ -102,8 +102,14 @@ func newTeamsListCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Listing teams for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "name", "email", "orgID", "memberCount", "memberID", "member Permission"})
-			filter := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
-			teams := rootCmd.GrafanaSvc().ListTeams(filter)
+			filter, err := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			teams, err := rootCmd.GrafanaSvc().ListTeams(filter)
+			if err != nil {
+				return err
+			}
 			if len(teams) == 0 {
 				slog.Info("No teams found")
 			} else {
@@ -125,8 +131,14 @@ func newTeamsDownloadCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Downloading Teams and Member data for context", "context", rootCmd.ConfigSvc().GetContext())
-			filter := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
-			savedFiles := rootCmd.GrafanaSvc().DownloadTeams(filter)
+			filter, err := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().DownloadTeams(filter)
+			if err != nil {
+				return err
+			}
 			if len(savedFiles) == 0 {
 				slog.Info("No teams found")
 			} else {
@@ -148,8 +160,14 @@ func newTeamsUploadCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Exporting Teams for context", "context", rootCmd.ConfigSvc().GetContext())
-			filter := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
-			savedFiles := rootCmd.GrafanaSvc().UploadTeams(filter)
+			filter, err := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().UploadTeams(filter)
+			if err != nil {
+				return err
+			}
 			if len(savedFiles) == 0 {
 				slog.Info("No teams found")
 			} else {
@@ -171,7 +189,10 @@ func newTeamsClearCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Deleting teams for context", "context", rootCmd.ConfigSvc().GetContext())
-			filter := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
+			filter, err := api.NewTeamFilter(parseTeamGlobalFlags(cd.CobraCommand)...)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "team ID", "team Name"})
 			teams, err := rootCmd.GrafanaSvc().DeleteTeam(filter)
 			if err != nil {
@@ -21,6 +21,7 @@ func newUsersCommand() simplecobra.Commander {
 		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
 			cmd.Aliases = []string{"user", "u"}
 			cmd.PersistentFlags().StringP("authlabel", "", "", "filter by a given auth label")
+			cmd.PersistentFlags().String("preferences-credentials", "", "file mapping user logins to passwords, the preferences of the listed users are backed up by logging in as them.  Overrides preferences_credentials")
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			return cd.CobraCommand.Help()
@@ -47,7 +48,14 @@ func newUsersListCmd() simplecobra.Commander {
 			authLabel, _ := cd.CobraCommand.Flags().GetString("authlabel")
 			slog.Info("Listing users for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "login", "name", "email", "admin", "disabled", "default Password", "authLabels"})
-			users := rootCmd.GrafanaSvc().ListUsers(service.NewUserFilter(authLabel))
+			filter, err := service.NewUserFilter(authLabel)
+			if err != nil {
+				return err
+			}
+			users, err := rootCmd.GrafanaSvc().ListUsers(filter)
+			if err != nil {
+				return err
+			}
 			if len(users) == 0 {
 				slog.Info("No users found")
 			} else {
@@ -85,7 +93,15 @@ func newUsersDownloadCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			authLabel, _ := cd.CobraCommand.Flags().GetString("authlabel")
-			savedFiles := rootCmd.GrafanaSvc().DownloadUsers(service.NewUserFilter(authLabel))
+			filter, err := service.NewUserFilter(authLabel)
+			if err != nil {
+				return err
+			}
+			setPreferencesCredentials(cd.CobraCommand, rootCmd)
+			savedFiles, err := rootCmd.GrafanaSvc().DownloadUsers(filter)
+			if err != nil {
+				return err
+			}
 			slog.Info("Importing Users for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			if len(savedFiles) == 0 {
@@ -113,7 +129,15 @@ func newUsersUploadCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			authLabel, _ := cd.CobraCommand.Flags().GetString("authlabel")
 			slog.Info("Uploading Users to context", "context", rootCmd.ConfigSvc().GetContext())
-			savedFiles := rootCmd.GrafanaSvc().UploadUsers(service.NewUserFilter(authLabel))
+			filter, err := service.NewUserFilter(authLabel)
+			if err != nil {
+				return err
+			}
+			setPreferencesCredentials(cd.CobraCommand, rootCmd)
+			savedFiles, err := rootCmd.GrafanaSvc().UploadUsers(filter)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "login", "name", "email", "grafanaAdmin", "disabled", "default Password", "authLabels"})
 			if len(savedFiles) == 0 {
 				slog.Info("No users found")
@@ -146,7 +170,14 @@ func newUsersClearCmd() simplecobra.Commander {
 		},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			authLabel, _ := cd.CobraCommand.Flags().GetString("authlabel")
-			savedFiles := rootCmd.GrafanaSvc().DeleteAllUsers(service.NewUserFilter(authLabel))
+			filter, err := service.NewUserFilter(authLabel)
+			if err != nil {
+				return err
+			}
+			savedFiles, err := rootCmd.GrafanaSvc().DeleteAllUsers(filter)
+			if err != nil {
+				return err
+			}
 			slog.Info("Delete Users for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			if len(savedFiles) == 0 {
@@ -161,3 +192,10 @@ func newUsersClearCmd() simplecobra.Commander {
 		},
 	}
 }
+
+// setPreferencesCredentials applies the --preferences-credentials override to the current context
+func setPreferencesCredentials(cmd *cobra.Command, rootCmd *support.RootCommand) {
+	if location, _ := cmd.Flags().GetString("preferences-credentials"); location != "" {
+		rootCmd.ConfigSvc().GetDefaultGrafanaConfig().SetPreferencesCredentials(location)
+	}
+}
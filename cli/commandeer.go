@@ -2,6 +2,9 @@ package cli
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/bep/simplecobra"
 	"github.com/esnet/gdg/cli/backup"
@@ -19,7 +22,10 @@ func Execute(args []string, options ...support.RootOption) error {
 		return err
 	}
 
-	cd, err := x.Execute(context.Background(), args)
+	// interrupting gdg cancels the requests in flight, the command then reports the entities it completed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	cd, err := x.Execute(ctx, args)
 
 	if err != nil || len(args) == 0 {
 		if cd != nil {
@@ -32,7 +38,7 @@ func Execute(args []string, options ...support.RootOption) error {
 }
 
 // getNewRootCmd creates the root command with name "gdg" and subcommands for version,
-// default config, tools, and backup utilities.
+// default config, tools, backup utilities and sync.
 func getNewRootCmd() *support.RootCommand {
 	return &support.RootCommand{
 		NameP: "gdg",
@@ -41,6 +47,7 @@ func getNewRootCmd() *support.RootCommand {
 			newDefaultConfig(),
 			tools.NewToolsCommand(),
 			backup.NewBackupCommand(),
+			newSyncCmd(),
 		},
 	}
 }
@@ -0,0 +1,79 @@
+package support
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/esnet/gdg/internal/service"
+	"github.com/samber/lo"
+)
+
+// Exit codes returned by the gdg binary
+const (
+	// ExitOK the command completed and every entity was processed
+	ExitOK = 0
+	// ExitError the command failed
+	ExitError = 1
+	// ExitPartialFailure the command completed but one or more entities failed to be processed
+	ExitPartialFailure = 2
+	// ExitConfigError the configuration is invalid or the Grafana client could not be created
+	ExitConfigError = 3
+	// ExitInterrupted the command was cancelled or timed out before completing
+	ExitInterrupted = 4
+)
+
+// CommandError an error carrying the exit code the process should terminate with
+type CommandError struct {
+	Code int
+	Err  error
+}
+
+func (e *CommandError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CommandError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code matching the error returned by a command
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var cmdErr *CommandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr.Code
+	}
+	return ExitError
+}
+
+// resultsError returns an error when any entity processed by the command failed, nil otherwise
+func resultsError(results []service.EntityResult) error {
+	counts := lo.CountValuesBy(results, func(item service.EntityResult) string { return item.Status })
+	if len(results) > 0 {
+		slog.Info("Processed entities", "succeeded", counts[service.ResultSucceeded],
+			"skipped", counts[service.ResultSkipped], "failed", counts[service.ResultFailed])
+	}
+	if counts[service.ResultFailed] == 0 {
+		return nil
+	}
+	return &CommandError{
+		Code: ExitPartialFailure,
+		Err:  fmt.Errorf("%d of %d entities failed to be processed", counts[service.ResultFailed], len(results)),
+	}
+}
+
+// interruptedError logs the entities completed before the command was cancelled or timed out, and returns an error
+// carrying ExitInterrupted.
+func interruptedError(cause error, results []service.EntityResult) error {
+	completed := lo.FilterMap(results, func(item service.EntityResult, _ int) (string, bool) {
+		return fmt.Sprintf("%s/%s", item.Resource, item.Name), item.Status == service.ResultSucceeded
+	})
+	slog.Warn("Command was interrupted, only the listed entities were completed", "completed", completed)
+	return &CommandError{
+		Code: ExitInterrupted,
+		Err:  fmt.Errorf("command interrupted after completing %d entities: %w", len(completed), cause),
+	}
+}
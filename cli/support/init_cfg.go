@@ -1,7 +1,7 @@
 package support
 
 import (
-	"log"
+	"log/slog"
 	"os"
 
 	"github.com/esnet/gdg/internal/config"
@@ -18,14 +18,17 @@ func (c *RootCommand) InitConfiguration(cmd *cobra.Command) {
 		c.configObj = config.InitGdgConfig(configOverride)
 	}
 	if contextOverride != "" {
-		_, ok := c.configObj.GetContexts()[contextOverride]
-		if !ok {
-			log.Fatalf("context %s was not found", contextOverride)
+		if err := c.configObj.SetContext(contextOverride); err != nil {
+			slog.Error("context was not found", "context", contextOverride)
+			os.Exit(ExitConfigError)
 		}
-
-		c.configObj.SetContext(contextOverride)
 	}
 
 	appconfig.InitializeAppLogger(os.Stdout, os.Stderr, c.configObj.IsDebug())
-	c.configObj.GetDefaultGrafanaConfig().Validate()
+	grafanaConf, err := c.configObj.GetGrafanaConfig()
+	if err != nil {
+		slog.Error("invalid configuration", "err", err)
+		os.Exit(ExitConfigError)
+	}
+	grafanaConf.Validate()
 }
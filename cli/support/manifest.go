@@ -0,0 +1,49 @@
+package support
+
+import (
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/esnet/gdg/internal/service"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// globalFlags are not recorded as filters in the backup manifest
+var globalFlags = []string{"config", "context", "output"}
+
+// isBackupCommand returns true if the command is the given action of a backup entity, ie. gdg backup dashboards download
+func isBackupCommand(command *cobra.Command, action string) bool {
+	return command.Name() == action && slices.Contains(strings.Fields(command.CommandPath()), "backup")
+}
+
+// recordManifest updates the backup manifest after a successful download
+func (c *RootCommand) recordManifest(command *cobra.Command) error {
+	recorder, ok := c.app.(service.ManifestRecorder)
+	if !ok || !isBackupCommand(command, "download") {
+		return nil
+	}
+	filters := make(map[string]string)
+	command.Flags().Visit(func(flag *pflag.Flag) {
+		if !slices.Contains(globalFlags, flag.Name) {
+			filters[flag.Name] = flag.Value.String()
+		}
+	})
+	return recorder.WriteManifest(command.CommandPath(), filters)
+}
+
+// verifyManifest warns about version mismatches and missing files of the backup prior to an upload
+func (c *RootCommand) verifyManifest(command *cobra.Command) {
+	recorder, ok := c.app.(service.ManifestRecorder)
+	if !ok || !isBackupCommand(command, "upload") {
+		return
+	}
+	warnings, err := recorder.VerifyManifest()
+	if err != nil {
+		slog.Warn("Unable to verify backup manifest", "err", err)
+	}
+	for _, warning := range warnings {
+		slog.Warn("Backup manifest mismatch", "warning", warning)
+	}
+}
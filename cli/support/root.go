@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
 
 	"github.com/bep/simplecobra"
@@ -24,6 +25,8 @@ type RootCommand struct {
 
 	configObj *domain.GDGAppConfiguration
 	app       service.GrafanaService
+	// storageApp manages the backups without contacting Grafana, see StorageSvc
+	storageApp *service.DashNGoImpl
 
 	ctx                  context.Context
 	initThis             *simplecobra.Commandeer
@@ -32,6 +35,9 @@ type RootCommand struct {
 	failRun              bool
 
 	TableObj table.Writer
+	// rendered holds the data passed to the last Render call, bufferJSON suppresses printing it as JSON
+	rendered   any
+	bufferJSON bool
 
 	CommandEntries []simplecobra.Commander
 }
@@ -47,23 +53,103 @@ func (c *RootCommand) SetUpTest(app service.GrafanaService) {
 	c.configObj = config.InitGdgConfig("testing")
 }
 
-// GrafanaSvc returns the configured GrafanaService instance, initializing it if nil.
+// SetUpTestService sets a mock GrafanaService, leaving the configuration to be loaded by the command being executed.
+// It only runs when the TESTING environment variable is set to "1".
+func (c *RootCommand) SetUpTestService(app service.GrafanaService) {
+	if os.Getenv("TESTING") != "1" {
+		return
+	}
+
+	c.app = app
+}
+
+// GrafanaSvc returns the configured GrafanaService instance, initializing it if nil.  The process exits with
+// ExitConfigError when no valid client can be created.
 func (c *RootCommand) GrafanaSvc() service.GrafanaService {
 	if c.app == nil {
-		c.app = service.NewDashNGo(c.configObj)
+		ctx := c.ctx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		app, err := service.NewDashNGo(ctx, c.configObj)
+		if err != nil {
+			slog.Error("Unable to create the Grafana client", "err", err)
+			os.Exit(ExitConfigError)
+		}
+		c.app = app
 	}
 	return c.app
 }
 
+// StorageSvc returns the service managing the backups of the current context.  Unless the Grafana service was already
+// created, the storage engine is configured without contacting Grafana.  The process exits with ExitConfigError when
+// no valid storage engine can be configured.
+func (c *RootCommand) StorageSvc() service.StorageApi {
+	if c.app != nil {
+		return c.app
+	}
+	if c.storageApp == nil {
+		ctx := c.ctx
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		app, err := service.NewStorageService(ctx, c.configObj)
+		if err != nil {
+			slog.Error("Unable to configure the storage engine", "err", err)
+			os.Exit(ExitConfigError)
+		}
+		c.storageApp = app
+	}
+	return c.storageApp
+}
+
+// commandContext returns the context of the running command, limited to the configured timeout.  Every request made
+// by the Grafana service is bound to it.
+func (c *RootCommand) commandContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if c.configObj != nil {
+		if timeout := c.configObj.GetAppGlobals().GetTimeout(); timeout > 0 {
+			ctx, cancel = context.WithTimeoutCause(ctx, timeout,
+				fmt.Errorf("command did not complete within the configured timeout of %s", timeout))
+		}
+	}
+	c.ctx = ctx
+	if app, ok := c.app.(*service.DashNGoImpl); ok {
+		c.app = app.WithContext(ctx)
+	}
+	return ctx, cancel
+}
+
 // ConfigSvc returns the root command's configuration object.
 func (c *RootCommand) ConfigSvc() *domain.GDGAppConfiguration {
 	return c.configObj
 }
 
+// UseOrganization runs the following commands against a copy of the Grafana service, and of the configuration, scoped
+// to the given organization.  The returned function restores the previous service and configuration.
+func (c *RootCommand) UseOrganization(name string) (func(), error) {
+	app, cfg := c.GrafanaSvc(), c.configObj
+	scoped, err := app.WithOrganization(name)
+	if err != nil {
+		return nil, err
+	}
+	c.app = scoped
+	if impl, ok := scoped.(*service.DashNGoImpl); ok {
+		c.configObj = impl.GetGdgConfig()
+	}
+	return func() {
+		c.app, c.configObj = app, cfg
+	}, nil
+}
+
 // Render outputs data as JSON if --output=json, otherwise renders a table.
 func (c *RootCommand) Render(command *cobra.Command, data any) {
+	c.rendered = data
 	output, _ := command.Flags().GetString("output")
 	if output == "json" {
+		if c.bufferJSON {
+			return
+		}
 		data, err := json.MarshalIndent(data, "", "    ")
 		if err != nil {
 			log.Fatal("unable to render result to JSON", err)
@@ -75,6 +161,43 @@ func (c *RootCommand) Render(command *cobra.Command, data any) {
 	}
 }
 
+// ResetTable replaces the table writer with an empty one using the given title, and clears the last rendered result.
+func (c *RootCommand) ResetTable(title string) {
+	c.TableObj = newTableWriter()
+	c.TableObj.SetTitle(title)
+	c.rendered = nil
+}
+
+// BufferOutput when enabled, Render records the result without printing JSON so the caller can consolidate it.
+func (c *RootCommand) BufferOutput(enabled bool) {
+	c.bufferJSON = enabled
+}
+
+// Rendered returns the data passed to the last Render call
+func (c *RootCommand) Rendered() any {
+	return c.rendered
+}
+
+func newTableWriter() table.Writer {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleLight)
+	return t
+}
+
+// commitStorage commits the changes made by the command, only applies to storage engines such as git
+func (c *RootCommand) commitStorage(command *cobra.Command) error {
+	var app any = c.app
+	if c.app == nil && c.storageApp != nil {
+		app = c.storageApp
+	}
+	committer, ok := app.(service.StorageCommitter)
+	if !ok {
+		return nil
+	}
+	return committer.CommitStorage(command.CommandPath())
+}
+
 // RootOption used to configure the Root Command struct
 type RootOption func(command *RootCommand)
 
@@ -129,9 +252,7 @@ func (c *RootCommand) Init(cd *simplecobra.Commandeer) error {
 	persistentFlags.StringP("context", "", "", "Context Override")
 	persistentFlags.StringP("output", "", "table", "output format: (table, json)")
 	if c.TableObj == nil {
-		c.TableObj = table.NewWriter()
-		c.TableObj.SetOutputMirror(os.Stdout)
-		c.TableObj.SetStyle(table.StyleLight)
+		c.TableObj = newTableWriter()
 	}
 
 	return nil
@@ -2,8 +2,10 @@ package support
 
 import (
 	"context"
+	"errors"
 
 	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/internal/service"
 	"github.com/spf13/cobra"
 )
 
@@ -42,7 +44,32 @@ func (c *SimpleCommand) Run(ctx context.Context, cd *simplecobra.Commandeer, arg
 	if c.RunFunc == nil {
 		return nil
 	}
-	return c.RunFunc(ctx, cd, c.RootCmd, args)
+	ctx, cancel := c.RootCmd.commandContext(ctx)
+	defer cancel()
+	c.RootCmd.verifyManifest(cd.CobraCommand)
+	if reporter, ok := c.RootCmd.app.(service.ResultReporter); ok {
+		reporter.ResetResults()
+	}
+	err := c.RunFunc(ctx, cd, c.RootCmd, args)
+	if ctx.Err() != nil {
+		// the manifest isn't updated as the backup is incomplete
+		var results []service.EntityResult
+		if reporter, ok := c.RootCmd.app.(service.ResultReporter); ok {
+			results = reporter.Results()
+		}
+		err = errors.Join(err, interruptedError(context.Cause(ctx), results))
+	} else {
+		if err == nil {
+			err = c.RootCmd.recordManifest(cd.CobraCommand)
+		}
+		if reporter, ok := c.RootCmd.app.(service.ResultReporter); ok && err == nil {
+			err = resultsError(reporter.Results())
+		}
+	}
+	if commitErr := c.RootCmd.commitStorage(cd.CobraCommand); commitErr != nil {
+		return errors.Join(err, commitErr)
+	}
+	return err
 }
 
 // Init initializes the SimpleCommand
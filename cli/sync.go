@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// newSyncCmd returns a command copying resources from one context to another without writing them to disk.
+func newSyncCmd() simplecobra.Commander {
+	description := "Copy resources from one context to another"
+	return &support.SimpleCommand{
+		NameP: "sync",
+		Short: description,
+		Long: description + ".  Resources are read from the source context into memory and uploaded to the target " +
+			"context, missing folders are created and references to connections are remapped onto the connections of the " +
+			"target context by name.  Supported resources: " + strings.Join(service.SyncResources(), ", "),
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Flags().String("from", "", "context to read the resources from")
+			cmd.Flags().String("to", "", "context to write the resources to")
+			cmd.Flags().StringToString("org-map", nil, "sync source organizations onto target organizations, ie. --org-map \"Main Org.=Staging\"")
+			cmd.Flags().String("promotion", "", "promotion rules file applied when uploading to the target context")
+			cmd.Flags().StringToString("connection-map", nil, "map source connections onto target connections, ie. --connection-map \"prometheus=prometheus-staging\"")
+		},
+		InitCFunc: func(cd *simplecobra.Commandeer, r *support.RootCommand) error {
+			r.InitConfiguration(cd.CobraCommand)
+			return nil
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			from, _ := cd.CobraCommand.Flags().GetString("from")
+			to, _ := cd.CobraCommand.Flags().GetString("to")
+			if from == "" || to == "" {
+				return errors.New("both --from and --to contexts are required")
+			}
+			orgMap, _ := cd.CobraCommand.Flags().GetStringToString("org-map")
+			connectionMap, _ := cd.CobraCommand.Flags().GetStringToString("connection-map")
+			promotion, _ := cd.CobraCommand.Flags().GetString("promotion")
+			slog.Info("Syncing resources between contexts", "from", from, "to", to)
+			results, err := rootCmd.GrafanaSvc().SyncContexts(service.SyncOptions{
+				From:          from,
+				To:            to,
+				Resources:     args,
+				Organizations: orgMap,
+				Connections:   connectionMap,
+				Promotion:     promotion,
+			})
+			if err != nil {
+				return err
+			}
+			rootCmd.ResetTable(fmt.Sprintf("Sync: %s -> %s", from, to))
+			rootCmd.TableObj.AppendHeader(table.Row{"source org", "target org", "resource", "status", "downloaded", "uploaded", "error"})
+			for _, result := range results {
+				rootCmd.TableObj.AppendRow(table.Row{
+					result.SourceOrganization, result.TargetOrganization, result.Resource,
+					result.Status, result.Downloaded, result.Uploaded, result.Error,
+				})
+			}
+			rootCmd.Render(cd.CobraCommand, results)
+			failed := lo.CountBy(results, func(item service.SyncResult) bool { return item.Status == service.SyncStatusFailed })
+			if failed > 0 {
+				return &support.CommandError{
+					Code: support.ExitPartialFailure,
+					Err:  fmt.Errorf("sync failed for %d of %d resources", failed, len(results)),
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/mocks"
+	"github.com/esnet/gdg/pkg/test_tooling"
+	"github.com/esnet/gdg/pkg/test_tooling/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncCommand(t *testing.T) {
+	assert.NoError(t, path.FixTestDir("cli", ".."))
+	execMe := func(mockSvc *mocks.GrafanaService, optionMockSvc func() support.RootOption) error {
+		expected := service.SyncOptions{
+			From:          "production",
+			To:            "qa",
+			Resources:     []string{"folders", "dashboards"},
+			Organizations: map[string]string{"Main Org.": "Staging"},
+			Connections:   map[string]string{},
+		}
+		mockSvc.EXPECT().SyncContexts(mock.MatchedBy(func(opts service.SyncOptions) bool {
+			return assert.ObjectsAreEqual(expected, opts)
+		})).Return([]service.SyncResult{
+			{SourceOrganization: "Main Org.", TargetOrganization: "Staging", Resource: "folders", Status: service.SyncStatusOK, Downloaded: 2, Uploaded: 2},
+			{SourceOrganization: "Main Org.", TargetOrganization: "Staging", Resource: "dashboards", Status: service.SyncStatusOK, Downloaded: 5, Uploaded: 5},
+		}, nil)
+		return Execute([]string{"sync", "--from", "production", "--to", "qa", "--org-map", "Main Org.=Staging", "folders", "dashboards"}, optionMockSvc())
+	}
+	outStr, closeReader := test_tooling.SetupAndExecuteMockingServices(t, execMe)
+	defer closeReader()
+
+	assert.True(t, strings.Contains(outStr, "Sync: production -> qa"))
+	assert.True(t, strings.Contains(outStr, "Staging"))
+	assert.True(t, strings.Contains(outStr, "dashboards"))
+}
+
+func TestSyncCommandFailures(t *testing.T) {
+	assert.NoError(t, path.FixTestDir("cli", ".."))
+	testSvc := new(mocks.GrafanaService)
+	optionMockSvc := func(response *support.RootCommand) {
+		response.SetUpTest(testSvc)
+	}
+	_, w, cleanup := test_tooling.InterceptStdout()
+	defer cleanup()
+	defer w.Close()
+
+	err := Execute([]string{"sync", "--from", "production"}, optionMockSvc)
+	assert.ErrorContains(t, err, "both --from and --to contexts are required")
+
+	testSvc.EXPECT().SyncContexts(mock.Anything).Return([]service.SyncResult{
+		{Resource: "folders", Status: service.SyncStatusOK},
+		{Resource: "dashboards", Status: service.SyncStatusFailed, Error: "boom"},
+	}, nil)
+	err = Execute([]string{"sync", "--from", "production", "--to", "qa"}, optionMockSvc)
+	assert.ErrorContains(t, err, "sync failed for 1 of 2 resources")
+}
+
+func TestSyncCommandLoadsConfiguration(t *testing.T) {
+	assert.NoError(t, path.FixTestDir("cli", ".."))
+	testSvc := new(mocks.GrafanaService)
+	var root *support.RootCommand
+	optionMockSvc := func(response *support.RootCommand) {
+		root = response
+		response.SetUpTestService(testSvc)
+	}
+	_, w, cleanup := test_tooling.InterceptStdout()
+	defer cleanup()
+	defer w.Close()
+
+	testSvc.EXPECT().SyncContexts(mock.Anything).Return([]service.SyncResult{
+		{Resource: "folders", Status: service.SyncStatusOK},
+	}, nil)
+	err := Execute([]string{"sync", "--config", "testing", "--context", "qa", "--from", "production", "--to", "qa"}, optionMockSvc)
+	assert.NoError(t, err)
+	if assert.NotNil(t, root.ConfigSvc(), "sync loads the configuration") {
+		assert.Equal(t, "qa", root.ConfigSvc().GetContext())
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"sort"
 	"strconv"
@@ -48,7 +47,10 @@ func newListServiceAccountCmd() simplecobra.Commander {
 		CommandsList: []simplecobra.Commander{},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "service name", "role", "tokens", "token id", "token name", "expiration"})
-			apiKeys := rootCmd.GrafanaSvc().ListServiceAccounts()
+			apiKeys, err := rootCmd.GrafanaSvc().ListServiceAccounts()
+			if err != nil {
+				return err
+			}
 			sort.SliceStable(apiKeys, func(i, j int) bool {
 				return apiKeys[i].ServiceAccount.ID < apiKeys[j].ServiceAccount.ID
 			})
@@ -94,7 +96,7 @@ func newDeleteServiceAccountsCmd() simplecobra.Commander {
 			idStr := args[0]
 			id, err := strconv.ParseInt(idStr, 10, 64)
 			if err != nil {
-				log.Fatalf("unable to parse %s as a valid numeric value", idStr)
+				return fmt.Errorf("unable to parse %s as a valid numeric value", idStr)
 			}
 
 			slog.Info("Deleting Service Accounts for context", "context", rootCmd.ConfigSvc().GetContext(),
@@ -119,7 +121,10 @@ func newClearServiceAccountsCmd() simplecobra.Commander {
 		Long:         description,
 		CommandsList: []simplecobra.Commander{},
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			savedFiles := rootCmd.GrafanaSvc().DeleteAllServiceAccounts()
+			savedFiles, err := rootCmd.GrafanaSvc().DeleteAllServiceAccounts()
+			if err != nil {
+				return err
+			}
 			slog.Info("Delete Service Accounts for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"type", "filename"})
 			if len(savedFiles) == 0 {
@@ -163,11 +168,11 @@ func newServiceAccount() simplecobra.Commander {
 			}
 
 			if !validBasicRole(role) {
-				log.Fatalf("Invalid role specified, '%s'.  Valid roles are:[%s]", role, strings.Join(getBasicRoles(), ", "))
+				return fmt.Errorf("Invalid role specified, '%s'.  Valid roles are:[%s]", role, strings.Join(getBasicRoles(), ", "))
 			}
 			serviceAcct, err := rootCmd.GrafanaSvc().CreateServiceAccount(name, role, expiration)
 			if err != nil {
-				log.Fatal("unable to create api key", "error", err)
+				return fmt.Errorf("unable to create api key: %w", err)
 			} else {
 
 				rootCmd.TableObj.AppendHeader(table.Row{"id", "name", "role"})
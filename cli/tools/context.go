@@ -166,8 +166,7 @@ func newSetContext() simplecobra.Commander {
 				return errors.New("requires a context argument")
 			}
 			contextEntry := args[0]
-			rootCmd.ConfigSvc().ChangeContext(contextEntry)
-			return nil
+			return rootCmd.ConfigSvc().ChangeContext(contextEntry)
 		},
 	}
 }
@@ -28,7 +28,10 @@ func newServerInfoCmd() simplecobra.Commander {
 		Short: "server health info",
 		Long:  "server health info",
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
-			result := rootCmd.GrafanaSvc().GetServerInfo()
+			result, err := rootCmd.GrafanaSvc().GetServerInfo()
+			if err != nil {
+				return err
+			}
 			for key, value := range result {
 				slog.Info("", key, value)
 			}
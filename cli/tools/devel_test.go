@@ -19,7 +19,7 @@ func TestDevelSrvInfo(t *testing.T) {
 		expected["Commit"] = "commit"
 		expected["Version"] = "version"
 
-		mock.EXPECT().GetServerInfo().Return(expected)
+		mock.EXPECT().GetServerInfo().Return(expected, nil)
 		err := cli.Execute([]string{"tools", "devel", "srvinfo"}, optionMockSvc())
 		return err
 	}
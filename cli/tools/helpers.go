@@ -2,8 +2,8 @@ package tools
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"os"
 
@@ -112,26 +112,31 @@ func newCipherEncode() simplecobra.Commander {
 			fileName, _ := cd.CobraCommand.Flags().GetString("file")
 			value, _ := cd.CobraCommand.Flags().GetString("value")
 			if fileName != "" && value != "" {
-				log.Fatal("either a value or a file must be specified, not both")
+				return errors.New("either a value or a file must be specified, not both")
 			}
 			if value != "" {
-				result := rootCmd.GrafanaSvc().EncodeValue(value)
+				result, err := rootCmd.GrafanaSvc().EncodeValue(value)
+				if err != nil {
+					return err
+				}
 				slog.Info("Encoded result:")
 				fmt.Println(result)
 			} else {
 				data, err := os.ReadFile(fileName) // #nosec G304
 				if err != nil {
-					log.Fatal("Error reading file", "file", fileName, "err", err)
+					return fmt.Errorf("error reading file %s: %w", fileName, err)
 				}
 
-				result := rootCmd.GrafanaSvc().EncodeValue(string(data))
+				result, err := rootCmd.GrafanaSvc().EncodeValue(string(data))
+				if err != nil {
+					return err
+				}
 				if result != "" {
 					err = os.WriteFile(fileName, []byte(result), 0o600)
 					if err != nil {
-						log.Fatal("Error writing file", "file", fileName, "err", err)
-					} else {
-						slog.Info("File has been encrypted", "file", fileName)
+						return fmt.Errorf("error writing file %s: %w", fileName, err)
 					}
+					slog.Info("File has been encrypted", "file", fileName)
 				}
 			}
 
@@ -149,26 +154,31 @@ func newCipherDecode() simplecobra.Commander {
 			fileName, _ := cd.CobraCommand.Flags().GetString("file")
 			value, _ := cd.CobraCommand.Flags().GetString("value")
 			if fileName != "" && value != "" {
-				log.Fatal("either a value or a file must be specified, not both")
+				return errors.New("either a value or a file must be specified, not both")
 			}
 			if value != "" {
-				result := rootCmd.GrafanaSvc().DecodeValue(value)
+				result, err := rootCmd.GrafanaSvc().DecodeValue(value)
+				if err != nil {
+					return err
+				}
 				slog.Info("Decoded result")
 				fmt.Println(result)
 			} else {
 				data, err := os.ReadFile(fileName) // #nosec G304
 				if err != nil {
-					log.Fatal("Error reading file", "file", fileName, "err", err)
+					return fmt.Errorf("error reading file %s: %w", fileName, err)
 				}
 
-				result := rootCmd.GrafanaSvc().DecodeValue(string(data))
+				result, err := rootCmd.GrafanaSvc().DecodeValue(string(data))
+				if err != nil {
+					return err
+				}
 				if result != "" {
 					err = os.WriteFile(fileName, []byte(result), 0o600)
 					if err != nil {
-						log.Fatal("Error writing file", "file", fileName, "err", err)
-					} else {
-						slog.Info("File has been decrypted", "file", fileName)
+						return fmt.Errorf("error writing file %s: %w", fileName, err)
 					}
+					slog.Info("File has been decrypted", "file", fileName)
 				}
 			}
 			return nil
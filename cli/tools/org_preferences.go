@@ -2,7 +2,8 @@ package tools
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/bep/simplecobra"
@@ -48,15 +49,15 @@ func newUpdateOrgPreferenceCmd() simplecobra.Commander {
 			theme, _ := cd.CobraCommand.Flags().GetString("theme")
 			weekstart, _ := cd.CobraCommand.Flags().GetString("weekstart")
 			if org == "" {
-				log.Fatal("--orgName is a required parameter")
+				return errors.New("--orgName is a required parameter")
 			}
 			if home != "" && theme != "" && weekstart == "" {
-				log.Fatal("At least one of [--homeDashUid, --theme, --weekstart] needs to be set")
+				return errors.New("At least one of [--homeDashUid, --theme, --weekstart] needs to be set")
 			}
 
 			preferences, err := rootCmd.GrafanaSvc().GetOrgPreferences(org)
 			if err != nil {
-				log.Fatal(err.Error())
+				return err
 			}
 			if home != "" {
 				preferences.HomeDashboardUID = home
@@ -70,7 +71,7 @@ func newUpdateOrgPreferenceCmd() simplecobra.Commander {
 
 			err = rootCmd.GrafanaSvc().UploadOrgPreferences(org, preferences)
 			if err != nil {
-				log.Fatalf("Failed to update org preferences, %v", err)
+				return fmt.Errorf("Failed to update org preferences, %v", err)
 			}
 			slog.Info("Preferences update for organization", slog.Any("organization", org))
 
@@ -92,7 +93,7 @@ func newGetOrgPreferenceCmd() simplecobra.Commander {
 
 			pref, err := rootCmd.GrafanaSvc().GetOrgPreferences(orgName)
 			if err != nil {
-				log.Fatal(err.Error())
+				return err
 			}
 
 			rootCmd.TableObj.AppendHeader(table.Row{"field", "value"})
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"strconv"
 	"strings"
@@ -45,7 +44,10 @@ func newGetUserOrgCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Listing organizations for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "name"})
-			org := rootCmd.GrafanaSvc().GetUserOrganization()
+			org, err := rootCmd.GrafanaSvc().GetUserOrganization()
+			if err != nil {
+				return err
+			}
 			if org == nil {
 				slog.Info("No organizations found")
 			} else {
@@ -72,11 +74,14 @@ func newListUsers() simplecobra.Commander {
 			}
 			orgId, err := strconv.ParseInt(args[0], 10, 64)
 			if err != nil {
-				log.Fatal("unable to parse orgId to numeric value")
+				return errors.New("unable to parse orgId to numeric value")
 			}
 			slog.Info("Listing org users for context", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "login", "orgId", "name", "email", "role"})
-			users := rootCmd.GrafanaSvc().ListOrgUsers(orgId)
+			users, err := rootCmd.GrafanaSvc().ListOrgUsers(orgId)
+			if err != nil {
+				return err
+			}
 			if len(users) == 0 {
 				slog.Info("No users found")
 			} else {
@@ -107,7 +112,7 @@ func newUpdateUserRoleCmd() simplecobra.Commander {
 			roleName := args[2]
 			userId, err := strconv.ParseInt(args[1], 10, 64)
 			if err != nil {
-				log.Fatal("unable to parse userId to numeric value")
+				return errors.New("unable to parse userId to numeric value")
 			}
 			slog.Info("Updating User role for context", slog.Any("context", rootCmd.ConfigSvc().GetContext()))
 			rootCmd.TableObj.AppendHeader(table.Row{"login", "orgId", "name", "email", "role"})
@@ -139,7 +144,7 @@ func newAddUserRoleCmd() simplecobra.Commander {
 			userId, err := strconv.ParseInt(args[1], 10, 64)
 			role := args[2]
 			if err != nil {
-				log.Fatal("unable to parse userId to numeric value")
+				return errors.New("unable to parse userId to numeric value")
 			}
 			slog.Info("Add user to org for context",
 				slog.Any("userId", userId),
@@ -148,7 +153,7 @@ func newAddUserRoleCmd() simplecobra.Commander {
 				slog.Any("organization", rootCmd.ConfigSvc().GetDefaultGrafanaConfig().OrganizationName),
 			)
 			if !validBasicRole(role) {
-				log.Fatalf("Invalid role specified, '%s'.  Valid roles are:[%s]", role, strings.Join(getBasicRoles(), ", "))
+				return fmt.Errorf("Invalid role specified, '%s'.  Valid roles are:[%s]", role, strings.Join(getBasicRoles(), ", "))
 			}
 			rootCmd.TableObj.AppendHeader(table.Row{"login", "orgId", "name", "email", "role"})
 			err = rootCmd.GrafanaSvc().AddUserToOrg(role, orgSlug, userId)
@@ -178,7 +183,7 @@ func newDeleteUserRoleCmd() simplecobra.Commander {
 			orgSlug := args[0]
 			userId, err := strconv.ParseInt(args[1], 10, 64)
 			if err != nil {
-				log.Fatal("unable to parse userId to numeric value")
+				return errors.New("unable to parse userId to numeric value")
 			}
 			slog.Info("Update org for context", "context", rootCmd.ConfigSvc().GetContext())
 			err = rootCmd.GrafanaSvc().DeleteUserFromOrg(orgSlug, userId)
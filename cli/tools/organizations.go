@@ -3,7 +3,7 @@ package tools
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"log/slog"
 
 	"github.com/bep/simplecobra"
@@ -57,12 +57,17 @@ func newSetOrgCmd() simplecobra.Commander {
 				}
 				err := rootCmd.GrafanaSvc().SetOrganizationByName(orgName, useSlug)
 				if err != nil {
-					log.Fatal("unable to set Org ID, ", err.Error())
+					return fmt.Errorf("unable to set Org ID: %w", err)
 				}
 			}
 
-			rootCmd.GrafanaSvc().InitOrganizations()
-			userOrg := rootCmd.GrafanaSvc().GetUserOrganization()
+			if err := rootCmd.GrafanaSvc().InitOrganizations(); err != nil {
+				return err
+			}
+			userOrg, err := rootCmd.GrafanaSvc().GetUserOrganization()
+			if err != nil {
+				return err
+			}
 			slog.Info("New Org is now set to", slog.String("orgName", userOrg.Name))
 
 			return nil
@@ -79,7 +84,10 @@ func newGetTokenOrgCmd() simplecobra.Commander {
 		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
 			slog.Info("Display token organization for context'", "context", rootCmd.ConfigSvc().GetContext())
 			rootCmd.TableObj.AppendHeader(table.Row{"id", "name"})
-			org := rootCmd.GrafanaSvc().GetTokenOrganization()
+			org, err := rootCmd.GrafanaSvc().GetTokenOrganization()
+			if err != nil {
+				return err
+			}
 			if org == nil {
 				slog.Info("No tokens were found")
 			} else {
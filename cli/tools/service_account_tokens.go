@@ -3,7 +3,7 @@ package tools
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"log/slog"
 	"strconv"
 
@@ -46,13 +46,16 @@ func newDeleteServiceAccountTokensCmd() simplecobra.Commander {
 			idStr := args[0]
 			id, err := strconv.ParseInt(idStr, 10, 64)
 			if err != nil {
-				log.Fatalf("unable to parse %s as a valid numeric value", idStr)
+				return fmt.Errorf("unable to parse %s as a valid numeric value", idStr)
 			}
 
 			slog.Info("Deleting Service Accounts Tokens for context",
 				"serviceAccountId", id,
 				"context", rootCmd.ConfigSvc().GetContext())
-			savedFiles := rootCmd.GrafanaSvc().DeleteServiceAccountTokens(id)
+			savedFiles, err := rootCmd.GrafanaSvc().DeleteServiceAccountTokens(id)
+			if err != nil {
+				return err
+			}
 			rootCmd.TableObj.AppendHeader(table.Row{"serviceID", "type", "token_name"})
 			if len(savedFiles) == 0 {
 				slog.Info("No Service Accounts tokens found")
@@ -91,7 +94,7 @@ func newServiceAccountTokenCmd() simplecobra.Commander {
 
 			serviceID, err := strconv.ParseInt(serviceIDRaw, 10, 64)
 			if err != nil {
-				log.Fatal("unable to parse serviceID, make sure it's a numeric value")
+				return errors.New("unable to parse serviceID, make sure it's a numeric value")
 			}
 			expiration, err = strconv.ParseInt(ttl, 10, 64)
 			if err != nil {
@@ -100,7 +103,7 @@ func newServiceAccountTokenCmd() simplecobra.Commander {
 
 			key, err := rootCmd.GrafanaSvc().CreateServiceAccountToken(serviceID, name, expiration)
 			if err != nil {
-				log.Fatal("unable to create api key", "err", err)
+				return fmt.Errorf("unable to create api key: %w", err)
 			} else {
 
 				rootCmd.TableObj.AppendHeader(table.Row{"serviceID", "token_id", "name", "token"})
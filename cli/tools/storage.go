@@ -0,0 +1,162 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newStorageCommand() simplecobra.Commander {
+	description := "Manage the storage engine of the current context"
+	return &support.SimpleCommand{
+		NameP: "storage",
+		Short: description,
+		Long:  description,
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"s"}
+		},
+		CommandsList: []simplecobra.Commander{
+			newReEncryptCmd(),
+			newPruneCmd(),
+			newCopyCmd(),
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			return cd.CobraCommand.Help()
+		},
+	}
+}
+
+func newReEncryptCmd() simplecobra.Commander {
+	description := "Re-encrypt all backups of the context using the configured encryption key"
+	return &support.SimpleCommand{
+		NameP: "re-encrypt",
+		Short: description,
+		Long: description + ".  Files are read using the --previous-* settings, when omitted plaintext files and files " +
+			"encrypted with the current key are rewritten, which migrates an existing backup.",
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"reencrypt", "rotate"}
+			cmd.PersistentFlags().StringP("previous-encryption", "", "", "encryption the files were written with: aes-gcm, age")
+			cmd.PersistentFlags().StringP("previous-key-file", "", "", "file containing the previous encryption key or age identity")
+			cmd.PersistentFlags().StringP("previous-key-env", "", "", "environment variable containing the previous encryption key or age identity")
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			encryption, _ := cd.CobraCommand.Flags().GetString("previous-encryption")
+			keyFile, _ := cd.CobraCommand.Flags().GetString("previous-key-file")
+			keyEnv, _ := cd.CobraCommand.Flags().GetString("previous-key-env")
+			previous := map[string]string{
+				storage.Encryption:        encryption,
+				storage.EncryptionKeyFile: keyFile,
+				storage.EncryptionKeyEnv:  keyEnv,
+			}
+			slog.Info("Re-encrypting backups for context", "context", rootCmd.ConfigSvc().GetContext())
+			files, err := rootCmd.StorageSvc().ReEncryptStorage(previous)
+			rootCmd.TableObj.AppendHeader(table.Row{"file"})
+			for _, file := range files {
+				rootCmd.TableObj.AppendRow(table.Row{file})
+			}
+			if len(files) > 0 {
+				rootCmd.Render(cd.CobraCommand, files)
+			}
+			if err != nil {
+				slog.Error("unable to re-encrypt all files", "reEncrypted", len(files), "err", err)
+				return err
+			}
+			if len(files) == 0 {
+				slog.Info("No files were found")
+			}
+			return nil
+		},
+	}
+}
+
+func newPruneCmd() simplecobra.Commander {
+	description := "Remove the snapshots that aren't retained by the given rules"
+	return &support.SimpleCommand{
+		NameP: "prune",
+		Short: description,
+		Long: description + ".  The most recent snapshot of every day, week and month is kept until the number of " +
+			"days, weeks and months is reached, ie. --daily 7 --weekly 4 --monthly 12.  The latest snapshot is never removed.",
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.PersistentFlags().IntP("last", "", 0, "number of most recent snapshots to keep")
+			cmd.PersistentFlags().IntP("daily", "", 0, "number of daily snapshots to keep")
+			cmd.PersistentFlags().IntP("weekly", "", 0, "number of weekly snapshots to keep")
+			cmd.PersistentFlags().IntP("monthly", "", 0, "number of monthly snapshots to keep")
+			cmd.PersistentFlags().BoolP("dry-run", "", false, "list the snapshots that would be removed without removing them")
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			var policy storage.RetentionPolicy
+			policy.Last, _ = cd.CobraCommand.Flags().GetInt("last")
+			policy.Daily, _ = cd.CobraCommand.Flags().GetInt("daily")
+			policy.Weekly, _ = cd.CobraCommand.Flags().GetInt("weekly")
+			policy.Monthly, _ = cd.CobraCommand.Flags().GetInt("monthly")
+			dryRun, _ := cd.CobraCommand.Flags().GetBool("dry-run")
+			if policy.IsEmpty() {
+				return errors.New("at least one of [--last, --daily, --weekly, --monthly] needs to be set")
+			}
+			slog.Info("Pruning snapshots for context", "context", rootCmd.ConfigSvc().GetContext(), "dryRun", dryRun)
+			results, err := rootCmd.StorageSvc().PruneSnapshots(policy, dryRun)
+			rootCmd.TableObj.AppendHeader(table.Row{"snapshot", "status"})
+			for _, result := range results {
+				rootCmd.TableObj.AppendRow(table.Row{result.Name, result.Status})
+			}
+			if len(results) > 0 {
+				rootCmd.Render(cd.CobraCommand, results)
+			}
+			if err != nil {
+				return err
+			}
+			if len(results) == 0 {
+				slog.Info("No snapshots were found")
+			}
+			return nil
+		},
+	}
+}
+
+func newCopyCmd() simplecobra.Commander {
+	description := "Copy the backups of the context from one storage engine to another"
+	return &support.SimpleCommand{
+		NameP: "copy",
+		Short: description,
+		Long: description + ".  Engines are referenced by their storage_engine label, use `local` for the local disk.  " +
+			"Every file is verified against its source checksum, use --resume to skip files already copied by a previous run.",
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"cp", "migrate"}
+			cmd.PersistentFlags().StringP("from", "", "", "storage engine to copy from, ie. local")
+			cmd.PersistentFlags().StringP("to", "", "", "storage engine to copy to")
+			cmd.PersistentFlags().BoolP("resume", "", false, "skip files already present in the destination with the same content")
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			from, _ := cd.CobraCommand.Flags().GetString("from")
+			to, _ := cd.CobraCommand.Flags().GetString("to")
+			resume, _ := cd.CobraCommand.Flags().GetBool("resume")
+			if from == "" || to == "" {
+				return errors.New("both --from and --to need to be set")
+			}
+			slog.Info("Copying backups between storage engines", "context", rootCmd.ConfigSvc().GetContext(), "from", from, "to", to)
+			results, err := rootCmd.StorageSvc().CopyStorage(from, to, resume)
+			rootCmd.TableObj.AppendHeader(table.Row{"file", "status"})
+			counts := make(map[string]int)
+			for _, result := range results {
+				counts[result.Status]++
+				rootCmd.TableObj.AppendRow(table.Row{result.File, result.Status})
+			}
+			if len(results) > 0 {
+				rootCmd.Render(cd.CobraCommand, results)
+			}
+			slog.Info("Copy summary", "copied", counts[storage.CopyStatusCopied], "skipped", counts[storage.CopyStatusSkipped],
+				"failed", counts[storage.CopyStatusFailed])
+			if err != nil {
+				slog.Error("copy did not complete, rerun with --resume to continue", "err", err)
+				return err
+			}
+			return nil
+		},
+	}
+}
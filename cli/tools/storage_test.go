@@ -0,0 +1,66 @@
+package tools_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/esnet/gdg/cli"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/mocks"
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/esnet/gdg/pkg/test_tooling"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoragePrune(t *testing.T) {
+	execMe := func(mock *mocks.GrafanaService, optionMockSvc func() support.RootOption) error {
+		mock.EXPECT().PruneSnapshots(storage.RetentionPolicy{Daily: 7, Weekly: 4}, true).Return([]storage.SnapshotResult{
+			{Name: "20250102T000000Z", Status: "kept"},
+			{Name: "20250101T000000Z", Status: "pending"},
+		}, nil)
+		return cli.Execute([]string{"tools", "storage", "prune", "--daily", "7", "--weekly", "4", "--dry-run"}, optionMockSvc())
+	}
+	outStr, closeReader := test_tooling.SetupAndExecuteMockingServices(t, execMe)
+	defer closeReader()
+	assert.True(t, strings.Contains(outStr, "20250102T000000Z"))
+	assert.True(t, strings.Contains(outStr, "pending"))
+}
+
+func TestStoragePruneRequiresRule(t *testing.T) {
+	execMe := func(mock *mocks.GrafanaService, optionMockSvc func() support.RootOption) error {
+		err := cli.Execute([]string{"tools", "storage", "prune"}, optionMockSvc())
+		assert.ErrorContains(t, err, "needs to be set")
+		return nil
+	}
+	_, closeReader := test_tooling.SetupAndExecuteMockingServices(t, execMe)
+	defer closeReader()
+}
+
+func TestStorageCopy(t *testing.T) {
+	execMe := func(mock *mocks.GrafanaService, optionMockSvc func() support.RootOption) error {
+		mock.EXPECT().CopyStorage("local", "minio", true).Return([]storage.CopyResult{
+			{File: "test/data/users/bob.json", Status: storage.CopyStatusCopied},
+			{File: "test/data/users/alice.json", Status: storage.CopyStatusSkipped},
+		}, nil)
+		return cli.Execute([]string{"tools", "storage", "copy", "--from", "local", "--to", "minio", "--resume"}, optionMockSvc())
+	}
+	outStr, closeReader := test_tooling.SetupAndExecuteMockingServices(t, execMe)
+	defer closeReader()
+	assert.True(t, strings.Contains(outStr, "test/data/users/bob.json"))
+	assert.True(t, strings.Contains(outStr, "copied=1 skipped=1 failed=0"))
+}
+
+func TestVerify(t *testing.T) {
+	execMe := func(mock *mocks.GrafanaService, optionMockSvc func() support.RootOption) error {
+		mock.EXPECT().VerifyBackup().Return([]service.VerifyIssue{
+			{File: "org_main-org/connections/broken.json", Check: service.VerifyCheckJSON, Message: "file is not valid JSON"},
+		}, nil)
+		err := cli.Execute([]string{"tools", "verify"}, optionMockSvc())
+		assert.ErrorContains(t, err, "found 1 issues")
+		return nil
+	}
+	outStr, closeReader := test_tooling.SetupAndExecuteMockingServices(t, execMe)
+	defer closeReader()
+	assert.True(t, strings.Contains(outStr, "org_main-org/connections/broken.json"))
+}
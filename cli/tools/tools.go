@@ -30,6 +30,8 @@ func NewToolsCommand() simplecobra.Commander {
 			newAuthCmd(),
 			newOrgCommand(),
 			newHelpers(),
+			newStorageCommand(),
+			newVerifyCmd(),
 		},
 		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
 			cmd.Aliases = []string{"t"}
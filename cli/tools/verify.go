@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bep/simplecobra"
+	"github.com/esnet/gdg/cli/support"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newVerifyCmd() simplecobra.Commander {
+	description := "Verify the integrity of the backups of the current context without contacting Grafana"
+	return &support.SimpleCommand{
+		NameP: "verify",
+		Short: description,
+		Long: description + ".  Every file needs to be valid JSON stored where gdg writes it, library panels, folders, " +
+			"dashboards, connections and users referenced by other entities need to be part of the backup and files need " +
+			"to match the checksums of the manifest when one exists.",
+		WithCFunc: func(cmd *cobra.Command, r *support.RootCommand) {
+			cmd.Aliases = []string{"fsck"}
+		},
+		RunFunc: func(ctx context.Context, cd *simplecobra.Commandeer, rootCmd *support.RootCommand, args []string) error {
+			slog.Info("Verifying backups for context", "context", rootCmd.ConfigSvc().GetContext())
+			issues, err := rootCmd.StorageSvc().VerifyBackup()
+			if err != nil {
+				return err
+			}
+			if len(issues) == 0 {
+				slog.Info("No issues were found")
+				return nil
+			}
+			rootCmd.TableObj.AppendHeader(table.Row{"file", "check", "message"})
+			for _, issue := range issues {
+				rootCmd.TableObj.AppendRow(table.Row{issue.File, issue.Check, issue.Message})
+			}
+			rootCmd.Render(cd.CobraCommand, issues)
+			return fmt.Errorf("backup verification found %d issues", len(issues))
+		},
+	}
+}
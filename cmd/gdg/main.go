@@ -1,15 +1,17 @@
 package main
 
 import (
-	"log"
+	"log/slog"
 	"os"
 
 	"github.com/esnet/gdg/cli"
+	"github.com/esnet/gdg/cli/support"
 )
 
 func main() {
 	err := cli.Execute(os.Args[1:])
 	if err != nil {
-		log.Fatalf("Error: %s", err)
+		slog.Error("Error", "err", err)
 	}
+	os.Exit(support.ExitCode(err))
 }
@@ -1,7 +1,7 @@
 package api
 
 import (
-	"crypto/tls"
+	"context"
 	"net/http"
 
 	"github.com/carlmjohnson/requests"
@@ -12,23 +12,39 @@ import (
 type ExtendedApi struct {
 	appCfg *domain.GDGAppConfiguration
 	debug  bool
+	ctx    context.Context // every request is bound to it
+	// transport shared with the OpenAPI client so that retries and rate limits apply to every request
+	transport http.RoundTripper
 }
 
-func NewExtendedApi(cfg *domain.GDGAppConfiguration) *ExtendedApi {
+func NewExtendedApi(ctx context.Context, cfg *domain.GDGAppConfiguration, transport http.RoundTripper) *ExtendedApi {
 	o := ExtendedApi{
-		appCfg: cfg,
-		debug:  cfg.IsApiDebug(),
+		appCfg:    cfg,
+		debug:     cfg.IsApiDebug(),
+		ctx:       ctx,
+		transport: transport,
 	}
 	return &o
 }
 
-// getRequestBuilder returns a requests.Builder preconfigured with Grafana URL, auth, and optional TLS settings.
+// fetch sends the request, limited to the configured request timeout
+func (extended *ExtendedApi) fetch(req *requests.Builder) error {
+	ctx := extended.ctx
+	if timeout := extended.appCfg.GetAppGlobals().GetRequestTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return req.Fetch(ctx)
+}
+
+// getRequestBuilder returns a requests.Builder preconfigured with Grafana URL, auth, and the shared transport.
 func (extended *ExtendedApi) getRequestBuilder() *requests.Builder {
-	req := requests.URL(extended.appCfg.GetDefaultGrafanaConfig().GetURL())
-	if extended.appCfg.IgnoreSSL() {
-		customTransport := http.DefaultTransport.(*http.Transport).Clone()
-		customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
-		req = req.Transport(customTransport)
+	req := requests.URL(extended.appCfg.GetDefaultGrafanaConfig().GetURL()).
+		Transport(extended.transport)
+	// tokens of the provider are set by the shared transport
+	if extended.appCfg.GetDefaultGrafanaConfig().HasTokenProvider() {
+		return req
 	}
 	token := extended.appCfg.GetDefaultGrafanaConfig().GetAPIToken()
 
@@ -1,57 +1,63 @@
 package api
 
 import (
-	"context"
 	"errors"
+	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"time"
 
-	"github.com/avast/retry-go"
 	"github.com/grafana/grafana-openapi-client-go/models"
 )
 
+const (
+	// orgLookupAttempts and orgLookupDelay the organization lookup is retried using a longer backoff than the other
+	// requests, unless retries are configured
+	orgLookupAttempts = 5
+	orgLookupDelay    = 5 * time.Second
+)
+
 // GetConfiguredOrgId needed to call grafana API in order to configure the Grafana API correctly.  Invoking
 // this endpoint manually to avoid a circular dependency.
 func (extended *ExtendedApi) GetConfiguredOrgId(orgName string) (int64, error) {
 	var result []*models.UserOrgDTO
-	fetch := func() error {
-		req := extended.getRequestBuilder().
-			Path("api/user/orgs").
-			ToJSON(&result).
-			Method(http.MethodGet)
-
-		if extended.debug {
-			log.Printf("%v", req)
-		}
+	req := extended.getRequestBuilder().
+		Path("api/user/orgs").
+		ToJSON(&result).
+		Method(http.MethodGet)
 
-		return req.Fetch(context.Background())
+	if extended.debug {
+		log.Printf("%v", req)
 	}
 
 	/* There's something goofy here.  This seems to fail sporadically in grafana if we keep swapping orgs too fast.
 	   This is a safety check that should ideally never be triggered, but if the URL fails, then we retry a few times
 		before finally giving up.
 	*/
-	delay := time.Second * 5
-	var count uint = 5
+	attempts, delay := orgLookupAttempts, orgLookupDelay
 	// Giving user configured value preference over defaults
-	if extended.appCfg.GetAppGlobals().RetryCount != 0 {
-		count = uint(extended.appCfg.GetAppGlobals().RetryCount) // #nosec G115
+	globals := extended.appCfg.GetAppGlobals()
+	if globals.RetryCount > 0 {
+		attempts = globals.RetryCount
 	}
-	if extended.appCfg.GetAppGlobals().GetRetryTimeout() != time.Millisecond*100 {
-		delay = extended.appCfg.GetAppGlobals().GetRetryTimeout()
+	if globals.RetryDelay != "" {
+		delay = globals.GetRetryTimeout()
 	}
-	err := retry.Do(fetch,
-		retry.Attempts(count),
-		retry.Delay(delay),
-		retry.OnRetry(func(n uint, err error) {
-			slog.Info("Retrying request after error",
-				slog.String("orgName", orgName),
-				slog.Any("err", err))
-		}))
-	if err != nil {
-		return 0, err
+	for attempt := 1; ; attempt++ {
+		err := extended.fetch(req)
+		if err == nil {
+			break
+		}
+		if attempt >= attempts {
+			return 0, fmt.Errorf("unable to list the organizations of the user: %w", err)
+		}
+		slog.Info("Retrying request after error", slog.String("orgName", orgName), slog.Any("err", err))
+		select {
+		case <-extended.ctx.Done():
+			return 0, extended.ctx.Err()
+		case <-time.After(delay):
+		}
 	}
 	for _, entity := range result {
 		if entity.Name == orgName {
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfiguredOrgIdRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"orgId":1,"name":"Main Org."},{"orgId":4,"name":"testing"}]`))
+	}))
+	defer server.Close()
+
+	grafanaConf := domain.NewGrafanaConfig("test")
+	grafanaConf.URL = server.URL
+	grafanaConf.SetSecureAuth(domain.SecureModel{Token: "token"})
+	// requests aren't retried by the transport, the lookup still is
+	cfg := &domain.GDGAppConfiguration{
+		ContextName: "test",
+		Contexts:    map[string]*domain.GrafanaConfig{"test": grafanaConf},
+		Global:      &domain.AppGlobals{RetryDelay: "1ms"},
+	}
+	transport, err := NewTransport(cfg)
+	require.NoError(t, err)
+	orgID, err := NewExtendedApi(t.Context(), cfg, transport).GetConfiguredOrgId("testing")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), orgID)
+	assert.Equal(t, int32(3), calls.Load())
+
+	cfg.Global.RetryCount = 1
+	calls.Store(-10)
+	_, err = NewExtendedApi(t.Context(), cfg, transport).GetConfiguredOrgId("testing")
+	assert.ErrorContains(t, err, "unable to list the organizations")
+}
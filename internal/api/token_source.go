@@ -0,0 +1,132 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// tokenSource caches the tokens of a provider until they expire, or are rejected by Grafana.  Concurrent requests
+// wait for the token being fetched instead of fetching their own.
+type tokenSource struct {
+	mu    sync.Mutex
+	fetch func(ctx context.Context) (*oauth2.Token, error)
+	token *oauth2.Token
+}
+
+// newTokenSource returns the token source of the auth settings, the token endpoint is reached using the given client.
+func newTokenSource(grafanaConf *domain.GrafanaConfig, client *http.Client) (*tokenSource, error) {
+	auth := grafanaConf.Auth
+	if err := auth.Validate(); err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(auth.Type, domain.AuthTypeExec) {
+		return &tokenSource{fetch: execToken(auth.Exec)}, nil
+	}
+	cc := &clientcredentials.Config{
+		ClientID:       auth.OAuth2.ClientID,
+		ClientSecret:   grafanaConf.GetClientSecret(),
+		TokenURL:       auth.OAuth2.TokenURL,
+		Scopes:         auth.OAuth2.Scopes,
+		EndpointParams: url.Values{},
+	}
+	for k, v := range auth.OAuth2.EndpointParams {
+		cc.EndpointParams.Set(k, v)
+	}
+	return &tokenSource{fetch: func(ctx context.Context) (*oauth2.Token, error) {
+		return cc.Token(context.WithValue(ctx, oauth2.HTTPClient, client))
+	}}, nil
+}
+
+// Token returns a valid token, a new one is fetched when none is cached or the cached one expired
+func (t *tokenSource) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token.Valid() {
+		return t.token.AccessToken, nil
+	}
+	token, err := t.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("token provider returned an empty token")
+	}
+	t.token = token
+	return token.AccessToken, nil
+}
+
+// invalidate drops the cached token if it's still the given one, the next request fetches a new token
+func (t *tokenSource) invalidate(accessToken string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != nil && t.token.AccessToken == accessToken {
+		t.token = nil
+	}
+}
+
+// execCredential output of the command, either a kubectl ExecCredential or an OAuth2 token response
+type execCredential struct {
+	Status *struct {
+		Token               string     `json:"token"`
+		ExpirationTimestamp *time.Time `json:"expirationTimestamp"`
+	} `json:"status"`
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// execToken returns a function running the command and parsing the token it prints.  Plain text tokens don't expire,
+// they are cached until Grafana rejects them.
+func execToken(settings *domain.ExecSettings) func(ctx context.Context) (*oauth2.Token, error) {
+	return func(ctx context.Context) (*oauth2.Token, error) {
+		cmd := exec.CommandContext(ctx, settings.Command, settings.Args...) // #nosec G204
+		cmd.Env = os.Environ()
+		for k, v := range settings.GetEnv() {
+			cmd.Env = append(cmd.Env, k+"="+v)
+		}
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("token command %s failed: %w: %s", settings.Command, err, strings.TrimSpace(stderr.String()))
+		}
+		return parseExecToken(stdout.Bytes())
+	}
+}
+
+// parseExecToken parses the output of a token command
+func parseExecToken(output []byte) (*oauth2.Token, error) {
+	output = bytes.TrimSpace(output)
+	if !bytes.HasPrefix(output, []byte("{")) {
+		return &oauth2.Token{AccessToken: string(output)}, nil
+	}
+	var credential execCredential
+	if err := json.Unmarshal(output, &credential); err != nil {
+		return nil, fmt.Errorf("invalid token command output: %w", err)
+	}
+	if credential.Status != nil {
+		token := &oauth2.Token{AccessToken: credential.Status.Token}
+		if credential.Status.ExpirationTimestamp != nil {
+			token.Expiry = *credential.Status.ExpirationTimestamp
+		}
+		return token, nil
+	}
+	token := &oauth2.Token{AccessToken: credential.AccessToken}
+	if credential.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(credential.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGrafanaStandIn returns a server accepting the requests whose Authorization header is one of the valid tokens
+func newGrafanaStandIn(t *testing.T, valid func(token string) bool) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		if _, err := fmt.Sscanf(r.Header.Get("Authorization"), "Bearer %s", &token); err != nil || !valid(token) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOAuth2TokenProvider(t *testing.T) {
+	var issued atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "grafana", r.PostForm.Get("audience"))
+		clientID, secret, _ := r.BasicAuth()
+		if clientID != "gdg" || secret != "s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, issued.Add(1))
+	}))
+	defer tokenServer.Close()
+	// the first token is revoked by Grafana
+	grafana := newGrafanaStandIn(t, func(token string) bool { return token == "token-2" })
+
+	grafanaConf := domain.NewGrafanaConfig("test")
+	grafanaConf.SetSecureAuth(domain.SecureModel{ClientSecret: "s3cr3t"})
+	grafanaConf.Auth = &domain.AuthSettings{Type: domain.AuthTypeOAuth2, OAuth2: &domain.OAuth2Settings{
+		TokenURL:       tokenServer.URL,
+		ClientID:       "gdg",
+		EndpointParams: map[string]string{"audience": "grafana"},
+	}}
+	transport, err := newContextTransport(grafanaConf, &domain.AppGlobals{RetryCount: -1})
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	for range 3 {
+		resp, err := client.Get(grafana.URL)
+		require.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, int32(2), issued.Load(), "the rejected token is refreshed, the new one is cached")
+
+	grafanaConf.SetSecureAuth(domain.SecureModel{ClientSecret: "wrong"})
+	transport, err = newContextTransport(grafanaConf, &domain.AppGlobals{RetryCount: -1})
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(grafana.URL)
+	assert.ErrorContains(t, err, "failed to obtain a token")
+}
+
+func TestExecTokenProvider(t *testing.T) {
+	grafana := newGrafanaStandIn(t, func(token string) bool { return token == "exec-token" })
+	calls := filepath.Join(t.TempDir(), "calls")
+	grafanaConf := domain.NewGrafanaConfig("test")
+	grafanaConf.Auth = &domain.AuthSettings{Type: domain.AuthTypeExec, Exec: &domain.ExecSettings{
+		Command: "sh",
+		Args:    []string{"-c", `echo >> "$CALLS"; printf '{"status":{"token":"%s"}}' "$TOKEN"`},
+		Env:     map[string]string{"TOKEN": "exec-token", "CALLS": calls},
+	}}
+	transport, err := newContextTransport(grafanaConf, &domain.AppGlobals{})
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	for range 2 {
+		resp, err := client.Get(grafana.URL)
+		require.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	data, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	assert.Equal(t, "\n", string(data), "the token is fetched once")
+
+	grafanaConf.Auth.Exec = &domain.ExecSettings{Command: "sh", Args: []string{"-c", "echo denied >&2; exit 1"}}
+	transport, err = newContextTransport(grafanaConf, &domain.AppGlobals{})
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(grafana.URL)
+	assert.ErrorContains(t, err, "denied")
+
+	grafanaConf.Auth = &domain.AuthSettings{Type: domain.AuthTypeExec}
+	_, err = newContextTransport(grafanaConf, &domain.AppGlobals{})
+	assert.ErrorContains(t, err, "requires a command")
+}
+
+func TestParseExecToken(t *testing.T) {
+	token, err := parseExecToken([]byte("plain-token\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "plain-token", token.AccessToken)
+	assert.True(t, token.Expiry.IsZero())
+
+	token, err = parseExecToken([]byte(`{"kind":"ExecCredential","status":{"token":"k8s","expirationTimestamp":"2030-01-02T03:04:05Z"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "k8s", token.AccessToken)
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), token.Expiry)
+
+	token, err = parseExecToken([]byte(`{"access_token":"oauth","expires_in":60}`))
+	require.NoError(t, err)
+	assert.Equal(t, "oauth", token.AccessToken)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), token.Expiry, 5*time.Second)
+
+	_, err = parseExecToken([]byte(`{"status":`))
+	assert.Error(t, err)
+}
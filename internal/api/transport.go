@@ -0,0 +1,290 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"golang.org/x/time/rate"
+)
+
+// maxRetryDelay longest backoff between two attempts, Retry-After values sent by Grafana included
+const maxRetryDelay = 30 * time.Second
+
+// Transport is shared by every request made to the Grafana instance of a context.  It limits the rate requests are
+// sent at and retries failed ones using an exponential backoff with jitter.  Rate limited requests (429) are retried
+// whatever their method since Grafana didn't process them, server and network errors only for idempotent methods.
+// When the context uses a token provider, its token is set on every request and refreshed once when rejected.
+type Transport struct {
+	next       http.RoundTripper
+	limiter    *rate.Limiter // nil when requests aren't rate limited
+	maxRetries int
+	baseDelay  time.Duration
+	headers    map[string]string // static headers set on every request
+	// headers of the proxy set on plain HTTP requests, HTTPS requests send them with the CONNECT request instead
+	proxyHeaders map[string]string
+	tokens       *tokenSource // nil when the context doesn't use a token provider
+	tokenHeader  string
+}
+
+// NewTransport returns the transport used to reach the Grafana instance of the current context, an error is returned
+// when the TLS or proxy settings of the context are invalid.
+func NewTransport(cfg *domain.GDGAppConfiguration) (*Transport, error) {
+	grafanaConf, err := cfg.GetGrafanaConfig()
+	if err != nil {
+		return nil, err
+	}
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig, err := newTLSConfig(grafanaConf.TLS, cfg.IgnoreSSL())
+	if err != nil {
+		return nil, err
+	}
+	base.TLSClientConfig = tlsConfig
+	var proxyHeaders map[string]string
+	if proxy := grafanaConf.Proxy; proxy != nil && proxy.URL != "" {
+		proxyURL, err := url.Parse(proxy.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		base.Proxy = http.ProxyURL(proxyURL)
+		proxyHeaders = proxy.GetHeaders()
+		base.ProxyConnectHeader = http.Header{}
+		for k, v := range proxyHeaders {
+			base.ProxyConnectHeader.Set(k, v)
+		}
+	}
+	globals := cfg.GetAppGlobals()
+	t := &Transport{
+		next:         base,
+		maxRetries:   globals.GetRetryCount(),
+		baseDelay:    globals.GetRetryTimeout(),
+		headers:      grafanaConf.GetHeaders(),
+		proxyHeaders: proxyHeaders,
+	}
+	if limit := grafanaConf.RateLimit; limit > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(limit), int(math.Ceil(limit)))
+	}
+	if grafanaConf.Auth != nil {
+		// the token endpoint is usually hosted elsewhere, the certificate is verified against its own name
+		tokenTransport := base.Clone()
+		tokenTransport.TLSClientConfig.ServerName = ""
+		if t.tokens, err = newTokenSource(grafanaConf, &http.Client{Transport: tokenTransport}); err != nil {
+			return nil, fmt.Errorf("invalid auth settings: %w", err)
+		}
+		t.tokenHeader = grafanaConf.Auth.GetHeader()
+	}
+	return t, nil
+}
+
+// newTLSConfig returns the TLS configuration trusting the system certificates and the CA file of the context, and
+// presenting its client certificate.
+func newTLSConfig(settings *domain.TLSSettings, insecure bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecure} // #nosec G402
+	if settings == nil {
+		return tlsConfig, nil
+	}
+	tlsConfig.ServerName = settings.ServerName
+	if settings.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		data, err := os.ReadFile(settings.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no PEM certificate found in CA file %s", settings.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if settings.CertFile != "" || settings.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(settings.CertFile, settings.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	// plain HTTP requests are sent to the proxy as is, no CONNECT request carries the proxy headers
+	plainProxied := len(t.proxyHeaders) > 0 && req.URL.Scheme == "http"
+	if len(t.headers) > 0 || plainProxied {
+		req = req.Clone(ctx)
+		for k, v := range t.headers {
+			req.Header.Set(k, v)
+		}
+		if plainProxied {
+			for k, v := range t.proxyHeaders {
+				req.Header.Set(k, v)
+			}
+		}
+	}
+	getBody := req.GetBody
+	if (t.maxRetries > 0 || t.tokens != nil) && getBody == nil {
+		var err error
+		if getBody, err = bufferBody(req); err != nil {
+			return nil, err
+		}
+	}
+	sent, refreshed := false, false
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		attemptReq := req
+		// the body of the original request was consumed when it was buffered or by the previous attempt
+		if getBody != nil && (sent || req.GetBody == nil) {
+			body, err := getBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read request body: %w", err)
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+		var token string
+		if t.tokens != nil {
+			var err error
+			if token, err = t.tokens.Token(ctx); err != nil {
+				return nil, fmt.Errorf("failed to obtain a token: %w", err)
+			}
+			if attemptReq == req {
+				attemptReq = req.Clone(ctx)
+			}
+			attemptReq.Header.Set(t.tokenHeader, "Bearer "+token)
+		}
+		resp, err := t.next.RoundTrip(attemptReq)
+		sent = true
+		// a rejected token is refreshed once right away, it may have been revoked or expired earlier than announced
+		if t.tokens != nil && !refreshed && err == nil && resp.StatusCode == http.StatusUnauthorized && ctx.Err() == nil {
+			refreshed = true
+			t.tokens.invalidate(token)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+			attempt--
+			continue
+		}
+		if attempt >= t.maxRetries || ctx.Err() != nil || !shouldRetry(req.Method, resp, err) {
+			return resp, err
+		}
+		delay := t.backoff(attempt, resp)
+		// waiting past the deadline of the request would only turn the last response into a timeout
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+		attrs := []any{"method", req.Method, "path", req.URL.Path, "attempt", attempt + 1, "delay", delay}
+		if err != nil {
+			attrs = append(attrs, "err", err)
+		} else {
+			attrs = append(attrs, "status", resp.StatusCode)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		slog.Info("Retrying Grafana request", attrs...)
+		if err = sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt, the one requested by Grafana when it sent a Retry-After header,
+// never more than maxRetryDelay
+func (t *Transport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(delay, maxRetryDelay)
+		}
+	}
+	delay := min(t.baseDelay<<attempt, maxRetryDelay)
+	if delay <= 0 {
+		return 0
+	}
+	// equal jitter, spreads retries of concurrent clients while always waiting at least half the delay
+	return delay/2 + rand.N(delay/2+1) // #nosec G404
+}
+
+// shouldRetry returns true when the attempt failed in a way that may succeed when sent again
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// isIdempotent returns true for methods that can be sent several times with the same effect
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// bufferBody reads the request body in memory so that it can be sent again, nil is returned when there's no body
+func bufferBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}, nil
+}
+
+// sleep waits for the delay, or until the context is done
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTransport returns the transport of a context using the given retry count and rate limit
+func newTestTransport(t *testing.T, retryCount int, rateLimit float64) *Transport {
+	grafanaConf := domain.NewGrafanaConfig("test")
+	grafanaConf.RateLimit = rateLimit
+	transport, err := newContextTransport(grafanaConf, &domain.AppGlobals{RetryCount: retryCount, RetryDelay: "1ms"})
+	require.NoError(t, err)
+	return transport
+}
+
+// newContextTransport returns the transport of a context using the given settings
+func newContextTransport(grafanaConf *domain.GrafanaConfig, globals *domain.AppGlobals) (*Transport, error) {
+	return NewTransport(&domain.GDGAppConfiguration{
+		ContextName: "test",
+		Contexts:    map[string]*domain.GrafanaConfig{"test": grafanaConf},
+		Global:      globals,
+	})
+}
+
+func TestTransportRetries(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(data))
+		switch calls.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport(t, 3, 0)}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, server.URL, io.NopCloser(strings.NewReader("dashboard")))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, []string{"dashboard", "dashboard", "dashboard"}, bodies, "every attempt sends the whole body")
+}
+
+func TestTransportDoesNotRetryNonIdempotent(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport(t, 3, 0)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// retries are disabled by a negative count
+	calls.Store(0)
+	client = &http.Client{Transport: newTestTransport(t, -1, 0)}
+	resp, err = client.Get(server.URL)
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestTransportRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	client := &http.Client{Transport: newTestTransport(t, 0, 20)}
+
+	start := time.Now()
+	for range 30 {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+	}
+	// the first 20 requests are sent at once, the remaining ones at 20 per second
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestRetryAfter(t *testing.T) {
+	delay, ok := retryAfter("2")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, delay)
+	delay, ok = retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Greater(t, delay, 59*time.Minute)
+	_, ok = retryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestTransportRetryAfterCapped(t *testing.T) {
+	transport := newTestTransport(t, 3, 0)
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"3600"}}}
+	assert.Equal(t, maxRetryDelay, transport.backoff(0, resp))
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: transport}
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	resp, err = client.Do(req)
+	require.NoError(t, err, "the response is returned rather than waiting past the deadline")
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestTransportTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	// the self-signed certificate of the test server isn't trusted by default
+	transport, err := newContextTransport(domain.NewGrafanaConfig("test"), &domain.AppGlobals{RetryCount: -1})
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Error(t, err)
+
+	grafanaConf := domain.NewGrafanaConfig("test")
+	grafanaConf.TLS = &domain.TLSSettings{CAFile: caFile, ServerName: "example.com"}
+	transport, err = newContextTransport(grafanaConf, &domain.AppGlobals{RetryCount: -1})
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+
+	grafanaConf.TLS = &domain.TLSSettings{CAFile: filepath.Join(t.TempDir(), "missing.pem")}
+	_, err = newContextTransport(grafanaConf, &domain.AppGlobals{})
+	assert.ErrorContains(t, err, "failed to read CA file")
+	grafanaConf.TLS = &domain.TLSSettings{CertFile: caFile}
+	_, err = newContextTransport(grafanaConf, &domain.AppGlobals{})
+	assert.ErrorContains(t, err, "failed to load client certificate")
+}
+
+func TestTransportHeadersAndProxy(t *testing.T) {
+	var proxied atomic.Bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// requests to plain http URLs are sent to the proxy with the absolute URL of the target
+		proxied.Store(r.URL.Host == "grafana.example.com" && r.Header.Get("Proxy-Authorization") == "Basic cHJveHk=")
+		w.Header().Set("X-Echo", r.Header.Get("X-Scope-OrgID"))
+	}))
+	defer proxy.Close()
+	t.Setenv("GDG_TEST_TENANT", "tenant-1")
+
+	grafanaConf := domain.NewGrafanaConfig("test")
+	grafanaConf.Proxy = &domain.ProxySettings{URL: proxy.URL, Headers: map[string]string{"Proxy-Authorization": "Basic cHJveHk="}}
+	grafanaConf.Headers = map[string]string{"X-Scope-OrgID": "env:GDG_TEST_TENANT"}
+	transport, err := newContextTransport(grafanaConf, &domain.AppGlobals{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://grafana.example.com/api/health", nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.True(t, proxied.Load())
+	assert.Equal(t, "tenant-1", resp.Header.Get("X-Echo"))
+	assert.Empty(t, req.Header.Get("X-Scope-OrgID"), "the caller's request isn't modified")
+	assert.Empty(t, req.Header.Get("Proxy-Authorization"))
+
+	grafanaConf.Proxy = &domain.ProxySettings{URL: "://proxy"}
+	_, err = newContextTransport(grafanaConf, &domain.AppGlobals{})
+	assert.ErrorContains(t, err, "invalid proxy url")
+}
@@ -34,11 +34,12 @@ const (
 // and default connection settings. It builds the configuration, writes secure files, updates
 // the internal context map, saves the config to disk, and logs completion.
 func CreateNewContext(app *domain.GDGAppConfiguration, name string) {
-	var encoder contract.CipherEncoder
+	var encoder contract.CipherEncoder = secure.NoOpEncoder{}
 	if !app.PluginConfig.Disabled && app.PluginConfig.CipherPlugin != nil {
-		encoder = secure.NewPluginCipherEncoder(app.PluginConfig.CipherPlugin, app.SecureConfig)
-	} else {
-		encoder = secure.NoOpEncoder{}
+		var err error
+		if encoder, err = secure.NewPluginCipherEncoder(app.PluginConfig.CipherPlugin, app.SecureConfig); err != nil {
+			log.Fatalf("unable to load cipher plugin: %v", err)
+		}
 	}
 	var authType string
 	err := huh.NewForm(
@@ -27,11 +27,13 @@ func TestSecureUnmarshall(t *testing.T) {
 	assert.Equal(cfg.PluginConfig.CipherPlugin.PluginConfig["passphrase"], "hello_world")
 	assert.NoError(err)
 	// Secure
-	assert.Equal(len(cfg.SecureConfig), 1)
+	assert.Equal(len(cfg.SecureConfig), 2)
 	keys := slices.Collect(maps.Keys(cfg.SecureConfig))
 	const alerting = "alerting"
 	assert.True(slices.Contains(keys, alerting))
 	assert.True(cfg.SecureConfig[alerting] != nil)
+	assert.True(slices.Contains(keys, "sso"))
+	assert.Contains(cfg.SecureConfig["sso"], "settings.clientSecret")
 }
 
 func TestConfigSearchPathBuilding(t *testing.T) {
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// AuthTypeOAuth2 obtains tokens using the OAuth2 client credentials flow
+	AuthTypeOAuth2 = "oauth2"
+	// AuthTypeExec obtains tokens by running a command, similar to kubectl credential plugins
+	AuthTypeExec = "exec"
+	// DefaultAuthHeader header the obtained tokens are sent in
+	DefaultAuthHeader = "Authorization"
+)
+
+// AuthSettings obtains short-lived tokens from a token provider, they are sent as bearer tokens with every request.
+type AuthSettings struct {
+	Type string `mapstructure:"type" yaml:"type"`
+	// Header the token is sent in, Authorization by default in which case the token replaces the credentials of the
+	// context.  Any other header, ie. Proxy-Authorization, keeps the basic auth or token credentials of the context.
+	Header string          `mapstructure:"header" yaml:"header,omitempty"`
+	OAuth2 *OAuth2Settings `mapstructure:"oauth2" yaml:"oauth2,omitempty"`
+	Exec   *ExecSettings   `mapstructure:"exec" yaml:"exec,omitempty"`
+}
+
+// OAuth2Settings client credentials flow settings, the client secret is read from the secure location of the context
+type OAuth2Settings struct {
+	TokenURL string   `mapstructure:"token_url" yaml:"token_url"`
+	ClientID string   `mapstructure:"client_id" yaml:"client_id"`
+	Scopes   []string `mapstructure:"scopes" yaml:"scopes,omitempty"`
+	// EndpointParams additional parameters sent to the token endpoint, ie. audience
+	EndpointParams map[string]string `mapstructure:"endpoint_params" yaml:"endpoint_params,omitempty"`
+}
+
+// ExecSettings command printing a token on its standard output
+type ExecSettings struct {
+	Command string   `mapstructure:"command" yaml:"command"`
+	Args    []string `mapstructure:"args" yaml:"args,omitempty"`
+	// Env variables added to the environment of the command, `env:` and `file:` values are resolved
+	Env map[string]string `mapstructure:"env" yaml:"env,omitempty"`
+}
+
+// GetHeader returns the header the tokens are sent in
+func (a *AuthSettings) GetHeader() string {
+	if a.Header == "" {
+		return DefaultAuthHeader
+	}
+	return a.Header
+}
+
+// GetEnv returns the environment variables added to the command, `env:` and `file:` values are resolved.
+func (e *ExecSettings) GetEnv() map[string]string {
+	return resolveValues(e.Env)
+}
+
+// Validate returns an error when the settings of the configured auth type are missing
+func (a *AuthSettings) Validate() error {
+	switch strings.ToLower(a.Type) {
+	case AuthTypeOAuth2:
+		if a.OAuth2 == nil || a.OAuth2.TokenURL == "" || a.OAuth2.ClientID == "" {
+			return fmt.Errorf("%s auth requires a token_url and client_id", AuthTypeOAuth2)
+		}
+	case AuthTypeExec:
+		if a.Exec == nil || a.Exec.Command == "" {
+			return fmt.Errorf("%s auth requires a command", AuthTypeExec)
+		}
+	default:
+		return fmt.Errorf("unsupported auth type '%s', expected %s or %s", a.Type, AuthTypeOAuth2, AuthTypeExec)
+	}
+	return nil
+}
+
+// HasTokenProvider returns true when the tokens of a provider replace the credentials of the context
+func (s *GrafanaConfig) HasTokenProvider() bool {
+	return s.Auth != nil && strings.EqualFold(s.Auth.GetHeader(), DefaultAuthHeader)
+}
+
+// GetClientSecret returns the OAuth2 client secret, respecting environment variable override if set.
+func (s *GrafanaConfig) GetClientSecret() string {
+	envKey := fmt.Sprintf("GDG_CONTEXTS__%s__CLIENT_SECRET", strings.ToUpper(s.contextName))
+	if val := os.Getenv(envKey); val != "" {
+		return val
+	}
+	secureAuth := s.getSecureAuth()
+	if secureAuth == nil {
+		return ""
+	}
+	return secureAuth.ClientSecret
+}
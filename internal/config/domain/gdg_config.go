@@ -2,7 +2,6 @@ package domain
 
 import (
 	"fmt"
-	"log"
 	"log/slog"
 	"maps"
 	"os"
@@ -27,14 +26,17 @@ type GDGAppConfiguration struct {
 }
 
 type PluginConfig struct {
-	Disabled     bool          `mapstructure:"disabled" yaml:"disabled"`
-	CipherPlugin *PluginEntity `mapstructure:"cipher" yaml:"cipher"`
+	Disabled      bool          `mapstructure:"disabled" yaml:"disabled"`
+	CipherPlugin  *PluginEntity `mapstructure:"cipher" yaml:"cipher"`
+	StoragePlugin *PluginEntity `mapstructure:"storage" yaml:"storage"`
 }
 
 type PluginEntity struct {
 	Url          string            `mapstructure:"url" yaml:"url"`
 	FilePath     string            `mapstructure:"file_path" yaml:"file_path"`
 	PluginConfig map[string]string `mapstructure:"config" yaml:"config"`
+	// AllowedPaths host folders mounted into the plugin, keyed by host path
+	AllowedPaths map[string]string `mapstructure:"allowed_paths" yaml:"allowed_paths,omitempty"`
 	processed    bool
 }
 
@@ -44,29 +46,33 @@ func (pe *PluginEntity) GetPluginConfig() map[string]string {
 	}
 	m := make(map[string]string)
 	for k, v := range pe.PluginConfig {
-		if strings.Contains(v, "env:") {
-			val := os.Getenv(strings.TrimPrefix(v, "env:"))
-			if val != "" {
-				m[k] = val
-				continue
-			}
-		} else if strings.HasPrefix(v, "file:") {
-			loc := strings.TrimPrefix(v, "file:")
-			expandedFile := os.ExpandEnv(loc)
-			raw, err := os.ReadFile(expandedFile) // #nosec G304
-			if err == nil {
-				m[k] = string(raw)
-				continue
-			}
-			slog.Warn(fmt.Sprintf("unable to read file from variable `%s`, using it value as string", expandedFile))
-		}
-		m[k] = v
+		m[k] = resolveValue(v)
 	}
 	pe.processed = true
 	pe.PluginConfig = m
 	return pe.PluginConfig
 }
 
+// resolveValue returns the value of the environment variable or the content of the file referenced by an `env:` or
+// `file:` prefixed value, the value itself otherwise.
+func resolveValue(v string) string {
+	if strings.Contains(v, "env:") {
+		val := os.Getenv(strings.TrimPrefix(v, "env:"))
+		if val != "" {
+			return val
+		}
+	} else if strings.HasPrefix(v, "file:") {
+		loc := strings.TrimPrefix(v, "file:")
+		expandedFile := os.ExpandEnv(loc)
+		raw, err := os.ReadFile(expandedFile) // #nosec G304
+		if err == nil {
+			return string(raw)
+		}
+		slog.Warn(fmt.Sprintf("unable to read file from variable `%s`, using it value as string", expandedFile))
+	}
+	return v
+}
+
 // GetSecureEntities returns the SecureModelConfig, initializing it if nil.
 func (app *GDGAppConfiguration) GetSecureEntities() map[string][]string {
 	if app.SecureConfig == nil {
@@ -93,7 +99,10 @@ type SecureEntity struct {
 
 // IgnoreSSL returns true if SSL errors should be ignored
 func (app *GDGAppConfiguration) IgnoreSSL() bool {
-	return app.GetViperConfig().GetBool("global.ignore_ssl_errors")
+	if val := app.GetViperConfig(); val != nil {
+		return val.GetBool("global.ignore_ssl_errors")
+	}
+	return app.GetAppGlobals().IgnoreSSLErrors
 }
 
 // IsDebug returns true if debug mode is enabled
@@ -101,7 +110,7 @@ func (app *GDGAppConfiguration) IsDebug() bool {
 	if val := app.GetViperConfig(); val != nil {
 		return val.GetBool("global.debug")
 	}
-	return false
+	return app.GetAppGlobals().Debug
 }
 
 // IsApiDebug returns true if debug mode is enabled for APIs
@@ -109,7 +118,7 @@ func (app *GDGAppConfiguration) IsApiDebug() bool {
 	if val := app.GetViperConfig(); val != nil {
 		return val.GetBool("global.api_debug")
 	}
-	return false
+	return app.GetAppGlobals().ApiDebug
 }
 
 // GetCloudConfiguration Returns storage type and configuration
@@ -122,6 +131,10 @@ func (app *GDGAppConfiguration) GetCloudConfiguration(configName string) (string
 	storageType := "local"
 	if len(appData) != 0 {
 		storageType = "cloud"
+		switch appData[storage.CloudType] {
+		case storage.GitCloudType, storage.ArchiveCloudType, storage.LocalCloudType, storage.PluginCloudType:
+			return appData[storage.CloudType], appData
+		}
 		if appData[storage.CloudType] == storage.Custom {
 			grafanaCfg := app.GetDefaultGrafanaConfig()
 			m := grafanaCfg.GetCloudAuth()
@@ -150,23 +163,29 @@ func (app *GDGAppConfiguration) PrintContext(name string) {
 	}
 	d, err := yaml.Marshal(grafana)
 	if err != nil {
-		log.Fatal("failed to serialize context", "err", err)
+		slog.Error("failed to serialize context", "context", name, "err", err)
+		return
 	}
 
 	fmt.Printf("config file: %s\n", app.GetViperConfig().ConfigFileUsed())
 	fmt.Printf("---context: %s\n%s\n", name, string(d))
 }
 
-// GetDefaultGrafanaConfig returns the default aka. selected grafana config
+// GetDefaultGrafanaConfig returns the default aka. selected grafana config, nil when that context is not configured.
+// The context is validated when the configuration is loaded and when a service is created, see GetGrafanaConfig.
 func (app *GDGAppConfiguration) GetDefaultGrafanaConfig() *GrafanaConfig {
-	name := app.GetContext()
+	grafanaConf, _ := app.GetGrafanaConfig()
+	return grafanaConf
+}
 
+// GetGrafanaConfig returns the grafana config of the selected context, an error is returned when it is not configured
+func (app *GDGAppConfiguration) GetGrafanaConfig() (*GrafanaConfig, error) {
+	name := app.GetContext()
 	val, ok := app.GetContexts()[name]
-	if ok {
-		return val
+	if !ok {
+		return nil, fmt.Errorf("context: '%s' is not found.  Please check your config", name)
 	}
-	log.Fatalf("Context: '%s' is not found.  Please check your config", name)
-	return nil
+	return val, nil
 }
 
 // UpdateContextNames sets each context's internal name to a slugified version of its key.
@@ -186,14 +205,46 @@ func (app *GDGAppConfiguration) GetContexts() map[string]*GrafanaConfig {
 	return app.Contexts
 }
 
-// ChangeContext changes active context
-func (app *GDGAppConfiguration) ChangeContext(name string) {
-	app.SetContext(name)
-	err := app.SaveToDisk(false)
+// ForContext returns a copy of the configuration using the given context.  The configuration of that context is
+// copied as well so that it may be modified without affecting the original one.
+func (app *GDGAppConfiguration) ForContext(name string) (*GDGAppConfiguration, error) {
+	name = strings.ToLower(name)
+	grafanaConf, ok := app.GetContexts()[name]
+	if !ok {
+		return nil, fmt.Errorf("context: '%s' is not found", name)
+	}
+	cfg := *app
+	cfg.ContextName = name
+	cfg.Contexts = maps.Clone(app.Contexts)
+	cfg.Contexts[name] = grafanaConf.Copy()
+	return &cfg, nil
+}
+
+// ForOrganization returns a copy of the configuration whose current context targets the given organization, the
+// original configuration is left untouched.
+func (app *GDGAppConfiguration) ForOrganization(orgName string) (*GDGAppConfiguration, error) {
+	grafanaConf, err := app.GetGrafanaConfig()
 	if err != nil {
-		log.Fatal("Failed to make save changes")
+		return nil, err
+	}
+	scoped := *grafanaConf
+	scoped.OrganizationName = orgName
+	cfg := *app
+	cfg.Contexts = maps.Clone(app.Contexts)
+	cfg.Contexts[app.GetContext()] = &scoped
+	return &cfg, nil
+}
+
+// ChangeContext changes active context and persists the change
+func (app *GDGAppConfiguration) ChangeContext(name string) error {
+	if err := app.SetContext(name); err != nil {
+		return err
+	}
+	if err := app.SaveToDisk(false); err != nil {
+		return fmt.Errorf("failed to save changes: %w", err)
 	}
 	slog.Info("Changed context", "context", name)
+	return nil
 }
 
 // SaveToDisk Persists current configuration to disk
@@ -212,14 +263,15 @@ func (app *GDGAppConfiguration) SaveToDisk(useViper bool) error {
 }
 
 // SetContext sets the active context by name after validating its existence.
-func (app *GDGAppConfiguration) SetContext(name string) {
+func (app *GDGAppConfiguration) SetContext(name string) error {
 	name = strings.ToLower(name)
 	_, ok := app.GetContexts()[name]
 	if !ok {
-		log.Fatalf("context %s was not found", name)
+		return fmt.Errorf("context %s was not found", name)
 	}
 
 	app.ContextName = name
+	return nil
 }
 
 // GetAppGlobals returns the global configuration, initializing it if nil.
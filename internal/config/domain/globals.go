@@ -8,6 +8,9 @@ import (
 const (
 	AuthPrefix      = "auth"
 	CloudAuthPrefix = "s3"
+	SSOPrefix       = "sso"
+	// DefaultRequestTimeout time allowed for a Grafana request when none is configured
+	DefaultRequestTimeout = 30 * time.Second
 )
 
 // AppGlobals is the global configuration for the application
@@ -18,9 +21,16 @@ type AppGlobals struct {
 	RetryCount      int            `mapstructure:"retry_count" yaml:"retry_count"`
 	RetryDelay      string         `mapstructure:"retry_delay" yaml:"retry_delay"`
 	ClearOutput     bool           `mapstructure:"clear_output" yaml:"clear_output"`
+	Timeout         string         `mapstructure:"timeout" yaml:"timeout,omitempty"`
+	RequestTimeout  string         `mapstructure:"request_timeout" yaml:"request_timeout,omitempty"`
 	retryTimeout    *time.Duration `mapstructure:"-" yaml:"-"`
 }
 
+// GetRetryCount returns the number of times a failed request is retried, requests aren't retried when it isn't set
+func (app *AppGlobals) GetRetryCount() int {
+	return max(app.RetryCount, 0)
+}
+
 // GetRetryTimeout returns 100ms, by default otherwise the parsed value
 func (app *AppGlobals) GetRetryTimeout() time.Duration {
 	defaultBehavior := func() {
@@ -43,3 +53,28 @@ func (app *AppGlobals) GetRetryTimeout() time.Duration {
 
 	return *app.retryTimeout
 }
+
+// GetTimeout returns the time a command is allowed to run for, 0 when it isn't limited
+func (app *AppGlobals) GetTimeout() time.Duration {
+	return parseTimeout("timeout", app.Timeout, 0)
+}
+
+// GetRequestTimeout returns the time a single Grafana request is allowed to take, including its retries.  Defaults
+// to 30s.
+func (app *AppGlobals) GetRequestTimeout() time.Duration {
+	return parseTimeout("request_timeout", app.RequestTimeout, DefaultRequestTimeout)
+}
+
+// parseTimeout returns the parsed duration, or the fallback when it is empty or invalid
+func parseTimeout(name, value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		slog.Warn("Unable to parse the timeout value, falling back on default", "setting", name, "value", value,
+			"default", fallback)
+		return fallback
+	}
+	return d
+}
@@ -26,6 +26,7 @@ type dashFilter struct {
 type GrafanaConfig struct {
 	contextName              string
 	secureAuth               *SecureModel
+	Auth                     *AuthSettings         `mapstructure:"auth" yaml:"auth,omitempty"`
 	ConnectionSettings       *ConnectionSettings   `mapstructure:"connections" yaml:"connections"`
 	DashboardSettings        *DashboardSettings    `mapstructure:"dashboard_settings" yaml:"dashboard_settings"`
 	MonitoredFolders         []string              `mapstructure:"watched" yaml:"watched"`
@@ -34,6 +35,11 @@ type GrafanaConfig struct {
 	OrganizationName         string                `mapstructure:"organization_name" yaml:"organization_name"`
 	SecureLocationOverride   string                `mapstructure:"secure_location" yaml:"secure_location"`
 	OutputPath               string                `mapstructure:"output_path" yaml:"output_path"`
+	PromotionFile            string                `mapstructure:"promotion_file" yaml:"promotion_file,omitempty"`
+	RateLimit                float64               `mapstructure:"rate_limit" yaml:"rate_limit,omitempty"` // requests per second, unlimited when 0
+	TLS                      *TLSSettings          `mapstructure:"tls" yaml:"tls,omitempty"`
+	Proxy                    *ProxySettings        `mapstructure:"proxy" yaml:"proxy,omitempty"`
+	Headers                  map[string]string     `mapstructure:"headers" yaml:"headers,omitempty"` // sent with every request
 	Storage                  string                `mapstructure:"storage" yaml:"storage"`
 	URL                      string                `mapstructure:"url" yaml:"url"`
 	UserName                 string                `mapstructure:"user_name" yaml:"user_name"`
@@ -127,6 +133,15 @@ func (s *GrafanaConfig) GetCloudAuth() map[string]string {
 	return m
 }
 
+// Copy returns a shallow copy of the context configuration.  The secure authentication and folder filter aren't
+// shared with the copy, they are loaded again on first use.
+func (s *GrafanaConfig) Copy() *GrafanaConfig {
+	c := *s
+	c.secureAuth = nil
+	c.filterFolder = nil
+	return &c
+}
+
 // getSecureAuth returns the parsed secure authentication model,
 // loading from YAML, YML or JSON files in order of precedence.
 // It caches the result for subsequent calls.
@@ -145,6 +160,11 @@ func (s *GrafanaConfig) getSecureAuth() *SecureModel {
 	return s.secureAuth
 }
 
+// SetSecureAuth sets the credentials of the context, they are used instead of the ones read from the secure location.
+func (s *GrafanaConfig) SetSecureAuth(auth SecureModel) {
+	s.secureAuth = &auth
+}
+
 // UpdateSecureModel updates the secure model using the supplied function, if secure auth is present.
 func (s *GrafanaConfig) UpdateSecureModel(fn func(string) (string, error)) {
 	secureAuth := s.getSecureAuth()
@@ -262,17 +282,14 @@ func (s *GrafanaConfig) IsFilterSet() bool {
 
 // GetURL returns the Grafana URL, trimmed of whitespace and guaranteed to end with a slash.
 func (s *GrafanaConfig) GetURL() string {
-	if len(s.URL) == 0 {
-		return s.URL
-	}
 	// remove white space
-	s.URL = strings.TrimSpace(s.URL)
-	// add trailing slash if present
-	if s.URL[len(s.URL)-1] != '/' {
-		s.URL = s.URL + "/"
+	u := strings.TrimSpace(s.URL)
+	// add trailing slash if missing
+	if len(u) > 0 && u[len(u)-1] != '/' {
+		u += "/"
 	}
 
-	return s.URL
+	return u
 }
 
 // GetOrganizationName returns the id of the organization (defaults to 1 if unset)
@@ -2,13 +2,14 @@ package domain
 
 import "log/slog"
 
-// SecureModel holds secure information like password and token
+// SecureModel holds secure information like password, token and OAuth2 client secret
 type SecureModel struct {
-	Password string `mapstructure:"password" json:"password" yaml:"password"`
-	Token    string `mapstructure:"token"  json:"token" yaml:"token"`
+	Password     string `mapstructure:"password" json:"password" yaml:"password"`
+	Token        string `mapstructure:"token"  json:"token" yaml:"token"`
+	ClientSecret string `mapstructure:"client_secret" json:"client_secret,omitempty" yaml:"client_secret,omitempty"`
 }
 
-// UpdateSecureModel updates Token and Password by applying fn; logs errors on failure.
+// UpdateSecureModel updates Token, Password and ClientSecret by applying fn; logs errors on failure.
 func (sm *SecureModel) UpdateSecureModel(fn func(string) (string, error)) {
 	if sm.Token != "" {
 		newToken, err := fn(sm.Token)
@@ -26,4 +27,12 @@ func (sm *SecureModel) UpdateSecureModel(fn func(string) (string, error)) {
 			slog.Warn("error updating secure model, cannot decode password", "err", err)
 		}
 	}
+	if sm.ClientSecret != "" {
+		newSecret, err := fn(sm.ClientSecret)
+		if err == nil {
+			sm.ClientSecret = newSecret
+		} else {
+			slog.Warn("error updating secure model, cannot decode client secret", "err", err)
+		}
+	}
 }
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/esnet/gdg/pkg/plugins/secure/contract"
+	"gopkg.in/yaml.v3"
+)
+
+// GetSSOSecretsLocation returns the path, without extension, of the file holding the secrets of the SSO provider
+func (s *GrafanaConfig) GetSSOSecretsLocation(provider string) string {
+	return filepath.Join(s.SecureLocation(), fmt.Sprintf("%s_%s", SSOPrefix, provider))
+}
+
+// GetSSOSecrets returns the secrets of the SSO provider keyed by settings field, ie. clientSecret.  Grafana never
+// returns them so they can't be backed up, they are read from a yaml or json file in the secure location instead and
+// decoded using the encoder.  An empty map is returned when the provider has no secrets file.
+func (s *GrafanaConfig) GetSSOSecrets(provider string, encoder contract.CipherEncoder) (map[string]string, error) {
+	location := s.GetSSOSecretsLocation(provider)
+	for _, ext := range []string{".yaml", ".yml", ".json"} {
+		secrets, err := readSecretsFile(location+ext, encoder)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		return secrets, err
+	}
+	return make(map[string]string), nil
+}
+
+// readSecretsFile reads a flat yaml or json map of secrets, values are decoded using the encoder when one is given
+func readSecretsFile(location string, encoder contract.CipherEncoder) (map[string]string, error) {
+	raw, err := os.ReadFile(location) // #nosec G304
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secrets %s: %w", location, err)
+	}
+	secrets := make(map[string]string)
+	switch ext := filepath.Ext(location); ext {
+	case ".yml", ".yaml":
+		err = yaml.Unmarshal(raw, &secrets)
+	case ".json":
+		err = json.Unmarshal(raw, &secrets)
+	default:
+		return nil, fmt.Errorf("invalid file extension %s", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse secrets %s: %w", location, err)
+	}
+	if encoder == nil {
+		return secrets, nil
+	}
+	for key, value := range secrets {
+		decoded, decodeErr := encoder.DecodeValue(value)
+		if decodeErr != nil {
+			return nil, fmt.Errorf("unable to decode secret %s: %w", key, decodeErr)
+		}
+		secrets[key] = decoded
+	}
+	return secrets, nil
+}
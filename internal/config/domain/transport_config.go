@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"maps"
+	"strings"
+)
+
+// TLSSettings TLS configuration used to reach the Grafana instance of a context
+type TLSSettings struct {
+	// CAFile PEM encoded certificates trusted in addition to the system ones
+	CAFile string `mapstructure:"ca_file" yaml:"ca_file,omitempty"`
+	// CertFile and KeyFile PEM encoded client certificate and key presented for mTLS
+	CertFile string `mapstructure:"cert_file" yaml:"cert_file,omitempty"`
+	KeyFile  string `mapstructure:"key_file" yaml:"key_file,omitempty"`
+	// ServerName overrides the name the server certificate is verified against
+	ServerName string `mapstructure:"server_name" yaml:"server_name,omitempty"`
+}
+
+// ProxySettings HTTP proxy used to reach the Grafana instance of a context
+type ProxySettings struct {
+	URL string `mapstructure:"url" yaml:"url,omitempty"`
+	// Headers sent to the proxy, ie. Proxy-Authorization.  They are set on the CONNECT request of HTTPS connections and
+	// on the request itself when Grafana is reached over plain HTTP.
+	Headers map[string]string `mapstructure:"headers" yaml:"headers,omitempty"`
+}
+
+// GetHeaders returns the headers sent with every Grafana request, `env:` and `file:` values are resolved.
+func (s *GrafanaConfig) GetHeaders() map[string]string {
+	return resolveValues(s.Headers)
+}
+
+// GetHeaders returns the headers sent to the proxy, `env:` and `file:` values are resolved.
+func (p *ProxySettings) GetHeaders() map[string]string {
+	return resolveValues(p.Headers)
+}
+
+// resolveValues returns a copy of the header map with every value resolved, surrounding whitespaces such as the
+// trailing newline of a file are removed.
+func resolveValues(values map[string]string) map[string]string {
+	m := maps.Clone(values)
+	for k, v := range m {
+		m[k] = strings.TrimSpace(resolveValue(v))
+	}
+	return m
+}
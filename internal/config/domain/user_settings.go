@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"log/slog"
 	"math/big"
+	"path/filepath"
 
+	"github.com/esnet/gdg/pkg/plugins/secure/contract"
 	"github.com/sethvargo/go-password/password"
 )
 
@@ -19,6 +21,10 @@ type UserSettings struct {
 	RandomPassword bool `mapstructure:"random_password" yaml:"random_password"`
 	MinLength      int  `mapstructure:"min_length" yaml:"min_length"`
 	MaxLength      int  `mapstructure:"max_length" yaml:"max_length"`
+	// PreferencesCredentials yaml or json file mapping user logins to their password, relative to the secure location.
+	// Grafana only exposes preferences and starred dashboards to the user itself, they are only backed up and restored
+	// for the users listed when set.
+	PreferencesCredentials string `mapstructure:"preferences_credentials" yaml:"preferences_credentials,omitempty"`
 }
 
 func (u *UserSettings) GetPassword(username string) string {
@@ -61,3 +67,24 @@ func (u *UserSettings) defaultUserPassword(username string) string {
 
 	return passwordVal
 }
+
+// GetPreferencesCredentials returns the passwords of the users whose preferences are backed up keyed by login, nil
+// when preferences aren't backed up.  Values are decoded using the encoder.
+func (s *GrafanaConfig) GetPreferencesCredentials(encoder contract.CipherEncoder) (map[string]string, error) {
+	location := s.GetUserSettings().PreferencesCredentials
+	if location == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(location) {
+		location = filepath.Join(s.SecureLocation(), location)
+	}
+	return readSecretsFile(location, encoder)
+}
+
+// SetPreferencesCredentials overrides the file holding the passwords of the users whose preferences are backed up
+func (s *GrafanaConfig) SetPreferencesCredentials(location string) {
+	if s.UserSettings == nil {
+		s.UserSettings = &UserSettings{}
+	}
+	s.UserSettings.PreferencesCredentials = location
+}
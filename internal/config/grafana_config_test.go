@@ -48,3 +48,19 @@ func TestPrintConfig(t *testing.T) {
 	assert.True(strings.Contains(output, "dashboard_settings:"))
 	assert.True(strings.Contains(output, "output_path: test/data"))
 }
+
+func TestForContext(t *testing.T) {
+	assert.NoError(t, path.FixTestDir("config", "../.."))
+	confobj := InitGdgConfig(common.DefaultTestConfig)
+	original := confobj.GetDefaultGrafanaConfig()
+
+	copied, err := confobj.ForContext(strings.ToUpper(confobj.GetContext()))
+	assert.NoError(t, err)
+	assert.Equal(t, confobj.GetContext(), copied.GetContext())
+	copied.GetDefaultGrafanaConfig().OutputPath = "other"
+	assert.NotEqual(t, "other", original.OutputPath)
+	assert.Equal(t, original, confobj.GetDefaultGrafanaConfig())
+
+	_, err = confobj.ForContext("missing")
+	assert.ErrorContains(t, err, "context: 'missing' is not found")
+}
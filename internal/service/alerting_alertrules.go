@@ -3,17 +3,17 @@ package service
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"log/slog"
+	"maps"
 	"reflect"
 	"regexp"
 	"strings"
 
 	configDomain "github.com/esnet/gdg/internal/config/domain"
-	modelsDomain "github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
 	"github.com/esnet/gdg/internal/service/filters/v2"
 	"github.com/esnet/gdg/pkg/config/domain"
+	modelsDomain "github.com/esnet/gdg/pkg/service/domain"
 	"github.com/gosimple/slug"
 	"github.com/tidwall/gjson"
 
@@ -24,7 +24,7 @@ import (
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 )
 
-func NewAlertRuleFilter(cfg *configDomain.GDGAppConfiguration, grafanaSvc GrafanaService) filters.V2Filter {
+func NewAlertRuleFilter(cfg *configDomain.GDGAppConfiguration, grafanaSvc GrafanaService) (filters.V2Filter, error) {
 	filterObj := v2.NewBaseFilter()
 	err := filterObj.RegisterReader(reflect.TypeOf(&modelsDomain.AlertRuleWithNestedFolder{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(*modelsDomain.AlertRuleWithNestedFolder)
@@ -39,7 +39,7 @@ func NewAlertRuleFilter(cfg *configDomain.GDGAppConfiguration, grafanaSvc Grafan
 		}
 	})
 	if err != nil {
-		log.Fatalf("unable to register a valid object reader for alert rules filter")
+		return nil, fmt.Errorf("unable to register a valid object reader for alert rules filter: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.([]byte)
@@ -67,7 +67,7 @@ func NewAlertRuleFilter(cfg *configDomain.GDGAppConfiguration, grafanaSvc Grafan
 		}
 	})
 	if err != nil {
-		log.Fatalf("unable to register a valid byte reader for alert rules filter")
+		return nil, fmt.Errorf("unable to register a valid byte reader for alert rules filter: %w", err)
 	}
 	folderArr := cfg.GetDefaultGrafanaConfig().GetMonitoredFolders(false)
 	filterObj.AddValidation(filters.AlertRuleFilterType, func(value any, expected any) error {
@@ -87,7 +87,7 @@ func NewAlertRuleFilter(cfg *configDomain.GDGAppConfiguration, grafanaSvc Grafan
 
 		return fmt.Errorf("invalid folder filter. Expected: %v", expressions)
 	}, folderArr)
-	return filterObj
+	return filterObj, nil
 }
 
 func (s *DashNGoImpl) ListAlertRules(filter filters.V2Filter) ([]*modelsDomain.AlertRuleWithNestedFolder, error) {
@@ -96,7 +96,10 @@ func (s *DashNGoImpl) ListAlertRules(filter filters.V2Filter) ([]*modelsDomain.A
 		return nil, err
 	}
 
-	folderUidMap := s.getFolderUIDEntityMap(nil)
+	folderUidMap, err := s.getFolderUIDEntityMap(nil)
+	if err != nil {
+		return nil, err
+	}
 	var results []*modelsDomain.AlertRuleWithNestedFolder
 
 	for _, item := range data.GetPayload() {
@@ -124,8 +127,19 @@ func (s *DashNGoImpl) UploadAlertRules(filter filters.V2Filter) error {
 		rawEntity []byte
 	)
 
-	rulesPath := s.grafanaConf.GetPath(domain.AlertingRulesResource, s.grafanaConf.GetOrganizationName())
-	filesInDir, err := s.storage.FindAllFiles(rulesPath, true)
+	promotion, err := s.promotionRules()
+	if err != nil {
+		return err
+	}
+	orgName := promotion.sourceOrganization(s.grafanaConf.GetOrganizationName())
+	connections, err := s.promotionConnections(promotion, orgName)
+	if err != nil {
+		return err
+	}
+	var folderUidMap map[string]string
+
+	rulesPath := s.grafanaConf.GetPath(domain.AlertingRulesResource, orgName)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), rulesPath, true)
 	if err != nil {
 		return fmt.Errorf("unable to find any rules to export from storage engine, err: %w", err)
 	}
@@ -142,18 +156,41 @@ func (s *DashNGoImpl) UploadAlertRules(filter filters.V2Filter) error {
 			slog.Warn("Only json files are supported, skipping", "filename", file)
 			continue
 		}
-		if rawEntity, err = s.storage.ReadFile(file); err != nil {
-			slog.Warn("Unable to read file", "filename", file, "err", err)
+		if rawEntity, err = s.readBackupFile(file); err != nil {
+			s.recordFailure(domain.AlertingRulesResource, file, fmt.Errorf("unable to read file: %w", err))
 			continue
 		}
 		if filter != nil && !filter.ValidateAll(rawEntity) {
-			slog.Debug("Skipping file, failed Team filter", "file", file)
+			s.recordSkipped(domain.AlertingRulesResource, file, "failed folder filter")
 			continue
 		}
+		if connections != nil {
+			if rawEntity, err = connections.remap(rawEntity); err != nil {
+				return fmt.Errorf("failed to remap connections, file:%s, err: %w", file, err)
+			}
+		}
 		entity := new(modelsDomain.AlertRuleWithNestedFolder)
 		if err = json.Unmarshal(rawEntity, &entity); err != nil {
 			return fmt.Errorf("failed to unmarshall file, file:%s, err: %w", file, err)
 		}
+		if folderName := promotion.folder(entity.NestedPath); folderName != entity.NestedPath {
+			if folderUidMap == nil {
+				folders, listErr := s.ListFolders(nil)
+				if listErr != nil {
+					return listErr
+				}
+				folderUidMap = s.getFolderNameUIDMap(folders)
+			}
+			if _, ok := folderUidMap[folderName]; !ok {
+				newFolders, folderErr := s.createdFolders(folderName)
+				if folderErr != nil {
+					return fmt.Errorf("unable to create folder %s, err: %w", folderName, folderErr)
+				}
+				maps.Copy(folderUidMap, newFolders)
+			}
+			entity.NestedPath = folderName
+			entity.FolderUID = ptr.Of(folderUidMap[folderName])
+		}
 
 		if _, ok := m[entity.UID]; ok {
 			p := provisioning.NewPutAlertRuleParams()
@@ -168,8 +205,10 @@ func (s *DashNGoImpl) UploadAlertRules(filter filters.V2Filter) error {
 			_, err = s.GetClient().Provisioning.PostAlertRule(p)
 		}
 		if err != nil {
-			slog.Error("unable to import rule", "uid", entity.UID, "err", err)
+			s.recordFailure(domain.AlertingRulesResource, ptr.ValueOrDefault(entity.Title, entity.UID), fmt.Errorf("unable to import rule: %w", err))
+			continue
 		}
+		s.recordSuccess(domain.AlertingRulesResource, ptr.ValueOrDefault(entity.Title, entity.UID))
 	}
 
 	return nil
@@ -186,14 +225,15 @@ func (s *DashNGoImpl) DownloadAlertRules(filter filters.V2Filter) ([]string, err
 	}
 	var savedFiles []string
 	for _, link := range data {
-		base := BuildResourceFolder(s.grafanaConf, link.NestedPath, domain.AlertingRulesResource, s.isLocal(), s.GetGlobals().ClearOutput)
+		base := BuildResourceFolder(s.grafanaConf, link.NestedPath, domain.AlertingRulesResource, s.isLocal(), s.clearedFolders())
 		fileName := fmt.Sprintf("%s/%s.json", base, slug.Make(ptr.ValueOrDefault(link.Title, "no-name")))
 		if dsPacked, err = json.MarshalIndent(link, "", "	"); err != nil {
 			return nil, fmt.Errorf("unable to serialize data to JSON. %w", err)
 		}
-		if err = s.storage.WriteFile(fileName, dsPacked); err != nil {
+		if err = s.writeBackupFile(fileName, dsPacked); err != nil {
 			return nil, fmt.Errorf("unable to write file. %w", err)
 		}
+		s.recordSuccess(domain.AlertingRulesResource, ptr.ValueOrDefault(link.Title, link.UID))
 		savedFiles = append(savedFiles, fileName)
 	}
 
@@ -209,11 +249,12 @@ func (s *DashNGoImpl) ClearAlertRules(filter filters.V2Filter) ([]string, error)
 	for _, rule := range rules {
 		p := provisioning.NewDeleteAlertRuleParams()
 		p.UID = rule.UID
-		_, err := s.GetClient().Provisioning.DeleteAlertRule(p)
+		_, err = s.GetClient().Provisioning.DeleteAlertRule(p)
 		if err != nil {
-			slog.Error("unable to delete rule", "rule", rule.UID)
+			s.recordFailure(domain.AlertingRulesResource, ptr.ValueOrDefault(rule.Title, rule.UID), fmt.Errorf("unable to delete rule: %w", err))
 			continue
 		}
+		s.recordSuccess(domain.AlertingRulesResource, ptr.ValueOrDefault(rule.Title, rule.UID))
 		data = append(data, ptr.ValueOrDefault(rule.Title, ""))
 	}
 
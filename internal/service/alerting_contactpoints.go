@@ -3,7 +3,6 @@ package service
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"log/slog"
 
 	"github.com/esnet/gdg/pkg/config/domain"
@@ -47,7 +46,7 @@ func (s *DashNGoImpl) DownloadContactPoints() (string, error) {
 	p.Format = ptr.Of("json")
 	data, err := s.GetClient().Provisioning.GetContactpointsExport(p)
 	if err != nil {
-		log.Fatalf("unable to retrieve Contact Points, err: %s", err.Error())
+		return "", fmt.Errorf("unable to retrieve contact points: %w", err)
 	}
 	// filter default contactPoints
 	payload := data.GetPayload()
@@ -55,7 +54,7 @@ func (s *DashNGoImpl) DownloadContactPoints() (string, error) {
 		return item.Name != emailReceiver
 	})
 
-	dsPath := buildResourcePath(s.grafanaConf, contactsFile, domain.AlertingResource, s.isLocal(), false)
+	dsPath := buildResourcePath(s.grafanaConf, contactsFile, domain.AlertingResource, s.isLocal(), nil)
 	if dsPacked, err = json.MarshalIndent(payload.ContactPoints, "", "	"); err != nil {
 		return "", fmt.Errorf("unable to serialize data to JSON. %w", err)
 	}
@@ -66,7 +65,7 @@ func (s *DashNGoImpl) DownloadContactPoints() (string, error) {
 		}
 		dsPacked = newData
 	}
-	if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
+	if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
 		return "", fmt.Errorf("unable to write file. %w", err)
 	}
 
@@ -74,7 +73,7 @@ func (s *DashNGoImpl) DownloadContactPoints() (string, error) {
 }
 
 func (s *DashNGoImpl) isLocal() bool {
-	return s.storage.Name() == storage.LocalStorageType.String()
+	return s.storage.Name() == storage.LocalStorageType.String() || s.storage.Name() == storage.GitStorageType.String()
 }
 
 func (s *DashNGoImpl) UploadContactPoints() ([]string, error) {
@@ -93,8 +92,8 @@ func (s *DashNGoImpl) UploadContactPoints() ([]string, error) {
 		m[i.UID] = currentContacts[ndx]
 	}
 
-	fileLocation := buildResourcePath(s.grafanaConf, contactsFile, domain.AlertingResource, s.isLocal(), false)
-	if rawDS, err = s.storage.ReadFile(fileLocation); err != nil {
+	fileLocation := buildResourcePath(s.grafanaConf, contactsFile, domain.AlertingResource, s.isLocal(), nil)
+	if rawDS, err = s.readBackupFile(fileLocation); err != nil {
 		return nil, fmt.Errorf("failed to read file.  file: %s, err: %w", fileLocation, err)
 	}
 	if !s.gdgConfig.PluginConfig.Disabled && s.gdgConfig.PluginConfig.CipherPlugin != nil {
@@ -26,11 +26,11 @@ func (s *DashNGoImpl) DownloadAlertTemplates() (string, error) {
 		return "", err
 	}
 
-	dsPath := buildResourcePath(s.grafanaConf, templatesFile, domain.AlertingResource, s.isLocal(), false)
+	dsPath := buildResourcePath(s.grafanaConf, templatesFile, domain.AlertingResource, s.isLocal(), nil)
 	if dsPacked, err = json.MarshalIndent(tpls, "", "	"); err != nil {
 		return "", fmt.Errorf("unable to serialize data to JSON. %w", err)
 	}
-	if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
+	if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
 		return "", fmt.Errorf("unable to write file. %w", err)
 	}
 
@@ -80,8 +80,8 @@ func (s *DashNGoImpl) UploadAlertTemplates() ([]string, error) {
 		m[i.Name] = currentTemplates[ndx]
 	}
 
-	fileLocation := buildResourcePath(s.grafanaConf, templatesFile, domain.AlertingResource, s.isLocal(), false)
-	if rawDS, err = s.storage.ReadFile(fileLocation); err != nil {
+	fileLocation := buildResourcePath(s.grafanaConf, templatesFile, domain.AlertingResource, s.isLocal(), nil)
+	if rawDS, err = s.readBackupFile(fileLocation); err != nil {
 		return nil, fmt.Errorf("failed to read file.  file: %s, err: %w", fileLocation, err)
 	}
 	if err = json.Unmarshal(rawDS, &data); err != nil {
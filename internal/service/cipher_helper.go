@@ -1,21 +1,21 @@
 package service
 
-import "log"
+import "fmt"
 
-func (s *DashNGoImpl) EncodeValue(in string) string {
+func (s *DashNGoImpl) EncodeValue(in string) (string, error) {
 	newVal, err := s.encoder.EncodeValue(in)
 	if err != nil {
-		log.Fatal(err)
+		return "", fmt.Errorf("unable to encode value: %w", err)
 	}
 
-	return newVal
+	return newVal, nil
 }
 
-func (s *DashNGoImpl) DecodeValue(in string) string {
+func (s *DashNGoImpl) DecodeValue(in string) (string, error) {
 	newVal, err := s.encoder.DecodeValue(in)
 	if err != nil {
-		log.Fatal(err)
+		return "", fmt.Errorf("unable to decode value: %w", err)
 	}
 
-	return newVal
+	return newVal, nil
 }
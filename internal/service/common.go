@@ -62,21 +62,21 @@ func getFolderFromResourcePath(cfg *configDomain.GrafanaConfig, filePath string,
 	return "", errors.New("unable to parse resource to retrieve folder name")
 }
 
-func BuildResourceFolder(cfg *configDomain.GrafanaConfig, folderName string, resourceType domain.ResourceType, createDestination bool, clearOutput bool) string {
+func BuildResourceFolder(cfg *configDomain.GrafanaConfig, folderName string, resourceType domain.ResourceType, createDestination bool, cleared *tools.ClearedFolders) string {
 	if (resourceType == domain.DashboardResource || resourceType == domain.AlertingRulesResource) && folderName == "" {
 		folderName = DefaultFolderName
 	}
 	v := fmt.Sprintf("%s/%s", cfg.GetPath(resourceType, cfg.GetOrganizationName()), folderName)
 	if createDestination {
-		tools.CreateDestinationPath(cfg.GetPath(resourceType, cfg.GetOrganizationName()), clearOutput, v)
+		tools.CreateDestinationPath(cfg.GetPath(resourceType, cfg.GetOrganizationName()), cleared, v)
 	}
 	return v
 }
 
-func buildResourcePath(cfg *configDomain.GrafanaConfig, folderName string, resourceType domain.ResourceType, createDestination bool, clearOutput bool) string {
+func buildResourcePath(cfg *configDomain.GrafanaConfig, folderName string, resourceType domain.ResourceType, createDestination bool, cleared *tools.ClearedFolders) string {
 	v := fmt.Sprintf("%s%s%s.json", cfg.GetPath(resourceType, cfg.GetOrganizationName()), pathSeparator, folderName)
 	if createDestination {
-		tools.CreateDestinationPath(cfg.GetPath(resourceType, cfg.GetOrganizationName()), clearOutput, filepath.Dir(v))
+		tools.CreateDestinationPath(cfg.GetPath(resourceType, cfg.GetOrganizationName()), cleared, filepath.Dir(v))
 	}
 	return v
 }
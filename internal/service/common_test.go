@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	configDomain "github.com/esnet/gdg/internal/config/domain"
@@ -35,7 +36,8 @@ func TestRelativePathLogin(t *testing.T) {
 	}()
 
 	localEngine := storage.NewLocalStorage(context.Background())
-	svc := NewTestApiService(localEngine, nil)
+	svc, err := NewTestApiService(localEngine, nil)
+	assert.NoError(t, err)
 	_, cfg := svc.(*DashNGoImpl).getNewClient()
 	assert.Equal(t, cfg.Host, "localhost:3000")
 	assert.Equal(t, cfg.BasePath, "/grafana/api")
@@ -57,7 +59,7 @@ func TestUserPath(t *testing.T) {
 	cfg := &configDomain.GrafanaConfig{
 		OutputPath: "test/data",
 	}
-	userPath := BuildResourceFolder(cfg, "", domain.UserResource, false, false)
+	userPath := BuildResourceFolder(cfg, "", domain.UserResource, false, nil)
 	assert.Equal(t, "test/data/users/", userPath)
 }
 
@@ -67,7 +69,7 @@ func TestBuildDashboardPath(t *testing.T) {
 		OutputPath:       "test/data",
 		OrganizationName: "Your Org",
 	}
-	result := BuildResourceFolder(cfg, "General", domain.DashboardResource, false, false)
+	result := BuildResourceFolder(cfg, "General", domain.DashboardResource, false, nil)
 	assert.Equal(t, "test/data/org_your-org/dashboards/General", result)
 }
 
@@ -77,7 +79,7 @@ func TestBuildFolderSourcePath(t *testing.T) {
 		OutputPath:       "test/data",
 		OrganizationName: "Your Org",
 	}
-	result := buildResourcePath(cfg, slug.Make("Some Folder"), domain.FolderResource, false, false)
+	result := buildResourcePath(cfg, slug.Make("Some Folder"), domain.FolderResource, false, nil)
 	assert.Equal(t, "test/data/org_your-org/folders/some-folder.json", result)
 }
 
@@ -87,6 +89,27 @@ func TestBuildDataSourcePath(t *testing.T) {
 		OutputPath:       "test/data",
 		OrganizationName: "Your Org",
 	}
-	result := buildResourcePath(cfg, slug.Make("My DS"), domain.ConnectionResource, false, false)
+	result := buildResourcePath(cfg, slug.Make("My DS"), domain.ConnectionResource, false, nil)
 	assert.Equal(t, "test/data/org_your-org/connections/my-ds.json", result)
 }
+
+func TestClearedFoldersPerService(t *testing.T) {
+	root := t.TempDir()
+	newService := func() *DashNGoImpl {
+		s := newContextTestService("http://localhost:3000", &configDomain.AppGlobals{ClearOutput: true})
+		s.grafanaConf.OutputPath = root
+		return s
+	}
+	first, second := newService(), newService()
+	userPath := BuildResourceFolder(first.grafanaConf, "", domain.UserResource, true, first.clearedFolders())
+	stale := filepath.Join(userPath, "stale.json")
+	assert.NoError(t, os.WriteFile(stale, []byte(`{}`), 0o600))
+
+	BuildResourceFolder(first.grafanaConf, "", domain.UserResource, true, first.WithContext(t.Context()).clearedFolders())
+	assert.FileExists(t, stale, "folders are cleared once per service, copies included")
+	BuildResourceFolder(second.grafanaConf, "", domain.UserResource, true, second.clearedFolders())
+	assert.NoFileExists(t, stale, "another service clears the folder again")
+
+	first.GetGlobals().ClearOutput = false
+	assert.Nil(t, first.clearedFolders())
+}
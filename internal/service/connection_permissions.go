@@ -2,15 +2,15 @@ package service
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"path/filepath"
 	"strings"
 
 	configDomain "github.com/esnet/gdg/pkg/config/domain"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/grafana/grafana-openapi-client-go/client/access_control"
 
@@ -29,12 +29,15 @@ const (
 )
 
 // ListConnectionPermissions lists all connection permission matching the given filter
-func (s *DashNGoImpl) ListConnectionPermissions(filter filters.V2Filter) []domain.ConnectionPermissionItem {
-	if !s.IsEnterprise() {
-		log.Fatal("Requires Enterprise to be enabled.  Please check your GDG configuration and try again")
+func (s *DashNGoImpl) ListConnectionPermissions(filter filters.V2Filter) ([]domain.ConnectionPermissionItem, error) {
+	if err := s.requireEnterprise(); err != nil {
+		return nil, err
 	}
 	result := make([]domain.ConnectionPermissionItem, 0)
-	connections := s.ListConnections(filter)
+	connections, err := s.ListConnections(filter)
+	if err != nil {
+		return nil, err
+	}
 	for ndx, connection := range connections {
 
 		permission, err := s.getConnectionPermission(connection.UID)
@@ -53,37 +56,40 @@ func (s *DashNGoImpl) ListConnectionPermissions(filter filters.V2Filter) []domai
 		result = append(result, entry)
 	}
 
-	return result
+	return result, nil
 }
 
 // DownloadConnectionPermissions download permissions to local file system
-func (s *DashNGoImpl) DownloadConnectionPermissions(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DownloadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	slog.Info("Downloading connection permissions")
 	var (
 		dsPacked  []byte
-		err       error
 		dataFiles []string
 	)
-	currentPermissions := s.ListConnectionPermissions(filter)
+	currentPermissions, err := s.ListConnectionPermissions(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, connection := range currentPermissions {
 		if dsPacked, err = json.MarshalIndent(connection, "", "	"); err != nil {
-			slog.Error("unable to marshall json ", "err", err.Error(), "connectionName", connection.Connection.Name)
+			s.recordFailure(configDomain.ConnectionPermissionResource, connection.Connection.Name, fmt.Errorf("unable to marshall json: %w", err))
 			continue
 		}
-		dsPath := buildResourcePath(s.grafanaConf, slug.Make(connection.Connection.Name), configDomain.ConnectionPermissionResource, s.isLocal(), s.GetGlobals().ClearOutput)
-		if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
-			slog.Error("unable to write file. ", "filename", slug.Make(connection.Connection.Name), "error", err.Error())
+		dsPath := buildResourcePath(s.grafanaConf, slug.Make(connection.Connection.Name), configDomain.ConnectionPermissionResource, s.isLocal(), s.clearedFolders())
+		if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
+			s.recordFailure(configDomain.ConnectionPermissionResource, connection.Connection.Name, fmt.Errorf("unable to write file: %w", err))
 		} else {
+			s.recordSuccess(configDomain.ConnectionPermissionResource, connection.Connection.Name)
 			dataFiles = append(dataFiles, dsPath)
 		}
 	}
-	return dataFiles
+	return dataFiles, nil
 }
 
 // UploadConnectionPermissions upload connection permissions
-func (s *DashNGoImpl) UploadConnectionPermissions(filter filters.V2Filter) []string {
-	if !s.IsEnterprise() {
-		log.Fatal("Requires Enterprise to be enabled.  Please check your GDG configuration and try again")
+func (s *DashNGoImpl) UploadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
+	if err := s.requireEnterprise(); err != nil {
+		return nil, err
 	}
 	var (
 		rawFolder []byte
@@ -91,15 +97,16 @@ func (s *DashNGoImpl) UploadConnectionPermissions(filter filters.V2Filter) []str
 	)
 
 	orgName := s.grafanaConf.GetOrganizationName()
-	filesInDir, err := s.storage.FindAllFiles(s.grafanaConf.GetPath(configDomain.ConnectionPermissionResource, orgName), false)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), s.grafanaConf.GetPath(configDomain.ConnectionPermissionResource, orgName), false)
 	if err != nil {
-		log.Fatalf("Failed to read folders permission imports: %s", err.Error())
+		return nil, fmt.Errorf("failed to read connection permission imports: %w", err)
 	}
+	resolver := s.newPrincipalResolver()
 	for _, file := range filesInDir {
 		fileLocation := filepath.Join(s.grafanaConf.GetPath(configDomain.ConnectionPermissionResource, orgName), file)
 		if strings.HasSuffix(file, ".json") {
-			if rawFolder, err = s.storage.ReadFile(fileLocation); err != nil {
-				slog.Error("failed to read file %s", "filename", fileLocation, "err", err)
+			if rawFolder, err = s.readBackupFile(fileLocation); err != nil {
+				s.recordFailure(configDomain.ConnectionPermissionResource, fileLocation, fmt.Errorf("failed to read file: %w", err))
 				continue
 			}
 		}
@@ -110,13 +117,13 @@ func (s *DashNGoImpl) UploadConnectionPermissions(filter filters.V2Filter) []str
 		newEntries := new(domain.ConnectionPermissionItem)
 		err = json.Unmarshal(rawFolder, &newEntries)
 		if err != nil {
-			slog.Warn("Failed to Decode payload for file", "filename", fileLocation)
+			s.recordFailure(configDomain.ConnectionPermissionResource, fileLocation, fmt.Errorf("failed to decode payload: %w", err))
 			continue
 		}
 		// Get current permissions
 		permissions, err := s.getConnectionPermission(newEntries.Connection.UID)
 		if err != nil {
-			slog.Error("connection permission could not be retrieved, cannot update permissions")
+			s.recordFailure(configDomain.ConnectionPermissionResource, newEntries.Connection.Name, fmt.Errorf("connection permission could not be retrieved: %w", err))
 			continue
 		}
 
@@ -131,12 +138,15 @@ func (s *DashNGoImpl) UploadConnectionPermissions(filter filters.V2Filter) []str
 		}
 
 		if removePermissionError != nil {
-			slog.Error("Failed to delete previous data, cannot update permissions")
+			s.recordFailure(configDomain.ConnectionPermissionResource, newEntries.Connection.Name, fmt.Errorf("failed to delete previous permissions: %w", removePermissionError))
 			continue
 		}
 
 		success := true
 		for _, permission := range newEntries.Permissions {
+			if !resolver.resolveResource(permission) {
+				continue
+			}
 			err = s.updatedConnectionPermission(newEntries.Connection, permission, permission.Permission)
 			if err != nil {
 				slog.Error("Failed to update connection permissions", slog.Any("userId", permission.UserLogin), slog.Any("team", permission.Team), slog.Any("role", permission.BuiltInRole), slog.Any("permission", permission.Permission))
@@ -144,33 +154,45 @@ func (s *DashNGoImpl) UploadConnectionPermissions(filter filters.V2Filter) []str
 			}
 
 		}
-		if success {
+		unresolvedErr := resolver.unresolvedError(fileLocation)
+		switch {
+		case !success:
+			s.recordFailure(configDomain.ConnectionPermissionResource, newEntries.Connection.Name, errors.New("failed to update one or more permissions"))
+		case unresolvedErr != nil:
+			s.recordFailure(configDomain.ConnectionPermissionResource, newEntries.Connection.Name, unresolvedErr)
+		default:
+			s.recordSuccess(configDomain.ConnectionPermissionResource, newEntries.Connection.Name)
 			dataFiles = append(dataFiles, fileLocation)
 		}
 	}
 
 	slog.Info("Removing all previous permissions and re-applying")
-	return dataFiles
+	return dataFiles, nil
 }
 
 // DeleteAllConnectionPermissions clear all non-default permissions from all connections
-func (s *DashNGoImpl) DeleteAllConnectionPermissions(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DeleteAllConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	dataSources := make([]string, 0)
-	connectionPermissions := s.ListConnectionPermissions(filter)
+	connectionPermissions, err := s.ListConnectionPermissions(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, conn := range connectionPermissions {
-		success := true
+		var deleteErr error
 		for _, p := range conn.Permissions {
-			deleteConnectionErr := s.updatedConnectionPermission(conn.Connection, p, "")
-			if deleteConnectionErr != nil {
-				success = false
+			if deleteConnectionErr := s.updatedConnectionPermission(conn.Connection, p, ""); deleteConnectionErr != nil {
+				deleteErr = deleteConnectionErr
 			}
 		}
-		if success {
-			dataSources = append(dataSources, conn.Connection.Name)
+		if deleteErr != nil {
+			s.recordFailure(configDomain.ConnectionPermissionResource, conn.Connection.Name, deleteErr)
+			continue
 		}
+		s.recordSuccess(configDomain.ConnectionPermissionResource, conn.Connection.Name)
+		dataSources = append(dataSources, conn.Connection.Name)
 	}
 
-	return dataSources
+	return dataSources, nil
 }
 
 func getPermissionType(perm models.ResourcePermissionDTO) PermissionType {
@@ -3,7 +3,6 @@ package service
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"log/slog"
 	"path/filepath"
 	"reflect"
@@ -20,7 +19,7 @@ import (
 	"github.com/gosimple/slug"
 )
 
-func setupConnectionReaders(filterObj filters.V2Filter) {
+func setupConnectionReaders(filterObj filters.V2Filter) error {
 	obj := models.DataSourceListItemDTO{}
 	err := filterObj.RegisterReader(reflect.TypeOf(obj), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(models.DataSourceListItemDTO)
@@ -36,7 +35,7 @@ func setupConnectionReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Connection Filter, aborting.")
+		return fmt.Errorf("unable to create a valid connection filter: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.([]byte)
@@ -67,13 +66,16 @@ func setupConnectionReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Connection Filter, aborting.")
+		return fmt.Errorf("unable to create a valid connection filter: %w", err)
 	}
+	return nil
 }
 
-func NewConnectionFilter(name string) filters.V2Filter {
+func NewConnectionFilter(name string) (filters.V2Filter, error) {
 	filterEntity := v2.NewBaseFilter()
-	setupConnectionReaders(filterEntity)
+	if err := setupConnectionReaders(filterEntity); err != nil {
+		return nil, err
+	}
 	getValidateFunc := func(filterType filters.FilterType) func(value any, expected any) error {
 		return func(value any, expected any) error {
 			val, expression, convErr := v2.GetParams[string](value, expected, filterType)
@@ -94,19 +96,19 @@ func NewConnectionFilter(name string) filters.V2Filter {
 	// used to check filter for connection permissions
 	filterEntity.AddValidation(filters.ConnectionName, getValidateFunc(filters.ConnectionName), name)
 
-	return filterEntity
+	return filterEntity, nil
 }
 
 // ListConnections list all the currently configured connections
-func (s *DashNGoImpl) ListConnections(filter filters.V2Filter) []models.DataSourceListItemDTO {
+func (s *DashNGoImpl) ListConnections(filter filters.V2Filter) ([]models.DataSourceListItemDTO, error) {
 	err := s.SwitchOrganizationByName(s.grafanaConf.GetOrganizationName())
 	if err != nil {
-		log.Fatalf("Failed to switch organization ID %s: ", s.grafanaConf.OrganizationName)
+		return nil, fmt.Errorf("failed to switch to organization %s: %w", s.grafanaConf.GetOrganizationName(), err)
 	}
 
 	ds, err := s.GetClient().Datasources.GetDataSources()
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("unable to list connections: %w", err)
 	}
 	result := make([]models.DataSourceListItemDTO, 0)
 
@@ -121,64 +123,75 @@ func (s *DashNGoImpl) ListConnections(filter filters.V2Filter) []models.DataSour
 		}
 	}
 
-	return result
+	return result, nil
 }
 
 // DownloadConnections  will read in all the configured datasources.
 // NOTE: credentials cannot be retrieved and need to be set via configuration
-func (s *DashNGoImpl) DownloadConnections(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DownloadConnections(filter filters.V2Filter) ([]string, error) {
 	var (
-		dsListing []models.DataSourceListItemDTO
 		dsPacked  []byte
-		err       error
 		dataFiles []string
 	)
-	dsListing = s.ListConnections(filter)
+	dsListing, err := s.ListConnections(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, ds := range dsListing {
 		if dsPacked, err = json.MarshalIndent(ds, "", "	"); err != nil {
-			slog.Error("unable to marshall file", "datasource", ds.Name, "err", err)
+			s.recordFailure(domain.ConnectionResource, ds.Name, fmt.Errorf("unable to marshall file: %w", err))
 			continue
 		}
 
-		dsPath := buildResourcePath(s.grafanaConf, slug.Make(ds.Name), domain.ConnectionResource, s.isLocal(), s.GetGlobals().ClearOutput)
+		dsPath := buildResourcePath(s.grafanaConf, slug.Make(ds.Name), domain.ConnectionResource, s.isLocal(), s.clearedFolders())
 
-		if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
-			slog.Error("Unable to write file", "filename", slug.Make(ds.Name), "err", err)
+		if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
+			s.recordFailure(domain.ConnectionResource, ds.Name, fmt.Errorf("unable to write file: %w", err))
 		} else {
+			s.recordSuccess(domain.ConnectionResource, ds.Name)
 			dataFiles = append(dataFiles, dsPath)
 		}
 	}
-	return dataFiles
+	return dataFiles, nil
 }
 
 // DeleteAllConnections Removes all current datasources
-func (s *DashNGoImpl) DeleteAllConnections(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DeleteAllConnections(filter filters.V2Filter) ([]string, error) {
 	ds := make([]string, 0)
-	items := s.ListConnections(filter)
+	items, err := s.ListConnections(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, item := range items {
-		dsItem, err := s.GetClient().Datasources.DeleteDataSourceByID(fmt.Sprintf("%d", item.ID))
+		_, err = s.GetClient().Datasources.DeleteDataSourceByID(fmt.Sprintf("%d", item.ID))
 		if err != nil {
-			slog.Warn("Failed to delete datasource", "datasource", item.Name, "err", dsItem.Error())
+			s.recordFailure(domain.ConnectionResource, item.Name, fmt.Errorf("failed to delete datasource: %w", err))
 			continue
 		}
+		s.recordSuccess(domain.ConnectionResource, item.Name)
 		ds = append(ds, item.Name)
 	}
-	return ds
+	return ds, nil
 }
 
 // UploadConnections exports all connections to grafana using the credentials configured in config file.
-func (s *DashNGoImpl) UploadConnections(filter filters.V2Filter) []string {
-	var dsListing []models.DataSourceListItemDTO
-
+func (s *DashNGoImpl) UploadConnections(filter filters.V2Filter) ([]string, error) {
 	var exported []string
 
-	orgName := s.grafanaConf.GetOrganizationName()
+	promotion, err := s.promotionRules()
+	if err != nil {
+		return nil, err
+	}
+	orgName := promotion.sourceOrganization(s.grafanaConf.GetOrganizationName())
 	slog.Info("Reading files from folder", "folder", s.grafanaConf.GetPath(domain.ConnectionResource, orgName))
-	filesInDir, err := s.storage.FindAllFiles(s.grafanaConf.GetPath(domain.ConnectionResource, orgName), false)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), s.grafanaConf.GetPath(domain.ConnectionResource, orgName), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files in directory for datasources: %w", err)
+	}
+	dsListing, err := s.ListConnections(filter)
 	if err != nil {
-		slog.Error("failed to list files in directory for datasources", "err", err)
+		return nil, err
 	}
-	dsListing = s.ListConnections(filter)
 
 	var rawDS []byte
 
@@ -186,8 +199,8 @@ func (s *DashNGoImpl) UploadConnections(filter filters.V2Filter) []string {
 	for _, file := range filesInDir {
 		fileLocation := filepath.Join(s.grafanaConf.GetPath(domain.ConnectionResource, orgName), file)
 		if strings.HasSuffix(file, ".json") {
-			if rawDS, err = s.storage.ReadFile(fileLocation); err != nil {
-				slog.Error("failed to read file", "filename", fileLocation, "err", err)
+			if rawDS, err = s.readBackupFile(fileLocation); err != nil {
+				s.recordFailure(domain.ConnectionResource, fileLocation, fmt.Errorf("failed to read file: %w", err))
 				continue
 			}
 			if !filter.Validate(filters.Name, rawDS) {
@@ -196,9 +209,10 @@ func (s *DashNGoImpl) UploadConnections(filter filters.V2Filter) []string {
 
 			var newDS models.AddDataSourceCommand
 			if err = json.Unmarshal(rawDS, &newDS); err != nil {
-				slog.Error("failed to unmarshall file", "filename", fileLocation, "err", err)
+				s.recordFailure(domain.ConnectionResource, fileLocation, fmt.Errorf("failed to unmarshall file: %w", err))
 				continue
 			}
+			newDS.Name = promotion.connection(newDS.Name)
 
 			dsConfig := s.grafanaConf
 
@@ -209,7 +223,7 @@ func (s *DashNGoImpl) UploadConnections(filter filters.V2Filter) []string {
 			}
 
 			if dsSettings.FiltersEnabled() && dsSettings.IsExcluded(newDS) {
-				slog.Debug("Skipping local JSON file since source fails datatype filter checks", "datasource", newDS.Name, "datatype", newDS.Type)
+				s.recordSkipped(domain.ConnectionResource, newDS.Name, fmt.Sprintf("datatype %s is excluded", newDS.Type))
 				continue
 			}
 
@@ -235,13 +249,14 @@ func (s *DashNGoImpl) UploadConnections(filter filters.V2Filter) []string {
 				}
 			}
 
-			if createStatus, err := s.GetClient().Datasources.AddDataSource(&newDS); err != nil {
-				slog.Error("error on importing datasource", "datasource", newDS.Name, "err", err, "createStatus", createStatus)
+			if _, err := s.GetClient().Datasources.AddDataSource(&newDS); err != nil {
+				s.recordFailure(domain.ConnectionResource, newDS.Name, fmt.Errorf("error on importing datasource: %w", err))
 			} else {
+				s.recordSuccess(domain.ConnectionResource, newDS.Name)
 				exported = append(exported, fileLocation)
 			}
 
 		}
 	}
-	return exported
+	return exported, nil
 }
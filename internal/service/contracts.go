@@ -1,13 +1,14 @@
 package service
 
 import (
-	customModels "github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/internal/storage"
+	customModels "github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 )
 
 type ServerInfoApi interface {
-	GetServerInfo() map[string]any
+	GetServerInfo() (map[string]any, error)
 }
 
 type GrafanaService interface {
@@ -20,6 +21,10 @@ type GrafanaService interface {
 	LibraryElementsApi
 	TeamsApi
 	AlertingApi
+	RolesApi
+	SSOApi
+	StorageApi
+	SyncApi
 
 	AuthenticationApi
 	// MetaData
@@ -27,33 +32,51 @@ type GrafanaService interface {
 	LicenseApi
 }
 
+// StorageCommitter is implemented by services whose storage engine commits the changes of a command at once
+type StorageCommitter interface {
+	CommitStorage(subject string) error
+}
+
+// StorageApi Contract definition
+type StorageApi interface {
+	ReEncryptStorage(previous map[string]string) ([]string, error)
+	PruneSnapshots(policy storage.RetentionPolicy, dryRun bool) ([]storage.SnapshotResult, error)
+	CopyStorage(from, to string, resume bool) ([]storage.CopyResult, error)
+	VerifyBackup() ([]VerifyIssue, error)
+}
+
+// SyncApi Contract definition
+type SyncApi interface {
+	SyncContexts(opts SyncOptions) ([]SyncResult, error)
+}
+
 type LicenseApi interface {
-	IsEnterprise() bool
+	IsEnterprise() (bool, error)
 }
 
 // ConnectionsApi Contract definition
 type ConnectionsApi interface {
-	ListConnections(filter filters.V2Filter) []models.DataSourceListItemDTO
-	DownloadConnections(filter filters.V2Filter) []string
-	UploadConnections(filter filters.V2Filter) []string
-	DeleteAllConnections(filter filters.V2Filter) []string
+	ListConnections(filter filters.V2Filter) ([]models.DataSourceListItemDTO, error)
+	DownloadConnections(filter filters.V2Filter) ([]string, error)
+	UploadConnections(filter filters.V2Filter) ([]string, error)
+	DeleteAllConnections(filter filters.V2Filter) ([]string, error)
 	ConnectionPermissions
 }
 
 type ConnectionPermissions interface {
 	// Permissions Enterprise only
-	ListConnectionPermissions(filter filters.V2Filter) []customModels.ConnectionPermissionItem
-	DownloadConnectionPermissions(filter filters.V2Filter) []string
-	UploadConnectionPermissions(filter filters.V2Filter) []string
-	DeleteAllConnectionPermissions(filter filters.V2Filter) []string
+	ListConnectionPermissions(filter filters.V2Filter) ([]customModels.ConnectionPermissionItem, error)
+	DownloadConnectionPermissions(filter filters.V2Filter) ([]string, error)
+	UploadConnectionPermissions(filter filters.V2Filter) ([]string, error)
+	DeleteAllConnectionPermissions(filter filters.V2Filter) ([]string, error)
 }
 
 // DashboardsApi Contract definition
 type DashboardsApi interface {
-	ListDashboards(filter filters.V2Filter) []*customModels.NestedHit
-	DownloadDashboards(filter filters.V2Filter) []string
+	ListDashboards(filter filters.V2Filter) ([]*customModels.NestedHit, error)
+	DownloadDashboards(filter filters.V2Filter) ([]string, error)
 	UploadDashboards(filterReq filters.V2Filter) ([]string, error)
-	DeleteAllDashboards(filter filters.V2Filter) []string
+	DeleteAllDashboards(filter filters.V2Filter) ([]string, error)
 }
 
 type AlertContactPoints interface {
@@ -99,6 +122,22 @@ type AlertingApi interface {
 	AlertTimings
 }
 
+// RolesApi Contract definition, Enterprise only
+type RolesApi interface {
+	ListRoles(filter filters.V2Filter) ([]*customModels.RoleWithAssignments, error)
+	DownloadRoles(filter filters.V2Filter) ([]string, error)
+	UploadRoles(filter filters.V2Filter) ([]string, error)
+	DeleteAllRoles(filter filters.V2Filter) ([]string, error)
+}
+
+// SSOApi Contract definition
+type SSOApi interface {
+	ListSSOSettings() ([]*models.ListAllProvidersSettingsOKBodyItems, error)
+	DownloadSSOSettings() ([]string, error)
+	UploadSSOSettings() ([]string, error)
+	DiffSSOSettings() ([]customModels.SSOSettingsDiff, error)
+}
+
 type DashboardPermissionsApi interface {
 	ListDashboardPermissions(filterReq filters.V2Filter) ([]customModels.DashboardAndPermissions, error)
 	DownloadDashboardPermissions(filterReq filters.V2Filter) ([]string, error)
@@ -108,31 +147,31 @@ type DashboardPermissionsApi interface {
 
 // FoldersApi Contract definition
 type FoldersApi interface {
-	ListFolders(filter filters.V2Filter) []*customModels.NestedHit
-	DownloadFolders(filter filters.V2Filter) []string
-	UploadFolders(filter filters.V2Filter) []string
-	DeleteAllFolders(filter filters.V2Filter) []string
+	ListFolders(filter filters.V2Filter) ([]*customModels.NestedHit, error)
+	DownloadFolders(filter filters.V2Filter) ([]string, error)
+	UploadFolders(filter filters.V2Filter) ([]string, error)
+	DeleteAllFolders(filter filters.V2Filter) ([]string, error)
 	// Permissions
-	ListFolderPermissions(filter filters.V2Filter) map[*customModels.NestedHit][]*models.DashboardACLInfoDTO
-	DownloadFolderPermissions(filter filters.V2Filter) []string
-	UploadFolderPermissions(filter filters.V2Filter) []string
+	ListFolderPermissions(filter filters.V2Filter) (map[*customModels.NestedHit][]*models.DashboardACLInfoDTO, error)
+	DownloadFolderPermissions(filter filters.V2Filter) ([]string, error)
+	UploadFolderPermissions(filter filters.V2Filter) ([]string, error)
 }
 
 type LibraryElementsApi interface {
-	ListLibraryElements(filter filters.V2Filter) []*customModels.WithNested[models.LibraryElementDTO]
-	ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) []*models.DashboardFullWithMeta
-	DownloadLibraryElements(filter filters.V2Filter) []string
-	UploadLibraryElements(filter filters.V2Filter) []string
-	DeleteAllLibraryElements(filter filters.V2Filter) []string
+	ListLibraryElements(filter filters.V2Filter) ([]*customModels.WithNested[models.LibraryElementDTO], error)
+	ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) ([]*models.DashboardFullWithMeta, error)
+	DownloadLibraryElements(filter filters.V2Filter) ([]string, error)
+	UploadLibraryElements(filter filters.V2Filter) ([]string, error)
+	DeleteAllLibraryElements(filter filters.V2Filter) ([]string, error)
 }
 
 // AuthenticationApi Contract definition
 type AuthenticationApi interface {
 	// TokenApi
 	ServiceAccountApi
-	Login()
-	EncodeValue(in string) string
-	DecodeValue(in string) string
+	Login() error
+	EncodeValue(in string) (string, error)
+	DecodeValue(in string) (string, error)
 }
 
 // OrgPreferencesApi Contract definition
@@ -142,23 +181,25 @@ type OrgPreferencesApi interface {
 }
 
 type organizationCrudApi interface {
-	ListOrganizations(filter filters.V2Filter, withPreferences bool) []*customModels.OrgsDTOWithPreferences
-	DownloadOrganizations(filter filters.V2Filter) []string
-	UploadOrganizations(filter filters.V2Filter) []string
+	ListOrganizations(filter filters.V2Filter, withPreferences bool) ([]*customModels.OrgsDTOWithPreferences, error)
+	DownloadOrganizations(filter filters.V2Filter) ([]string, error)
+	UploadOrganizations(filter filters.V2Filter, renames map[string]string) ([]string, error)
+	DeleteAllOrganizations(filter filters.V2Filter) ([]string, error)
 }
 
 type organizationToolsApi interface {
 	// Manage Active Organization
 	SetOrganizationByName(name string, useSlug bool) error
-	GetUserOrganization() *models.OrgDetailsDTO
-	GetTokenOrganization() *models.OrgDetailsDTO
+	WithOrganization(name string) (GrafanaService, error)
+	GetUserOrganization() (*models.OrgDetailsDTO, error)
+	GetTokenOrganization() (*models.OrgDetailsDTO, error)
 	SetUserOrganizations(id int64) error
 	ListUserOrganizations() ([]*models.UserOrgDTO, error)
 }
 
 // organizationUserCrudApi  Manages user memberships to an org
 type organizationUserCrudApi interface {
-	ListOrgUsers(orgId int64) []*models.OrgUserDTO
+	ListOrgUsers(orgId int64) ([]*models.OrgUserDTO, error)
 	AddUserToOrg(role, orgSlug string, userId int64) error
 	DeleteUserFromOrg(orgId string, userId int64) error
 	UpdateUserInOrg(role, orgSlug string, userId int64) error
@@ -170,34 +211,34 @@ type OrganizationsApi interface {
 	organizationToolsApi
 	organizationUserCrudApi
 	OrgPreferencesApi
-	InitOrganizations()
+	InitOrganizations() error
 }
 
 type ServiceAccountApi interface {
-	ListServiceAccounts() []*customModels.ServiceAccountDTOWithTokens
+	ListServiceAccounts() ([]*customModels.ServiceAccountDTOWithTokens, error)
 	ListServiceAccountsTokens(id int64) ([]*models.TokenDTO, error)
 	DeleteServiceAccount(accountId int64) error
-	DeleteAllServiceAccounts() []string
-	DeleteServiceAccountTokens(serviceId int64) []string
+	DeleteAllServiceAccounts() ([]string, error)
+	DeleteServiceAccountTokens(serviceId int64) ([]string, error)
 	CreateServiceAccountToken(serviceAccountId int64, name string, expiration int64) (*models.NewAPIKeyResult, error)
 	CreateServiceAccount(name, role string, expiration int64) (*models.ServiceAccountDTO, error)
 }
 
 type TeamsApi interface {
 	// Team
-	DownloadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO
-	UploadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO
-	ListTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO
+	DownloadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)
+	UploadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)
+	ListTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)
 	DeleteTeam(filter filters.V2Filter) ([]*models.TeamDTO, error)
 }
 
 // UsersApi Contract definition
 type UsersApi interface {
 	// UserApi
-	ListUsers(filter filters.V2Filter) []*models.UserSearchHitDTO
-	DownloadUsers(filter filters.V2Filter) []string
-	UploadUsers(filter filters.V2Filter) []customModels.UserProfileWithAuth
-	DeleteAllUsers(filter filters.V2Filter) []string
+	ListUsers(filter filters.V2Filter) ([]*models.UserSearchHitDTO, error)
+	DownloadUsers(filter filters.V2Filter) ([]string, error)
+	UploadUsers(filter filters.V2Filter) ([]customModels.UserProfileWithAuth, error)
+	DeleteAllUsers(filter filters.V2Filter) ([]string, error)
 	// Tools
 	PromoteUser(userLogin string) (string, error)
 	GetUserInfo() (*models.UserProfileDTO, error)
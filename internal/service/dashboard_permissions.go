@@ -2,14 +2,14 @@ package service
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"strings"
 
 	configDomain "github.com/esnet/gdg/pkg/config/domain"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/tools/ptr"
 
@@ -22,19 +22,23 @@ import (
 )
 
 func (s *DashNGoImpl) ListDashboardPermissions(filterReq filters.V2Filter) ([]domain.DashboardAndPermissions, error) {
-	validateDashboardEnterpriseSupport(s)
-	dashboards := s.ListDashboards(filterReq)
+	if err := s.requireEnterprise(); err != nil {
+		return nil, err
+	}
+	dashboards, err := s.ListDashboards(filterReq)
+	if err != nil {
+		return nil, err
+	}
+	useResourcePermissions := s.supportsResourcePermissions()
 	var result []domain.DashboardAndPermissions
 	for _, dashboard := range dashboards {
 		item := domain.DashboardAndPermissions{Dashboard: dashboard}
-		perms, err := s.GetClient().Dashboards.GetDashboardPermissionsListByUID(dashboard.UID)
+		perms, err := s.getDashboardPermissions(dashboard.UID, useResourcePermissions)
 		if err != nil {
-			slog.Warn("Unable to retrieve permissions for dashboard",
-				slog.String("uid", dashboard.UID),
-				slog.String("Name", dashboard.Title))
+			s.recordFailure(configDomain.DashboardPermissionsResource, dashboard.Title, fmt.Errorf("unable to retrieve permissions for dashboard %s: %w", dashboard.UID, err))
 			continue
 		} else {
-			item.Permissions = perms.GetPayload()
+			item.Permissions = perms
 		}
 		result = append(result, item)
 	}
@@ -48,7 +52,6 @@ func (s *DashNGoImpl) DownloadDashboardPermissions(filterReq filters.V2Filter) (
 		err       error
 		dataFiles []string
 	)
-	validateDashboardEnterpriseSupport(s)
 	boardLinks, err := s.ListDashboardPermissions(filterReq)
 	if err != nil {
 		return nil, err
@@ -59,14 +62,15 @@ func (s *DashNGoImpl) DownloadDashboardPermissions(filterReq filters.V2Filter) (
 			continue
 		}
 		if dsPacked, err = json.MarshalIndent(link.Permissions, "", "	"); err != nil {
-			slog.Error("unable to marshall json ", "err", err.Error(), "dashboard", link.Dashboard.Title)
+			s.recordFailure(configDomain.DashboardPermissionsResource, link.Dashboard.Title, fmt.Errorf("unable to marshall json: %w", err))
 			continue
 		}
 
-		dsPath := fmt.Sprintf("%s/%s.json", BuildResourceFolder(s.grafanaConf, link.Dashboard.NestedPath, configDomain.DashboardPermissionsResource, s.isLocal(), s.GetGlobals().ClearOutput), slug.Make(link.Dashboard.Title))
-		if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
-			slog.Error("unable to write file. ", "filename", slug.Make(link.Dashboard.Title), "error", err.Error())
+		dsPath := fmt.Sprintf("%s/%s.json", BuildResourceFolder(s.grafanaConf, link.Dashboard.NestedPath, configDomain.DashboardPermissionsResource, s.isLocal(), s.clearedFolders()), slug.Make(link.Dashboard.Title))
+		if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
+			s.recordFailure(configDomain.DashboardPermissionsResource, link.Dashboard.Title, fmt.Errorf("unable to write file: %w", err))
 		} else {
+			s.recordSuccess(configDomain.DashboardPermissionsResource, link.Dashboard.Title)
 			dataFiles = append(dataFiles, dsPath)
 		}
 	}
@@ -74,14 +78,31 @@ func (s *DashNGoImpl) DownloadDashboardPermissions(filterReq filters.V2Filter) (
 	return dataFiles, nil
 }
 
-func validateDashboardEnterpriseSupport(s *DashNGoImpl) {
-	if !s.IsEnterprise() {
-		log.Fatalf("Enterprise support is required for Dashboard Permissions")
+// getDashboardPermissions retrieves the permissions of a dashboard either from the access-control API or the legacy ACL endpoint
+func (s *DashNGoImpl) getDashboardPermissions(uid string, useResourcePermissions bool) ([]*models.DashboardACLInfoDTO, error) {
+	if useResourcePermissions {
+		return s.getResourcePermissionsAsACL(dashboardResourceType, uid)
+	}
+	perms, err := s.GetClient().Dashboards.GetDashboardPermissionsListByUID(uid)
+	if err != nil {
+		return nil, err
+	}
+	return perms.GetPayload(), nil
+}
+
+// updateDashboardPermissions replaces the permissions of a dashboard either through the access-control API or the legacy ACL endpoint
+func (s *DashNGoImpl) updateDashboardPermissions(uid string, request *models.UpdateDashboardACLCommand, useResourcePermissions bool) error {
+	if useResourcePermissions {
+		return s.setResourcePermissionsFromACL(dashboardResourceType, uid, request.Items)
 	}
+	_, err := s.GetClient().Dashboards.UpdateDashboardPermissionsByUID(uid, request)
+	return err
 }
 
 func (s *DashNGoImpl) UploadDashboardPermissions(filterReq filters.V2Filter) ([]string, error) {
-	validateDashboardEnterpriseSupport(s)
+	if err := s.requireEnterprise(); err != nil {
+		return nil, err
+	}
 	var (
 		rawFile   []byte
 		dataFiles []string
@@ -89,37 +110,49 @@ func (s *DashNGoImpl) UploadDashboardPermissions(filterReq filters.V2Filter) ([]
 	)
 	// Fallback on defaults
 	if filterReq == nil {
-		filterReq = NewDashboardFilter(s.gdgConfig, "", "", "")
+		if filterReq, err = NewDashboardFilter(s.gdgConfig, "", "", ""); err != nil {
+			return nil, err
+		}
 	}
 
 	orgName := s.grafanaConf.GetOrganizationName()
-	folderUidMap := s.getFolderNameUIDMap(s.ListFolders(NewFolderFilter(s.gdgConfig)))
+	folderFilter, err := NewFolderFilter(s.gdgConfig)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := s.ListFolders(folderFilter)
+	if err != nil {
+		return nil, err
+	}
+	folderUidMap := s.getFolderNameUIDMap(folders)
+	resolver := s.newPrincipalResolver()
+	useResourcePermissions := s.supportsResourcePermissions()
 	path := s.grafanaConf.GetPath(configDomain.DashboardPermissionsResource, orgName)
-	filesInDir, err := s.storage.FindAllFiles(path, true)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), path, true)
 	if err != nil {
-		log.Fatalf("Failed to read folders permission imports: %s", err.Error())
+		return nil, fmt.Errorf("failed to read dashboard permission imports: %w", err)
 	}
 	for _, file := range filesInDir {
 
 		if !strings.HasSuffix(file, ".json") {
-			slog.Warn("Only json files are supported, skipping", "filename", file)
+			s.recordSkipped(configDomain.DashboardPermissionsResource, file, "only json files are supported")
 			continue
 		}
-		if rawFile, err = s.storage.ReadFile(file); err != nil {
-			slog.Warn("Unable to read file", "filename", file, "err", err)
+		if rawFile, err = s.readBackupFile(file); err != nil {
+			s.recordFailure(configDomain.DashboardPermissionsResource, file, fmt.Errorf("unable to read file: %w", err))
 			continue
 		}
 
 		r := gjson.GetBytes(rawFile, "#.uid")
 		if !r.Exists() || !r.IsArray() {
-			slog.Error("No valid dashboard UID references were found, cannot apply permission", "file", file)
+			s.recordFailure(configDomain.DashboardPermissionsResource, file, errors.New("no valid dashboard UID references were found, cannot apply permission"))
 			continue
 		}
 		uids := lo.Uniq(lo.Map(r.Array(), func(item gjson.Result, index int) string {
 			return item.String()
 		}))
 		if len(uids) > 1 {
-			slog.Error("too many UID references found in file. Cannot set permissions on dashboard", "file", file, "uids", uids)
+			s.recordFailure(configDomain.DashboardPermissionsResource, file, fmt.Errorf("too many UID references found in file, cannot set permissions on dashboard: %v", uids))
 			continue
 		}
 
@@ -133,31 +166,36 @@ func (s *DashNGoImpl) UploadDashboardPermissions(filterReq filters.V2Filter) ([]
 		}
 		folderUidMap, err = s.baseFolderValidation(filterReq, folderName, ptr.Of(""), folderUidMap, rawFile)
 		if err != nil {
-			slog.Warn("validation failed, skipping", "file", file, "err", err)
+			s.recordError(configDomain.DashboardPermissionsResource, file, err)
 			continue
 		}
 
 		var permissions []*models.DashboardACLInfoDTO
 		err = json.Unmarshal(rawFile, &permissions)
 		if err != nil || len(permissions) == 0 {
-			slog.Error("failed to unmarshall permissions for file.", slog.String("filename", file), "err", err)
+			s.recordFailure(configDomain.DashboardPermissionsResource, file, fmt.Errorf("failed to unmarshall permissions: %v", err))
 			continue
 		}
 		dashboardId := uids[0]
 		request := &models.UpdateDashboardACLCommand{Items: make([]*models.DashboardACLUpdateItem, 0)}
 		for _, permission := range permissions {
-			item := &models.DashboardACLUpdateItem{
-				Permission: permission.Permission,
-				Role:       permission.Role,
-				TeamID:     permission.TeamID,
-				UserID:     permission.UserID,
+			// inherited permissions are managed on the parent folder
+			if permission.Inherited {
+				continue
+			}
+			if item, ok := resolver.resolveACL(permission); ok {
+				request.Items = append(request.Items, item)
 			}
-			request.Items = append(request.Items, item)
 		}
-		_, err = s.GetClient().Dashboards.UpdateDashboardPermissionsByUID(dashboardId, request)
+		unresolvedErr := resolver.unresolvedError(file)
+		err = s.updateDashboardPermissions(dashboardId, request, useResourcePermissions)
+		if err == nil {
+			err = unresolvedErr
+		}
 		if err != nil {
-			slog.Error("Failed to process file", slog.String("filename", file))
+			s.recordFailure(configDomain.DashboardPermissionsResource, file, err)
 		} else {
+			s.recordSuccess(configDomain.DashboardPermissionsResource, file)
 			dataFiles = append(dataFiles, file)
 		}
 	}
@@ -165,20 +203,20 @@ func (s *DashNGoImpl) UploadDashboardPermissions(filterReq filters.V2Filter) ([]
 }
 
 func (s *DashNGoImpl) ClearDashboardPermissions(filterReq filters.V2Filter) error {
-	validateDashboardEnterpriseSupport(s)
 	boardLinks, err := s.ListDashboardPermissions(filterReq)
 	if err != nil {
-		slog.Error("unable to retrieve dashboards", slog.Any("err", err))
 		return err
 	}
+	useResourcePermissions := s.supportsResourcePermissions()
 	for _, link := range boardLinks {
 		request := &models.UpdateDashboardACLCommand{}
 		request.Items = make([]*models.DashboardACLUpdateItem, 0)
-		_, err := s.GetClient().Dashboards.UpdateDashboardPermissionsByUID(link.Dashboard.UID, request)
+		err := s.updateDashboardPermissions(link.Dashboard.UID, request, useResourcePermissions)
 		if err != nil {
-			slog.Error("Failed clear permissions fir dashboard",
-				slog.String("dashboard", fmt.Sprintf("%s%s", link.Dashboard.FolderTitle, link.Dashboard.Title)))
+			s.recordFailure(configDomain.DashboardPermissionsResource, fmt.Sprintf("%s/%s", link.Dashboard.NestedPath, link.Dashboard.Title), fmt.Errorf("failed to clear permissions: %w", err))
+			continue
 		}
+		s.recordSuccess(configDomain.DashboardPermissionsResource, fmt.Sprintf("%s/%s", link.Dashboard.NestedPath, link.Dashboard.Title))
 	}
 	return nil
 }
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"os"
 	"reflect"
@@ -15,7 +14,7 @@ import (
 
 	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/service/filters/v2"
 	"github.com/tidwall/gjson"
@@ -41,7 +40,7 @@ const (
 	NestedDashFolderName = "NestedDashFolderName"
 )
 
-func setupDashReaders(filterObj filters.V2Filter) {
+func setupDashReaders(filterObj filters.V2Filter) error {
 	obj := domain.NestedHit{}
 	err := filterObj.RegisterReader(reflect.TypeOf(&obj), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(*domain.NestedHit)
@@ -61,7 +60,7 @@ func setupDashReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Dashboard Filter, object reader could not be created, aborting.")
+		return fmt.Errorf("unable to create a valid dashboard filter, object reader could not be created: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.([]byte)
@@ -95,7 +94,7 @@ func setupDashReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Dashboard Filter, json reader could not be created, aborting.")
+		return fmt.Errorf("unable to create a valid dashboard filter, json reader could not be created: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf(map[string]any{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(map[string]any)
@@ -112,8 +111,9 @@ func setupDashReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Dashboard Filter, map entity reader could not be created, aborting.")
+		return fmt.Errorf("unable to create a valid dashboard filter, map entity reader could not be created: %w", err)
 	}
+	return nil
 }
 
 func addFolderFilter(cfg *configDomain.GDGAppConfiguration, filterReq filters.V2Filter, folderFilter string) {
@@ -144,9 +144,9 @@ func addFolderFilter(cfg *configDomain.GDGAppConfiguration, filterReq filters.V2
 	}, folderArr)
 }
 
-func NewDashboardFilter(cfg *configDomain.GDGAppConfiguration, entries ...string) filters.V2Filter {
+func NewDashboardFilter(cfg *configDomain.GDGAppConfiguration, entries ...string) (filters.V2Filter, error) {
 	if len(entries) != 3 {
-		log.Fatalf("Unable to create a valid Dashboard Filter, aborting.")
+		return nil, errors.New("unable to create a valid dashboard filter, expected a folder, dashboard and tags filter")
 	}
 	folderFilter := entries[0]
 	dashboardFilter := entries[1]
@@ -155,12 +155,14 @@ func NewDashboardFilter(cfg *configDomain.GDGAppConfiguration, entries ...string
 	if tagsFilter != "" {
 		err := json.Unmarshal([]byte(tagsFilter), &tagObj)
 		if err != nil {
-			log.Fatalf("Unable to create a valid Dashboard Filter, aborting.")
+			return nil, fmt.Errorf("unable to create a valid dashboard filter, invalid tags %s: %w", tagsFilter, err)
 		}
 	}
 	filterObj := v2.NewBaseFilter()
-	setupDashReaders(filterObj)
 	// Setup Readers
+	if err := setupDashReaders(filterObj); err != nil {
+		return nil, err
+	}
 
 	err := filterObj.RegisterDataProcessor(filters.FolderFilter, filters.ProcessorEntity{
 		Name: "folderQuoteRegEx",
@@ -183,7 +185,7 @@ func NewDashboardFilter(cfg *configDomain.GDGAppConfiguration, entries ...string
 		},
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Dashboard Filter, aborting.")
+		return nil, fmt.Errorf("unable to create a valid dashboard filter: %w", err)
 	}
 
 	addFolderFilter(cfg, filterObj, folderFilter)
@@ -221,7 +223,7 @@ func NewDashboardFilter(cfg *configDomain.GDGAppConfiguration, entries ...string
 		return fmt.Errorf("failed validation test val:%s  expected: %s", val, exp)
 	}, tagObj)
 
-	return filterObj
+	return filterObj, nil
 }
 
 // getDashboardByUid retrieve a dashboard given a particular uid.
@@ -237,10 +239,13 @@ func (s *DashNGoImpl) getDashboardByUid(uid string) (*models.DashboardFullWithMe
 
 // ListDashboards List all dashboards optionally filtered by folder name. If folderFilters
 // is blank, defaults to the configured Monitored folders
-func (s *DashNGoImpl) ListDashboards(filterReq filters.V2Filter) []*domain.NestedHit {
+func (s *DashNGoImpl) ListDashboards(filterReq filters.V2Filter) ([]*domain.NestedHit, error) {
+	var err error
 	// Fallback on defaults
 	if filterReq == nil {
-		filterReq = NewDashboardFilter(s.gdgConfig, "", "", "")
+		if filterReq, err = NewDashboardFilter(s.gdgConfig, "", "", ""); err != nil {
+			return nil, err
+		}
 	}
 
 	boardLinks := make([]*domain.NestedHit, 0)
@@ -255,7 +260,7 @@ func (s *DashNGoImpl) ListDashboards(filterReq filters.V2Filter) []*domain.Neste
 		tagsParams = append(tagsParams, val...)
 	}
 
-	retrieve := func(tag string) {
+	retrieve := func(tag string) error {
 		for {
 			searchParams := search.NewSearchParams()
 			if tag != "" {
@@ -267,7 +272,7 @@ func (s *DashNGoImpl) ListDashboards(filterReq filters.V2Filter) []*domain.Neste
 
 			pageBoardLinks, err := s.GetClient().Search.Search(searchParams)
 			if err != nil {
-				log.Fatal("Failed to retrieve dashboards", err)
+				return fmt.Errorf("failed to retrieve dashboards: %w", err)
 			}
 			boardLinks = append(boardLinks,
 				lo.Map(pageBoardLinks.GetPayload(), func(item *models.Hit, index int) *domain.NestedHit {
@@ -278,18 +283,26 @@ func (s *DashNGoImpl) ListDashboards(filterReq filters.V2Filter) []*domain.Neste
 			}
 			page += 1
 		}
+		return nil
 	}
 	if len(tagsParams) == 0 {
-		retrieve("")
+		if err = retrieve(""); err != nil {
+			return nil, err
+		}
 	} else {
 		// need to iterate over all tags since grafana API filters on AND (&&) instead of OR (||)
 		for _, tag := range tagsParams {
-			retrieve(tag)
+			if err = retrieve(tag); err != nil {
+				return nil, err
+			}
 			slog.Info("retrieving dashboard by tag", slog.String("tag", tag))
 		}
 	}
 
-	folderUidMap := s.getFolderUIDEntityMap(nil)
+	folderUidMap, err := s.getFolderUIDEntityMap(nil)
+	if err != nil {
+		return nil, err
+	}
 	var validFolder bool
 	var validUid bool
 	for ndx, link := range boardLinks {
@@ -338,19 +351,20 @@ func (s *DashNGoImpl) ListDashboards(filterReq filters.V2Filter) []*domain.Neste
 		return boardLinks[i].ID < boardLinks[j].ID
 	})
 
-	return boardLinks
+	return boardLinks, nil
 }
 
 // DownloadDashboards saves all dashboards matching query to configured location
-func (s *DashNGoImpl) DownloadDashboards(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DownloadDashboards(filter filters.V2Filter) ([]string, error) {
 	var (
-		boardLinks []*domain.NestedHit
-		rawBoard   []byte
-		err        error
-		metaData   *dashboards.GetDashboardByUIDOK
+		rawBoard []byte
+		metaData *dashboards.GetDashboardByUIDOK
 	)
 
-	boardLinks = s.ListDashboards(filter)
+	boardLinks, err := s.ListDashboards(filter)
+	if err != nil {
+		return nil, err
+	}
 
 	var boards []string
 	for _, link := range boardLinks {
@@ -360,25 +374,26 @@ func (s *DashNGoImpl) DownloadDashboards(filter filters.V2Filter) []string {
 		}
 
 		if metaData, err = s.GetClient().Dashboards.GetDashboardByUID(link.UID); err != nil {
-			slog.Error("unable to get Dashboard by UID", "err", err, "Dashboard-URI", link.URI)
+			s.recordFailure(resourceTypes.DashboardResource, link.Title, fmt.Errorf("unable to get dashboard by UID %s: %w", link.UID, err))
 			continue
 		}
 
 		rawBoard, err = json.Marshal(metaData.GetPayload().Dashboard)
 		if err != nil {
-			slog.Error("unable to serialize dashboard", "dashboard", link.UID)
+			s.recordFailure(resourceTypes.DashboardResource, link.Title, fmt.Errorf("unable to serialize dashboard: %w", err))
 			continue
 		}
 
-		fileName := fmt.Sprintf("%s/%s.json", BuildResourceFolder(s.grafanaConf, link.NestedPath, resourceTypes.DashboardResource, s.isLocal(), s.GetGlobals().ClearOutput), metaData.GetPayload().Meta.Slug)
-		if err = s.storage.WriteFile(fileName, pretty.Pretty(rawBoard)); err != nil {
-			slog.Error("Unable to save dashboard to file\n", "err", err, "dashboard", metaData.GetPayload().Meta.Slug)
+		fileName := fmt.Sprintf("%s/%s.json", BuildResourceFolder(s.grafanaConf, link.NestedPath, resourceTypes.DashboardResource, s.isLocal(), s.clearedFolders()), metaData.GetPayload().Meta.Slug)
+		if err = s.writeBackupFile(fileName, pretty.Pretty(rawBoard)); err != nil {
+			s.recordFailure(resourceTypes.DashboardResource, link.Title, fmt.Errorf("unable to save dashboard to file: %w", err))
 		} else {
+			s.recordSuccess(resourceTypes.DashboardResource, link.Title)
 			boards = append(boards, fileName)
 		}
 
 	}
-	return boards
+	return boards, nil
 }
 
 // getNestedFolder use this if calling from within the service, returns the nested folder path for a given folder
@@ -405,7 +420,15 @@ func (s *DashNGoImpl) TestCreatedFolders(folderName string) (map[string]string,
 
 // createFolders Creates a new folder with the given name.  If nested, each sub folder that does not exist is also created
 func (s *DashNGoImpl) createdFolders(folderName string) (map[string]string, error) {
-	namedUIDMap := getFolderMapping(s.ListFolders(NewFolderFilter(s.gdgConfig)),
+	folderFilter, err := NewFolderFilter(s.gdgConfig)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := s.ListFolders(folderFilter)
+	if err != nil {
+		return nil, err
+	}
+	namedUIDMap := getFolderMapping(folders,
 		func(db *domain.NestedHit) string {
 			return db.NestedPath
 		},
@@ -442,7 +465,7 @@ func (s *DashNGoImpl) createdFolders(folderName string) (map[string]string, erro
 			}
 
 			if pathErr != nil || cnt <= 0 {
-				log.Fatal("unable to update folder path, critical logic error")
+				return newFoldersMap, fmt.Errorf("unable to update folder path %s", folderName)
 			}
 			if val, ok := namedUIDMap[folderPath.String()]; ok {
 				parentUid = val.UID
@@ -475,51 +498,69 @@ func (s *DashNGoImpl) UploadDashboards(filterReq filters.V2Filter) ([]string, er
 		folderUid  string
 		dashFiles  []string
 	)
-	dashboardPath := s.grafanaConf.GetPath(resourceTypes.DashboardResource, s.grafanaConf.GetOrganizationName())
-	filesInDir, err := s.storage.FindAllFiles(dashboardPath, true)
+	promotion, err := s.promotionRules()
+	if err != nil {
+		return nil, err
+	}
+	orgName := promotion.sourceOrganization(s.grafanaConf.GetOrganizationName())
+	dashboardPath := s.grafanaConf.GetPath(resourceTypes.DashboardResource, orgName)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), dashboardPath, true)
 	if err != nil {
 		return nil, fmt.Errorf("unable to find any dashFiles to export from storage engine, err: %w", err)
 	}
-	if filterReq == nil {
-		filterReq = NewDashboardFilter(s.gdgConfig, "", "", "")
+	connections, err := s.promotionConnections(promotion, orgName)
+	if err != nil {
+		return nil, err
 	}
 	// Fallback on defaults
 	if filterReq == nil {
-		filterReq = NewDashboardFilter(s.gdgConfig, "", "", "")
+		if filterReq, err = NewDashboardFilter(s.gdgConfig, "", "", ""); err != nil {
+			return nil, err
+		}
+	}
+	currentDashboards, err := s.ListDashboards(filterReq)
+	if err != nil {
+		return nil, err
 	}
-	currentDashboards := s.ListDashboards(filterReq)
 
-	folderUidMap := s.getFolderNameUIDMap(s.ListFolders(NewFolderFilter(s.gdgConfig)))
+	folderFilter, err := NewFolderFilter(s.gdgConfig)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := s.ListFolders(folderFilter)
+	if err != nil {
+		return nil, err
+	}
+	folderUidMap := s.getFolderNameUIDMap(folders)
 
 	alreadyProcessed := make(map[any]bool)
 
 	for _, file := range filesInDir {
 
 		if !strings.HasSuffix(file, ".json") {
-			slog.Warn("Only json dashFiles are supported, skipping", "filename", file)
+			s.recordSkipped(resourceTypes.DashboardResource, file, "only json files are supported")
 			continue
 		}
 
-		if rawBoard, err = s.storage.ReadFile(file); err != nil {
-			slog.Warn("Unable to read file", "filename", file, "err", err)
+		if rawBoard, err = s.readBackupFile(file); err != nil {
+			s.recordFailure(resourceTypes.DashboardResource, file, fmt.Errorf("unable to read file: %w", err))
 			continue
 		}
 		board := make(map[string]any)
 		if err = json.Unmarshal(rawBoard, &board); err != nil {
-			slog.Warn("Failed to unmarshall file", "filename", file)
+			s.recordFailure(resourceTypes.DashboardResource, file, fmt.Errorf("failed to unmarshall file: %w", err))
 			continue
 		}
 		if _, ok := alreadyProcessed[board["uid"]]; ok {
-			slog.Warn(
-				fmt.Sprintf("board with same UID was already processed.  Please check your backup folder. This may occur if you pulled the data multiple times with configuration of: nested folder enabled and disabled, uid: %v, title: %v", board["uid"], slug.Make((board["title"]).(string))),
-				slog.String("file", file))
+			s.recordSkipped(resourceTypes.DashboardResource, file,
+				fmt.Sprintf("board with same UID was already processed.  Please check your backup folder. This may occur if you pulled the data multiple times with configuration of: nested folder enabled and disabled, uid: %v, title: %v", board["uid"], board["title"]))
 			continue
 		} else {
 			alreadyProcessed[board["uid"]] = true
 		}
 
 		// Extract Folder Name based on dashboardPath
-		folderName, err = getFolderFromResourcePath(s.grafanaConf, file, resourceTypes.DashboardResource, s.storage.GetPrefix(), s.grafanaConf.GetOrganizationName())
+		folderName, err = getFolderFromResourcePath(s.grafanaConf, file, resourceTypes.DashboardResource, s.storage.GetPrefix(), orgName)
 		if err != nil {
 			slog.Warn("unable to determine dashboard folder name, falling back on default")
 		}
@@ -527,9 +568,20 @@ func (s *DashNGoImpl) UploadDashboards(filterReq filters.V2Filter) ([]string, er
 		if folderName == "" {
 			folderName = DefaultFolderName
 		}
+		if promotion != nil {
+			folderName = promotion.folder(folderName)
+			promotion.promoteDashboard(board)
+			if connections != nil {
+				connections.remapValue(board)
+			}
+			if rawBoard, err = json.Marshal(board); err != nil {
+				s.recordFailure(resourceTypes.DashboardResource, file, fmt.Errorf("failed to serialize promoted dashboard: %w", err))
+				continue
+			}
+		}
 		folderUidMap, err = s.validateDashUploadEntity(filterReq, folderName, &folderUid, folderUidMap, rawBoard)
 		if err != nil {
-			slog.Warn("validation failed, skipping", "file", file, "err", err)
+			s.recordError(resourceTypes.DashboardResource, file, err)
 			continue
 		}
 
@@ -542,9 +594,10 @@ func (s *DashNGoImpl) UploadDashboards(filterReq filters.V2Filter) ([]string, er
 		}
 
 		if _, exportError := s.GetClient().Dashboards.ImportDashboard(importDashReq); exportError != nil {
-			slog.Info("error on Exporting dashboard", "dashboard-filename", file, "err", exportError)
+			s.recordFailure(resourceTypes.DashboardResource, file, fmt.Errorf("error on exporting dashboard: %w", exportError))
 			continue
 		} else {
+			s.recordSuccess(resourceTypes.DashboardResource, file)
 			dashFiles = append(dashFiles, file)
 		}
 
@@ -555,7 +608,7 @@ func (s *DashNGoImpl) UploadDashboards(filterReq filters.V2Filter) ([]string, er
 			slog.Info("Deleting Dashboard not found in backup", "folder", item.FolderTitle, "dashboard", item.Title)
 			err := s.deleteDashboard(item.Hit)
 			if err != nil {
-				slog.Error("Unable to delete dashboard", "folder", item.FolderTitle, "dashboard", item.Title)
+				s.recordFailure(resourceTypes.DashboardResource, item.Title, fmt.Errorf("unable to delete dashboard not found in backup: %w", err))
 			}
 		}
 	}
@@ -565,7 +618,7 @@ func (s *DashNGoImpl) UploadDashboards(filterReq filters.V2Filter) ([]string, er
 func (s *DashNGoImpl) baseFolderValidation(filterReq filters.V2Filter, folderName string, folderUid *string, folderUidMap map[string]string, rawBoard []byte) (map[string]string, error) {
 	// if filter is set or ignore set is not set, apply folder filter, otherwise fall through
 	if (s.grafanaConf.IsFilterSet() || !s.grafanaConf.GetDashboardSettings().IgnoreFilters) && !filterReq.Validate(filters.FolderFilter, map[string]any{NestedDashFolderName: folderName}) {
-		return folderUidMap, errors.New("dashboard fails to pass folder filter")
+		return folderUidMap, skipReason("dashboard fails to pass folder filter")
 	}
 
 	if folderName == DefaultFolderName {
@@ -576,7 +629,7 @@ func (s *DashNGoImpl) baseFolderValidation(filterReq filters.V2Filter, folderNam
 		} else {
 			newFolders, folderErr := s.createdFolders(folderName)
 			if folderErr != nil {
-				log.Panic("Unable to create required folder")
+				return folderUidMap, fmt.Errorf("unable to create required folder %s: %w", folderName, folderErr)
 			} else {
 				maps.Copy(folderUidMap, newFolders)
 				*folderUid = folderUidMap[folderName]
@@ -588,12 +641,12 @@ func (s *DashNGoImpl) baseFolderValidation(filterReq filters.V2Filter, folderNam
 
 func (s *DashNGoImpl) validateDashUploadEntity(filterReq filters.V2Filter, folderName string, folderUid *string, folderUidMap map[string]string, rawBoard []byte) (map[string]string, error) {
 	if !filterReq.Validate(filters.TagsFilter, rawBoard) {
-		return folderUidMap, fmt.Errorf("dashboard fails to pass tag filter: tagFilter: %s", filterReq.GetExpectedString(filters.TagsFilter))
+		return folderUidMap, skipReason("dashboard fails to pass tag filter: tagFilter: %s", filterReq.GetExpectedString(filters.TagsFilter))
 	}
 
 	// always apply filter, ignore filter only applies to folders
 	if !filterReq.Validate(filters.DashFilter, rawBoard) {
-		return folderUidMap, errors.New("dashboard fails to pass dash filter")
+		return folderUidMap, skipReason("dashboard fails to pass dash filter")
 	}
 
 	return s.baseFolderValidation(filterReq, folderName, folderUid, folderUidMap, rawBoard)
@@ -614,19 +667,23 @@ func (s *DashNGoImpl) deleteDashboard(item *models.Hit) error {
 
 // DeleteAllDashboards clears all current dashboards being monitored.  Any folder not whitelisted
 // will not be affected
-func (s *DashNGoImpl) DeleteAllDashboards(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DeleteAllDashboards(filter filters.V2Filter) ([]string, error) {
 	dashboardListing := make([]string, 0)
 
-	items := s.ListDashboards(filter)
+	items, err := s.ListDashboards(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, item := range items {
 		// if filter.Validate(filters.FolderFilter, item) && filter.Validate(filters.DashFilter, item) {
-		err := s.deleteDashboard(item.Hit)
+		err = s.deleteDashboard(item.Hit)
 		if err == nil {
+			s.recordSuccess(resourceTypes.DashboardResource, item.Title)
 			dashboardListing = append(dashboardListing, item.Title)
 		} else {
-			slog.Warn("Unable to remove dashboard", slog.String("title", item.Title), slog.String("uid", item.UID))
+			s.recordFailure(resourceTypes.DashboardResource, item.Title, fmt.Errorf("unable to remove dashboard %s: %w", item.UID, err))
 		}
 		//}
 	}
-	return dashboardListing
+	return dashboardListing, nil
 }
@@ -0,0 +1,14 @@
+package service
+
+import "errors"
+
+var (
+	// ErrEnterpriseRequired is returned by operations only supported by Grafana Enterprise
+	ErrEnterpriseRequired = errors.New("requires Grafana Enterprise, please check your GDG configuration and try again")
+	// ErrAdminRequired is returned by operations requiring a Grafana admin authenticated using basic auth
+	ErrAdminRequired = errors.New("requires a Grafana admin using basic auth")
+	// ErrBasicAuthRequired is returned by operations that can't be performed using a token
+	ErrBasicAuthRequired = errors.New("requires basic auth to be configured, token based access is not supported")
+	// ErrNotFound is returned when a requested entity does not exist
+	ErrNotFound = errors.New("not found")
+)
@@ -7,7 +7,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/service/filters"
 	"github.com/gosimple/slug"
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"os"
 	"path"
@@ -16,11 +15,12 @@ import (
 
 	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/service/filters/v2"
 
 	"github.com/esnet/gdg/internal/tools/encode"
+	"github.com/esnet/gdg/internal/tools/ptr"
 
 	configDomain "github.com/esnet/gdg/internal/config/domain"
 	"github.com/esnet/gdg/internal/service/filters"
@@ -36,7 +36,7 @@ const (
 	folderPathSeparator = string(os.PathSeparator)
 )
 
-func NewFolderFilter(cfg *configDomain.GDGAppConfiguration) filters.V2Filter {
+func NewFolderFilter(cfg *configDomain.GDGAppConfiguration) (filters.V2Filter, error) {
 	filterObj := v2.NewBaseFilter()
 	err := filterObj.RegisterReader(reflect.TypeOf(&domain.NestedHit{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(*domain.NestedHit)
@@ -51,7 +51,7 @@ func NewFolderFilter(cfg *configDomain.GDGAppConfiguration) filters.V2Filter {
 		}
 	})
 	if err != nil {
-		log.Fatalf("unable to register a valid reader for folder filter")
+		return nil, fmt.Errorf("unable to register a valid reader for folder filter: %w", err)
 	}
 
 	folderArr := cfg.GetDefaultGrafanaConfig().GetMonitoredFolders(false)
@@ -72,117 +72,153 @@ func NewFolderFilter(cfg *configDomain.GDGAppConfiguration) filters.V2Filter {
 
 		return fmt.Errorf("invalid folder filter. Expected: %v", expressions)
 	}, folderArr)
-	return filterObj
+	return filterObj, nil
 }
 
 // DownloadFolderPermissions downloads all the current folder permissions based on filter.
-func (s *DashNGoImpl) DownloadFolderPermissions(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DownloadFolderPermissions(filter filters.V2Filter) ([]string, error) {
 	slog.Info("Downloading folder permissions")
 	var (
 		dsPacked  []byte
-		err       error
 		dataFiles []string
 	)
-	currentPermissions := s.ListFolderPermissions(filter)
+	currentPermissions, err := s.ListFolderPermissions(filter)
+	if err != nil {
+		return nil, err
+	}
 	for folder, permission := range currentPermissions {
 		if dsPacked, err = json.MarshalIndent(permission, "", "	"); err != nil {
-			slog.Error("Unable to marshall file", "err", err, "folderName", folder.Title)
+			s.recordFailure(resourceTypes.FolderPermissionResource, folder.Title, fmt.Errorf("unable to marshall file: %w", err))
 			continue
 		}
 		fileName := folder.NestedPath
 		if fileName == "" {
 			fileName = folder.Title
 		}
-		dsPath := buildResourcePath(s.grafanaConf, slug.Make(fileName), resourceTypes.FolderPermissionResource, s.isLocal(), s.GetGlobals().ClearOutput)
-		if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
-			slog.Error("Unable to write file", "err", err.Error(), "filename", slug.Make(folder.Title))
+		dsPath := buildResourcePath(s.grafanaConf, slug.Make(fileName), resourceTypes.FolderPermissionResource, s.isLocal(), s.clearedFolders())
+		if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
+			s.recordFailure(resourceTypes.FolderPermissionResource, folder.Title, fmt.Errorf("unable to write file: %w", err))
 		} else {
+			s.recordSuccess(resourceTypes.FolderPermissionResource, folder.Title)
 			dataFiles = append(dataFiles, dsPath)
 		}
 	}
-	return dataFiles
+	return dataFiles, nil
 }
 
 // UploadFolderPermissions update current folder permissions to match local file system.
-// Note: This expects all the current users and teams to already exist.
-func (s *DashNGoImpl) UploadFolderPermissions(filter filters.V2Filter) []string {
+// Users and teams are matched by login/email and team name, entries that cannot be resolved are skipped and the folder is
+// recorded as failed.
+func (s *DashNGoImpl) UploadFolderPermissions(filter filters.V2Filter) ([]string, error) {
 	var (
 		rawFolder []byte
 		dataFiles []string
 	)
 	orgName := s.grafanaConf.GetOrganizationName()
-	filesInDir, err := s.storage.FindAllFiles(s.grafanaConf.GetPath(resourceTypes.FolderPermissionResource, orgName), false)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), s.grafanaConf.GetPath(resourceTypes.FolderPermissionResource, orgName), false)
 	if err != nil {
-		log.Fatalf("Failed to read folders permission imports, %v", err)
+		return nil, fmt.Errorf("failed to read folders permission imports: %w", err)
 	}
+	resolver := s.newPrincipalResolver()
+	useResourcePermissions := s.supportsResourcePermissions()
 	for _, file := range filesInDir {
 		fileLocation := filepath.Join(s.grafanaConf.GetPath(resourceTypes.FolderPermissionResource, orgName), file)
 		if strings.HasSuffix(file, ".json") {
-			if rawFolder, err = s.storage.ReadFile(fileLocation); err != nil {
-				slog.Error("failed to read file", "filename", fileLocation, "err", err)
+			if rawFolder, err = s.readBackupFile(fileLocation); err != nil {
+				s.recordFailure(resourceTypes.FolderPermissionResource, fileLocation, fmt.Errorf("failed to read file: %w", err))
 				continue
 			}
 		}
 		uid := gjson.GetBytes(rawFolder, "0.uid")
 
-		newEntries := make([]*models.DashboardACLUpdateItem, 0)
+		newEntries := make([]*models.DashboardACLInfoDTO, 0)
 		err = json.Unmarshal(rawFolder, &newEntries)
 		if err != nil {
-			slog.Warn("Failed to Decode payload file", "filename", fileLocation)
+			s.recordFailure(resourceTypes.FolderPermissionResource, fileLocation, fmt.Errorf("failed to decode payload file: %w", err))
 			continue
 		}
 		payload := &models.UpdateDashboardACLCommand{
-			Items: newEntries,
+			Items: make([]*models.DashboardACLUpdateItem, 0, len(newEntries)),
 		}
+		for _, entry := range newEntries {
+			// inherited permissions are managed on the parent folder
+			if entry.Inherited {
+				continue
+			}
+			if item, ok := resolver.resolveACL(entry); ok {
+				payload.Items = append(payload.Items, item)
+			}
+		}
+		unresolvedErr := resolver.unresolvedError(fileLocation)
 
-		_, err := s.GetClient().Folders.UpdateFolderPermissions(uid.String(), payload)
+		if useResourcePermissions {
+			err = s.setResourcePermissionsFromACL(folderResourceType, uid.String(), payload.Items)
+		} else {
+			_, err = s.GetClient().Folders.UpdateFolderPermissions(uid.String(), payload)
+		}
 		if err != nil {
-			slog.Error("Failed to update folder permissions")
+			s.recordFailure(resourceTypes.FolderPermissionResource, fileLocation, fmt.Errorf("failed to update folder permissions: %w", err))
+		} else if unresolvedErr != nil {
+			s.recordFailure(resourceTypes.FolderPermissionResource, fileLocation, unresolvedErr)
 		} else {
+			s.recordSuccess(resourceTypes.FolderPermissionResource, fileLocation)
 			dataFiles = append(dataFiles, fileLocation)
 		}
 	}
 	slog.Info("Patching server with local folder permissions")
-	return dataFiles
+	return dataFiles, nil
 }
 
 // ListFolderPermissions retrieves all current folder permissions
 // TODO: add concurrency to folder permissions calls
-func (s *DashNGoImpl) ListFolderPermissions(filter filters.V2Filter) map[*domain.NestedHit][]*models.DashboardACLInfoDTO {
+func (s *DashNGoImpl) ListFolderPermissions(filter filters.V2Filter) (map[*domain.NestedHit][]*models.DashboardACLInfoDTO, error) {
 	// get list of folders
-	var foldersList []*domain.NestedHit
-	if filter == nil {
-		foldersList = s.ListFolders(nil)
-	} else {
-		foldersList = s.ListFolders(NewFolderFilter(s.gdgConfig))
+	if filter != nil {
+		folderFilter, err := NewFolderFilter(s.gdgConfig)
+		if err != nil {
+			return nil, err
+		}
+		filter = folderFilter
+	}
+	foldersList, err := s.ListFolders(filter)
+	if err != nil {
+		return nil, err
 	}
 
 	r := make(map[*domain.NestedHit][]*models.DashboardACLInfoDTO)
 
+	if s.supportsResourcePermissions() {
+		for ndx, foldersEntry := range foldersList {
+			results, err := s.getResourcePermissionsAsACL(folderResourceType, foldersEntry.UID)
+			if err != nil {
+				s.recordFailure(resourceTypes.FolderPermissionResource, foldersEntry.Title, fmt.Errorf("unable to get folder permissions for folderUID %s: %w", foldersEntry.UID, err))
+				continue
+			}
+			r[foldersList[ndx]] = results
+		}
+		return r, nil
+	}
+
 	for ndx, foldersEntry := range foldersList {
 		results, err := s.GetClient().Folders.GetFolderPermissionList(foldersEntry.UID)
 		if err != nil {
 			msg := fmt.Sprintf("Unable to get folder permissions for folderUID: %s", foldersEntry.UID)
 
-			var getFolderPermissionListInternalServerError *folders.GetFolderPermissionListInternalServerError
-			switch {
-			case errors.As(err, &getFolderPermissionListInternalServerError):
-				var castError *folders.GetFolderPermissionListInternalServerError
-				errors.As(err, &castError)
-				slog.Error(msg, "message", *castError.GetPayload().Message, "err", err)
-			default:
-				slog.Error(msg, "err", err)
+			var castError *folders.GetFolderPermissionListInternalServerError
+			if errors.As(err, &castError) {
+				msg = fmt.Sprintf("%s, message: %s", msg, ptr.ValueOrDefault(castError.GetPayload().Message, ""))
 			}
+			s.recordFailure(resourceTypes.FolderPermissionResource, foldersEntry.Title, fmt.Errorf("%s: %w", msg, err))
 		} else {
 			r[foldersList[ndx]] = results.GetPayload()
 		}
 	}
 
-	return r
+	return r, nil
 }
 
 // ListFolders list the current existing folders that match the given filter.
-func (s *DashNGoImpl) ListFolders(filter filters.V2Filter) []*domain.NestedHit {
+func (s *DashNGoImpl) ListFolders(filter filters.V2Filter) ([]*domain.NestedHit, error) {
 	result := make([]*domain.NestedHit, 0)
 	if s.grafanaConf.GetDashboardSettings().IgnoreFilters {
 		filter = nil
@@ -192,7 +228,7 @@ func (s *DashNGoImpl) ListFolders(filter filters.V2Filter) []*domain.NestedHit {
 	p.Type = &SearchTypeFolder
 	folderRawListing, err := s.GetClient().Search.Search(p)
 	if err != nil {
-		log.Fatal("unable to retrieve folder list.")
+		return nil, fmt.Errorf("unable to retrieve folder list: %w", err)
 	}
 
 	folderListing := make([]*domain.NestedHit, 0)
@@ -216,31 +252,34 @@ func (s *DashNGoImpl) ListFolders(filter filters.V2Filter) []*domain.NestedHit {
 		}
 	}
 
-	return result
+	return result, nil
 }
 
 // DownloadFolders Download all the given folders matching filter
-func (s *DashNGoImpl) DownloadFolders(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DownloadFolders(filter filters.V2Filter) ([]string, error) {
 	var (
 		dsPacked  []byte
-		err       error
 		dataFiles []string
 	)
-	folderListing := s.ListFolders(filter)
+	folderListing, err := s.ListFolders(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, folder := range folderListing {
 		if dsPacked, err = json.MarshalIndent(folder, "", "	"); err != nil {
-			slog.Error("Unable to serialize data to JSON", "err", err, "folderName", folder.Title)
+			s.recordFailure(resourceTypes.FolderResource, folder.NestedPath, fmt.Errorf("unable to serialize data to JSON: %w", err))
 			continue
 		}
-		dsPath := buildResourcePath(s.grafanaConf, folder.NestedPath, resourceTypes.FolderResource, s.isLocal(), s.GetGlobals().ClearOutput)
-		if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
-			slog.Error("Unable to write file.", "err", err.Error(), "folderName", slug.Make(folder.Title))
+		dsPath := buildResourcePath(s.grafanaConf, folder.NestedPath, resourceTypes.FolderResource, s.isLocal(), s.clearedFolders())
+		if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
+			s.recordFailure(resourceTypes.FolderResource, folder.NestedPath, fmt.Errorf("unable to write file: %w", err))
 		} else {
+			s.recordSuccess(resourceTypes.FolderResource, folder.NestedPath)
 			dataFiles = append(dataFiles, dsPath)
 		}
 	}
 
-	return dataFiles
+	return dataFiles, nil
 }
 
 // getPathFolderList constructs
@@ -266,7 +305,7 @@ func getNestedFolderFromFile(file string, resourceDir string) string {
 
 // UploadFolders upload all the given folders to grafana
 // TODO: handle setting parent
-func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) ([]string, error) {
 	var (
 		result    []string
 		rawFolder []byte
@@ -295,11 +334,14 @@ func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) []string {
 	}
 
 	resourceDir := s.grafanaConf.GetPath(resourceTypes.FolderResource, s.grafanaConf.GetOrganizationName())
-	filesInDir, err := s.storage.FindAllFiles(resourceDir, true)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), resourceDir, true)
 	if err != nil {
-		log.Fatalf("Failed to read folders imports, %v", err)
+		return nil, fmt.Errorf("failed to read folders imports: %w", err)
+	}
+	folderItems, err := s.ListFolders(filter)
+	if err != nil {
+		return nil, err
 	}
-	folderItems := s.ListFolders(filter)
 	folderUidMap := getFolderUIDEntityMapByList(folderItems)
 	// build a mapping of the nested path to UID for all existing folders
 	nestedPathToUidExisting := getFolderMapping(folderItems,
@@ -320,8 +362,8 @@ func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) []string {
 		}
 		slog.Debug("processing file", slog.Any("file", fileLocation))
 		if strings.HasSuffix(fileLocation, ".json") {
-			if rawFolder, err = s.storage.ReadFile(fileLocation); err != nil {
-				slog.Error("failed to read file", "filename", fileLocation, "err", err)
+			if rawFolder, err = s.readBackupFile(fileLocation); err != nil {
+				s.recordFailure(resourceTypes.FolderResource, fileLocation, fmt.Errorf("failed to read file: %w", err))
 				continue
 			}
 		}
@@ -350,7 +392,7 @@ func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) []string {
 				if parentFile, parentOk := nestedPathMap[sb.String()]; parentOk {
 					getNewFolder := func() (*models.CreateFolderCommand, error) {
 						if strings.HasSuffix(parentFile, ".json") {
-							if rawFolder, err = s.storage.ReadFile(parentFile); err != nil {
+							if rawFolder, err = s.readBackupFile(parentFile); err != nil {
 								slog.Error("failed to read fileOrName", "filename", parentFile, "err", err)
 							}
 						}
@@ -410,16 +452,16 @@ func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) []string {
 
 		}
 		if errorOut {
-			slog.Error("unable to add folder", slog.Any("folder", nestedFolder))
+			s.recordFailure(resourceTypes.FolderResource, nestedFolder, errors.New("unable to create the parent folders"))
 			continue
 		}
 		var newFolder models.CreateFolderCommand
-		if rawFolder, err = s.storage.ReadFile(fileLocation); err != nil {
-			slog.Error("failed to read file", "filename", fileLocation, "err", err)
+		if rawFolder, err = s.readBackupFile(fileLocation); err != nil {
+			s.recordFailure(resourceTypes.FolderResource, nestedFolder, fmt.Errorf("failed to read file: %w", err))
 			continue
 		}
 		if err = json.Unmarshal(rawFolder, &newFolder); err != nil {
-			slog.Warn("failed to unmarshall folder", "err", err)
+			s.recordFailure(resourceTypes.FolderResource, nestedFolder, fmt.Errorf("failed to unmarshall folder: %w", err))
 			continue
 		}
 
@@ -434,7 +476,7 @@ func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) []string {
 		}
 
 		if skipCreate {
-			slog.Warn("folder already exists, skipping creation", slog.Any("folder", fileLocation))
+			s.recordSkipped(resourceTypes.FolderResource, nestedFolder, "folder already exists")
 			continue
 		}
 		params := folders.NewCreateFolderParams()
@@ -444,18 +486,19 @@ func (s *DashNGoImpl) UploadFolders(filter filters.V2Filter) []string {
 		}
 		params.Body = &newFolder
 		f, createErr := s.GetClient().Folders.CreateFolder(&newFolder)
-		if err != nil {
-			slog.Error("failed to create folder.", "folderName", newFolder.Title, "err", createErr)
+		if createErr != nil {
+			s.recordFailure(resourceTypes.FolderResource, nestedFolder, fmt.Errorf("failed to create folder: %w", createErr))
 			continue
 		}
 		processed[fileLocation] = true
 
 		folderUidMap[f.GetPayload().UID] = s.folderToHit(f.GetPayload())
 		nestedPathToUidExisting[nestedFolder] = s.folderToHit(f.GetPayload())
+		s.recordSuccess(resourceTypes.FolderResource, nestedFolder)
 		result = append(result, nestedFolder)
 
 	}
-	return result
+	return result, nil
 }
 
 // buildNestedFilePath returns a dictionary of nestedPaths to a matching file if one exists.
@@ -473,15 +516,11 @@ func (s *DashNGoImpl) buildNestedFilePath(files []string) map[string]string {
 }
 
 // DeleteAllFolders deletes all the matching folders from grafana
-func (s *DashNGoImpl) DeleteAllFolders(filter filters.V2Filter) []string {
-	var (
-		result        []string
-		folderListing []*domain.NestedHit
-	)
-	if filter == nil {
-		folderListing = s.ListFolders(nil)
-	} else {
-		folderListing = s.ListFolders(filter)
+func (s *DashNGoImpl) DeleteAllFolders(filter filters.V2Filter) ([]string, error) {
+	var result []string
+	folderListing, err := s.ListFolders(filter)
+	if err != nil {
+		return nil, err
 	}
 	sort.Slice(folderListing, func(i, j int) bool {
 		return strings.Compare(folderListing[i].NestedPath, folderListing[j].NestedPath) > 0
@@ -490,14 +529,15 @@ func (s *DashNGoImpl) DeleteAllFolders(filter filters.V2Filter) []string {
 		params := folders.NewDeleteFolderParams()
 		params.FolderUID = folder.UID
 
-		_, err := s.GetClient().Folders.DeleteFolder(params)
+		_, err = s.GetClient().Folders.DeleteFolder(params)
 		if err == nil {
+			s.recordSuccess(resourceTypes.FolderResource, folder.NestedPath)
 			result = append(result, folder.NestedPath)
 		} else {
-			slog.Error("failed to delete folder", "err", err, "folder", folder.Title)
+			s.recordFailure(resourceTypes.FolderResource, folder.NestedPath, fmt.Errorf("failed to delete folder: %w", err))
 		}
 	}
-	return result
+	return result, nil
 }
 
 // getFolderMapping returns a mapping of any comparable T to any value based on the folder entity.
@@ -512,8 +552,12 @@ func getFolderMapping[T comparable, V any](folders []*domain.NestedHit, key func
 }
 
 // getFolderUIDEntityMap builds a map from folder UID to NestedHit using ListFolders.
-func (s *DashNGoImpl) getFolderUIDEntityMap(filter filters.V2Filter) map[string]*domain.NestedHit {
-	return getFolderUIDEntityMapByList(s.ListFolders(filter))
+func (s *DashNGoImpl) getFolderUIDEntityMap(filter filters.V2Filter) (map[string]*domain.NestedHit, error) {
+	folderList, err := s.ListFolders(filter)
+	if err != nil {
+		return nil, err
+	}
+	return getFolderUIDEntityMapByList(folderList), nil
 }
 
 // getFolderUIDEntityMapByList helper function to build a mapping for name to folderID
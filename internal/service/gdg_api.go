@@ -2,29 +2,93 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
-	"log/slog"
-	"os"
+	"net/http"
+	"sync"
 
 	"github.com/esnet/gdg/internal/config/domain"
 	"github.com/esnet/gdg/pkg/plugins/secure"
 	"github.com/esnet/gdg/pkg/plugins/secure/contract"
+	storagePlugin "github.com/esnet/gdg/pkg/plugins/storage"
 	"github.com/esnet/gdg/pkg/test_tooling/common"
 
 	"github.com/esnet/gdg/internal/api"
 	"github.com/esnet/gdg/internal/config"
 	"github.com/esnet/gdg/internal/storage"
+	"github.com/esnet/gdg/internal/tools"
 )
 
-var instance *DashNGoImpl
-
 type DashNGoImpl struct {
 	extended    *api.ExtendedApi
 	gdgConfig   *domain.GDGAppConfiguration
 	grafanaConf *domain.GrafanaConfig
 	storage     storage.Storage
 	encoder     contract.CipherEncoder
+	// ctx bounds every request made to Grafana and the storage engine, it never changes once the service is created,
+	// see WithContext
+	ctx context.Context
+	// transport shared by every Grafana client so that retries and rate limits apply to all requests
+	transport http.RoundTripper
+	state     *serviceState
+	stateOnce sync.Once
+}
+
+// serviceState is shared by a service and the copies of it bound to other contexts
+type serviceState struct {
+	mu sync.Mutex
+	// results outcome of every entity processed since the last reset
+	results []EntityResult
+	// checksums of the backup files written by the current command, and of the manifest files read are verified against
+	written  map[string]string
+	verified map[string]string
+	// cleared folders removed by clear_output, each one is only removed once
+	cleared tools.ClearedFolders
+}
+
+// shared returns the state shared with the copies of the service, services that weren't created by a constructor
+// get their own on first use
+func (s *DashNGoImpl) shared() *serviceState {
+	s.stateOnce.Do(func() {
+		if s.state == nil {
+			s.state = new(serviceState)
+		}
+	})
+	return s.state
+}
+
+// clearedFolders returns the folders cleared by the service when clear_output is enabled, nil otherwise
+func (s *DashNGoImpl) clearedFolders() *tools.ClearedFolders {
+	if !s.GetGlobals().ClearOutput {
+		return nil
+	}
+	return &s.shared().cleared
+}
+
+// WithContext returns a copy of the service whose requests, to Grafana and the storage engine, are bound to ctx.  The
+// copy shares the configuration, storage engine, transport and recorded results of the service, which is left as is.
+func (s *DashNGoImpl) WithContext(ctx context.Context) *DashNGoImpl {
+	bound := &DashNGoImpl{
+		gdgConfig:   s.gdgConfig,
+		grafanaConf: s.grafanaConf,
+		storage:     s.storage,
+		encoder:     s.encoder,
+		ctx:         ctx,
+		transport:   s.transport,
+		state:       s.shared(),
+	}
+	if s.extended != nil {
+		bound.extended = api.NewExtendedApi(ctx, bound.gdgConfig, bound.httpTransport())
+	}
+	return bound
+}
+
+// requestContext returns the context requests are bound to
+func (s *DashNGoImpl) requestContext() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
 }
 
 func (s *DashNGoImpl) GetGlobals() *domain.AppGlobals {
@@ -43,50 +107,114 @@ func setupConfigData(cfg *domain.GDGAppConfiguration, obj *DashNGoImpl) {
 	obj.gdgConfig = cfg
 }
 
-func newInstance(cfg *domain.GDGAppConfiguration) *DashNGoImpl {
-	obj := &DashNGoImpl{
-		gdgConfig: cfg,
+func newInstance(ctx context.Context, cfg *domain.GDGAppConfiguration) (*DashNGoImpl, error) {
+	obj, err := newGrafanaClient(ctx, cfg)
+	if err != nil {
+		return nil, err
 	}
-	setupConfigData(cfg, obj)
-	if !cfg.PluginConfig.Disabled && cfg.PluginConfig.CipherPlugin != nil {
-		obj.encoder = secure.NewPluginCipherEncoder(cfg.PluginConfig.CipherPlugin, cfg.SecureConfig)
-	} else {
-		obj.encoder = secure.NoOpEncoder{}
+	storageEngine, err := ConfigureStorage(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure a valid storage engine: %w", err)
 	}
+	obj.SetStorage(storageEngine)
 
-	if obj.GetGlobals().ApiDebug {
-		err := os.Setenv("DEBUG", "1")
-		if err != nil {
-			slog.Debug("unable to set debug env value", slog.Any("err", err))
-		}
-	} else {
-		err := os.Setenv("DEBUG", "0")
-		if err != nil {
-			slog.Debug("unable to set debug env value", slog.Any("err", err))
-		}
+	return obj, nil
+}
+
+// NewStorageService returns a service managing the backups of the current context without contacting Grafana, only
+// the StorageApi methods can be used.  Every storage operation is bound to ctx.
+func NewStorageService(ctx context.Context, cfg *domain.GDGAppConfiguration) (*DashNGoImpl, error) {
+	if _, err := cfg.GetGrafanaConfig(); err != nil {
+		return nil, err
+	}
+	obj := &DashNGoImpl{ctx: ctx}
+	setupConfigData(cfg, obj)
+	var err error
+	if obj.encoder, err = newEncoder(cfg); err != nil {
+		return nil, err
 	}
-	obj.Login()
 	storageEngine, err := ConfigureStorage(cfg)
 	if err != nil {
-		log.Fatal("Unable to configure a valid storage engine, %w", err)
+		return nil, fmt.Errorf("unable to configure a valid storage engine: %w", err)
 	}
 	obj.SetStorage(storageEngine)
+	return obj, nil
+}
+
+// newGrafanaClient returns a service logged into the Grafana instance of the current context, without any storage
+// engine configured.  Its requests are bound to the given context.
+func newGrafanaClient(ctx context.Context, cfg *domain.GDGAppConfiguration) (*DashNGoImpl, error) {
+	obj := &DashNGoImpl{
+		gdgConfig: cfg,
+		ctx:       ctx,
+	}
+	if _, err := cfg.GetGrafanaConfig(); err != nil {
+		return nil, err
+	}
+	setupConfigData(cfg, obj)
+	var err error
+	if obj.encoder, err = newEncoder(cfg); err != nil {
+		return nil, err
+	}
 
-	return obj
+	// api_debug is applied by every client through TransportConfig.Debug
+	if err := obj.Login(); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
 }
 
 func (s *DashNGoImpl) SetStorage(v storage.Storage) {
 	s.storage = v
 }
 
+// CommitStorage commits the changes made by a command when the storage engine records them as a single unit.  The
+// commit isn't bound to the request context so that the changes of a cancelled command are still recorded.
+func (s *DashNGoImpl) CommitStorage(subject string) error {
+	if committer, ok := s.storage.(storage.Committer); ok {
+		return committer.Commit(context.Background(), subject)
+	}
+	return nil
+}
+
 func ConfigureStorage(cfg *domain.GDGAppConfiguration) (storage.Storage, error) {
+	grafanaConf, err := cfg.GetGrafanaConfig()
+	if err != nil {
+		return nil, err
+	}
+	return configureStorageEngine(cfg, grafanaConf.Storage, true)
+}
+
+// newEncoder returns the cipher plugin encoder when one is configured, secure values are left as is otherwise
+func newEncoder(cfg *domain.GDGAppConfiguration) (contract.CipherEncoder, error) {
+	if cfg.PluginConfig.Disabled || cfg.PluginConfig.CipherPlugin == nil {
+		return secure.NoOpEncoder{}, nil
+	}
+	return secure.NewPluginCipherEncoder(cfg.PluginConfig.CipherPlugin, cfg.SecureConfig)
+}
+
+// configureStorageEngine creates the storage engine with the given label, archives may only be nested once as they
+// need a backend to store the archive in.
+func configureStorageEngine(cfg *domain.GDGAppConfiguration, label string, allowArchive bool) (storage.Storage, error) {
 	var (
 		storageEngine storage.Storage
 		err           error
 	)
 
 	// config
-	storageType, appData := cfg.GetCloudConfiguration(cfg.GetDefaultGrafanaConfig().Storage)
+	storageType, appData := cfg.GetCloudConfiguration(label)
+	switch storageType {
+	case storage.GitCloudType:
+		if appData[storage.GitPath] == "" {
+			appData[storage.GitPath] = cfg.GetDefaultGrafanaConfig().OutputPath
+		}
+	case storage.ArchiveCloudType:
+		if appData[storage.ArchivePath] == "" && appData[storage.ArchiveBackend] == "" {
+			appData[storage.ArchivePath] = cfg.GetDefaultGrafanaConfig().OutputPath
+		}
+		appData[storage.ArchiveContextName] = cfg.GetContext()
+	}
 
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, storage.Context, appData)
@@ -94,33 +222,90 @@ func ConfigureStorage(cfg *domain.GDGAppConfiguration) (storage.Storage, error)
 	case "cloud":
 		{
 			var encoder contract.CipherEncoder
-			if !cfg.PluginConfig.Disabled || cfg.PluginConfig.CipherPlugin != nil {
-				encoder = secure.NewPluginCipherEncoder(cfg.PluginConfig.CipherPlugin, cfg.SecureConfig)
+			if encoder, err = newEncoder(cfg); err != nil {
+				return nil, err
 			}
 			storageEngine, err = storage.NewCloudStorage(ctx, encoder)
 			if err != nil {
 				return nil, fmt.Errorf("unable to configure CloudStorage Engine:	%w", err)
 			}
 		}
+	case storage.GitCloudType:
+		storageEngine, err = storage.NewGitStorage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to configure GitStorage Engine: %w", err)
+		}
+	case storage.PluginCloudType:
+		if cfg.PluginConfig.Disabled {
+			return nil, errors.New("storage plugin can't be used while plugins are disabled")
+		}
+		storageEngine, err = storagePlugin.NewPluginStorage(ctx, cfg.PluginConfig.StoragePlugin)
+		if err != nil {
+			return nil, fmt.Errorf("unable to configure PluginStorage Engine: %w", err)
+		}
+	case storage.ArchiveCloudType:
+		if !allowArchive {
+			return nil, errors.New("archive storage can't be used as the backend of another archive storage")
+		}
+		var backend storage.Storage
+		if backendLabel := appData[storage.ArchiveBackend]; backendLabel != "" {
+			if backend, err = configureStorageEngine(cfg, backendLabel, false); err != nil {
+				return nil, fmt.Errorf("unable to configure archive backend '%s': %w", backendLabel, err)
+			}
+		}
+		storageEngine, err = storage.NewArchiveStorage(ctx, backend)
+		if err != nil {
+			return nil, fmt.Errorf("unable to configure ArchiveStorage Engine: %w", err)
+		}
 	default:
 		storageEngine = storage.NewLocalStorage(ctx)
 	}
+	if storage.IsEnabled(appData[storage.Snapshots]) {
+		if storageType == storage.ArchiveCloudType {
+			return nil, errors.New("snapshots can't be used with archive storage, every archive is already a snapshot")
+		}
+		appData[storage.SnapshotRoot] = cfg.GetDefaultGrafanaConfig().OutputPath
+		if storageEngine, err = storage.NewSnapshotStorage(ctx, storageEngine); err != nil {
+			return nil, fmt.Errorf("unable to configure snapshots: %w", err)
+		}
+	}
+	storageEngine, err = storage.NewEncryptedStorage(storageEngine, appData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure storage encryption: %w", err)
+	}
 	return storageEngine, nil
 }
 
-func NewTestApiService(storageEngine storage.Storage, cfg *domain.GDGAppConfiguration) GrafanaService {
+func NewTestApiService(storageEngine storage.Storage, cfg *domain.GDGAppConfiguration) (GrafanaService, error) {
 	if cfg == nil {
 		cfg = config.InitGdgConfig(common.DefaultTestConfig)
 	}
-	ins := newInstance(cfg)
+	ins, err := newGrafanaClient(context.Background(), cfg)
+	if err != nil {
+		return nil, err
+	}
 	ins.SetStorage(storageEngine)
 	setupConfigData(cfg, ins)
-	return ins
+	return ins, nil
 }
 
-func NewDashNGo(cfg *domain.GDGAppConfiguration) *DashNGoImpl {
-	if instance == nil {
-		instance = newInstance(cfg)
+// NewGrafanaService returns a new service for the current context of the configuration, the storage engine is
+// configured from the configuration when none is given.  Requests are bound to ctx, WithContext returns a copy bound
+// to another context.
+func NewGrafanaService(ctx context.Context, cfg *domain.GDGAppConfiguration, storageEngine storage.Storage) (*DashNGoImpl, error) {
+	if storageEngine == nil {
+		return newInstance(ctx, cfg)
 	}
-	return instance
+	obj, err := newGrafanaClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	obj.SetStorage(storageEngine)
+	return obj, nil
+}
+
+// NewDashNGo returns a service logged into the Grafana instance of the current context using the configured storage
+// engine, the login and every request are bound to ctx.
+func NewDashNGo(ctx context.Context, cfg *domain.GDGAppConfiguration) (*DashNGoImpl, error) {
+	return newInstance(ctx, cfg)
 }
@@ -4,14 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"reflect"
 	"strings"
 
 	configDomain "github.com/esnet/gdg/internal/config/domain"
-	"github.com/esnet/gdg/internal/service/domain"
 	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/service/filters/v2"
 
@@ -30,7 +29,7 @@ const (
 	listLibraryVars   int64 = 2
 )
 
-func setupLibElementsReaders(filterObj filters.V2Filter) {
+func setupLibElementsReaders(filterObj filters.V2Filter) error {
 	obj := domain.WithNested[models.LibraryElementDTO]{}
 	err := filterObj.RegisterReader(reflect.TypeOf(&obj), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(*domain.WithNested[models.LibraryElementDTO])
@@ -46,7 +45,7 @@ func setupLibElementsReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Library Elements Filter, obj entity filter failure, aborting.")
+		return fmt.Errorf("unable to create a valid library elements filter, obj entity filter failure: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.([]byte)
@@ -69,7 +68,7 @@ func setupLibElementsReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Library Elements Filter, json filter failure, aborting.")
+		return fmt.Errorf("unable to create a valid library elements filter, json filter failure: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf(map[string]any{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(map[string]any)
@@ -86,37 +85,41 @@ func setupLibElementsReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Library Elements Filter, map filter failure, aborting.")
+		return fmt.Errorf("unable to create a valid library elements filter, map filter failure: %w", err)
 	}
+	return nil
 }
 
-func NewLibraryElementFilter(cfg *configDomain.GDGAppConfiguration) filters.V2Filter {
+func NewLibraryElementFilter(cfg *configDomain.GDGAppConfiguration) (filters.V2Filter, error) {
 	filterObj := v2.NewBaseFilter()
-	setupLibElementsReaders(filterObj)
+	if err := setupLibElementsReaders(filterObj); err != nil {
+		return nil, err
+	}
 	addFolderFilter(cfg, filterObj, "")
 
-	return filterObj
+	return filterObj, nil
 }
 
-func (s *DashNGoImpl) ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) []*models.DashboardFullWithMeta {
+func (s *DashNGoImpl) ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) ([]*models.DashboardFullWithMeta, error) {
 	payload, err := s.GetClient().LibraryElements.GetLibraryElementConnections(connectionID)
 	if err != nil {
-		log.Fatalf("unable to retrieve a valid connection for %s", connectionID)
+		return nil, fmt.Errorf("unable to retrieve a valid connection for %s: %w", connectionID, err)
 	}
 	var results []*models.DashboardFullWithMeta
 
 	for _, item := range payload.GetPayload().Result {
 		dashboard, err := s.getDashboardByUid(item.ConnectionUID)
 		if err != nil {
-			slog.Error("failed to retrieve linked Dashboard", "uid", item.ConnectionUID)
+			slog.Error("failed to retrieve linked Dashboard", "uid", item.ConnectionUID, "err", err)
+			continue
 		}
 		results = append(results, dashboard)
 	}
 
-	return results
+	return results, nil
 }
 
-func (s *DashNGoImpl) ListLibraryElements(filter filters.V2Filter) []*domain.WithNested[models.LibraryElementDTO] {
+func (s *DashNGoImpl) ListLibraryElements(filter filters.V2Filter) ([]*domain.WithNested[models.LibraryElementDTO], error) {
 	const limit int64 = 100
 	var (
 		page        int64 = 1
@@ -126,7 +129,10 @@ func (s *DashNGoImpl) ListLibraryElements(filter filters.V2Filter) []*domain.Wit
 	if ignoreFilters {
 		filter = nil
 	} else if filter == nil {
-		filter = NewLibraryElementFilter(s.gdgConfig)
+		var err error
+		if filter, err = NewLibraryElementFilter(s.gdgConfig); err != nil {
+			return nil, err
+		}
 	}
 
 	// Fetch all lib elements
@@ -138,7 +144,7 @@ func (s *DashNGoImpl) ListLibraryElements(filter filters.V2Filter) []*domain.Wit
 
 		libraryElements, err := s.GetClient().LibraryElements.GetLibraryElements(params)
 		if err != nil {
-			log.Fatalf("Unable to list Library Elements %v", err)
+			return nil, fmt.Errorf("unable to list library elements: %w", err)
 		}
 		allElements = append(allElements, libraryElements.GetPayload().Result.Elements...)
 		if int64(len(libraryElements.GetPayload().Result.Elements)) < limit {
@@ -155,7 +161,7 @@ func (s *DashNGoImpl) ListLibraryElements(filter filters.V2Filter) []*domain.Wit
 		} else {
 			fld, err := s.getFolderByUid(val.FolderUID)
 			if err != nil {
-				slog.Error("unable to get forder to validate resource")
+				slog.Error("unable to get folder to validate resource", "folderUID", val.FolderUID, "err", err)
 				continue
 			}
 			nestedPath = fld.NestedPath
@@ -169,23 +175,28 @@ func (s *DashNGoImpl) ListLibraryElements(filter filters.V2Filter) []*domain.Wit
 		}
 	}
 
-	return newData
+	return newData, nil
 }
 
 // DownloadLibraryElements downloads all the Library Elements
-func (s *DashNGoImpl) DownloadLibraryElements(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DownloadLibraryElements(filter filters.V2Filter) ([]string, error) {
 	var (
-		listing   []*domain.WithNested[models.LibraryElementDTO]
 		dsPacked  []byte
-		err       error
 		dataFiles []string
 	)
 
-	folderMap := reverseLookUp(s.getFolderNameUIDMap(s.ListFolders(nil)))
-	listing = s.ListLibraryElements(filter)
+	folderList, err := s.ListFolders(nil)
+	if err != nil {
+		return nil, err
+	}
+	folderMap := reverseLookUp(s.getFolderNameUIDMap(folderList))
+	listing, err := s.ListLibraryElements(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, item := range listing {
 		if dsPacked, err = json.MarshalIndent(item, "", "	"); err != nil {
-			slog.Error("Unable to serialize object", "err", err, "library-element", item.Entity.Name)
+			s.recordFailure(resourceTypes.LibraryElementResource, item.Entity.Name, fmt.Errorf("unable to serialize object: %w", err))
 			continue
 		}
 		folderName := DefaultFolderName
@@ -194,19 +205,20 @@ func (s *DashNGoImpl) DownloadLibraryElements(filter filters.V2Filter) []string
 			folderName = val
 		}
 
-		libraryPath := fmt.Sprintf("%s/%s.json", BuildResourceFolder(s.grafanaConf, folderName, resourceTypes.LibraryElementResource, s.isLocal(), s.GetGlobals().ClearOutput), slug.Make(item.Entity.Name))
+		libraryPath := fmt.Sprintf("%s/%s.json", BuildResourceFolder(s.grafanaConf, folderName, resourceTypes.LibraryElementResource, s.isLocal(), s.clearedFolders()), slug.Make(item.Entity.Name))
 
-		if err = s.storage.WriteFile(libraryPath, dsPacked); err != nil {
-			slog.Error("Unable to write file", "err", err, "library-element", slug.Make(item.Entity.Name))
+		if err = s.writeBackupFile(libraryPath, dsPacked); err != nil {
+			s.recordFailure(resourceTypes.LibraryElementResource, item.Entity.Name, fmt.Errorf("unable to write file: %w", err))
 		} else {
+			s.recordSuccess(resourceTypes.LibraryElementResource, item.Entity.Name)
 			dataFiles = append(dataFiles, libraryPath)
 		}
 	}
-	return dataFiles
+	return dataFiles, nil
 }
 
 // UploadLibraryElements uploads all the Library Elements
-func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) []string {
+func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) ([]string, error) {
 	var (
 		exported          = make([]string, 0)
 		rawLibraryElement []byte
@@ -215,15 +227,30 @@ func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) []string
 		folderName        string
 	)
 
-	orgName := s.grafanaConf.GetOrganizationName()
+	promotion, err := s.promotionRules()
+	if err != nil {
+		return nil, err
+	}
+	orgName := promotion.sourceOrganization(s.grafanaConf.GetOrganizationName())
+	connections, err := s.promotionConnections(promotion, orgName)
+	if err != nil {
+		return nil, err
+	}
 	slog.Info("Reading files from folder", "folder", s.grafanaConf.GetPath(resourceTypes.LibraryElementResource, orgName))
-	filesInDir, err := s.storage.FindAllFiles(s.grafanaConf.GetPath(resourceTypes.LibraryElementResource, orgName), true)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), s.grafanaConf.GetPath(resourceTypes.LibraryElementResource, orgName), true)
 	if err != nil {
-		slog.Error("failed to list files in directory for library elements", "err", err)
+		return nil, fmt.Errorf("failed to list files in directory for library elements: %w", err)
 	}
 
-	folderUidMap := s.getFolderNameUIDMap(s.ListFolders(nil))
-	currentLibElements := s.ListLibraryElements(filterReq)
+	folderList, err := s.ListFolders(nil)
+	if err != nil {
+		return nil, err
+	}
+	folderUidMap := s.getFolderNameUIDMap(folderList)
+	currentLibElements, err := s.ListLibraryElements(filterReq)
+	if err != nil {
+		return nil, err
+	}
 	libMapping := make(map[string]*domain.WithNested[models.LibraryElementDTO])
 	// Build a mapping by UID
 	for ndx, item := range currentLibElements {
@@ -237,19 +264,27 @@ func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) []string
 			continue
 		}
 
-		if rawLibraryElement, err = s.storage.ReadFile(file); err != nil {
-			slog.Error("failed to read file", "file", file, "err", err)
+		if rawLibraryElement, err = s.readBackupFile(file); err != nil {
+			s.recordFailure(resourceTypes.LibraryElementResource, file, fmt.Errorf("failed to read file: %w", err))
 			continue
 		}
 
+		if connections != nil {
+			if rawLibraryElement, err = connections.remap(rawLibraryElement); err != nil {
+				s.recordFailure(resourceTypes.LibraryElementResource, file, fmt.Errorf("failed to remap connections: %w", err))
+				continue
+			}
+		}
+
 		// Extract Folder Name based on dashboardPath
-		folderName, err = getFolderFromResourcePath(s.grafanaConf, file, resourceTypes.LibraryElementResource, s.storage.GetPrefix(), s.grafanaConf.GetOrganizationName())
+		folderName, err = getFolderFromResourcePath(s.grafanaConf, file, resourceTypes.LibraryElementResource, s.storage.GetPrefix(), orgName)
 		if err != nil {
 			slog.Warn("unable to determine dashboard folder name, falling back on default")
 			folderName = DefaultFolderName
 		}
+		folderName = promotion.folder(folderName)
 		if !ignoreFilters && !filterReq.Validate(filters.FolderFilter, map[string]any{NestedDashFolderName: folderName}) {
-			slog.Warn("Skipping since requested file is not in a folder gdg is configured to manage", "folder", folderName, "file", file)
+			s.recordSkipped(resourceTypes.LibraryElementResource, file, fmt.Sprintf("folder %s is not managed by gdg", folderName))
 			continue
 		}
 		if folderName != DefaultFolderName {
@@ -272,7 +307,7 @@ func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) []string
 		}
 
 		if _, ok := libMapping[libraryUID]; ok {
-			slog.Warn("Library already exists, skipping", "filename", file)
+			s.recordSkipped(resourceTypes.LibraryElementResource, file, "library element already exists")
 			continue
 		}
 		if folderName == "" {
@@ -286,7 +321,8 @@ func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) []string
 
 				newFolders, folderErr := s.createdFolders(folderName)
 				if folderErr != nil {
-					log.Panic("Unable to create required folder")
+					s.recordFailure(resourceTypes.LibraryElementResource, file, fmt.Errorf("unable to create required folder %s: %w", folderName, folderErr))
+					continue
 				} else {
 					maps.Copy(folderUidMap, newFolders)
 					folderUid = folderUidMap[folderName]
@@ -297,7 +333,7 @@ func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) []string
 
 		var libraryRequest domain.WithNested[*models.LibraryElementDTO]
 		if err = json.Unmarshal(rawLibraryElement, &libraryRequest); err != nil {
-			slog.Error("failed to unmarshall file", "filename", file, "err", err)
+			s.recordFailure(resourceTypes.LibraryElementResource, file, fmt.Errorf("failed to unmarshall file: %w", err))
 			continue
 		}
 		newLibraryRequest := domain.WithNestedToCreateLibraryElement(libraryRequest)
@@ -307,34 +343,36 @@ func (s *DashNGoImpl) UploadLibraryElements(filterReq filters.V2Filter) []string
 
 		entity, grafanaErr := s.GetClient().LibraryElements.CreateLibraryElement(newLibraryRequest)
 		if grafanaErr != nil {
-			slog.Error("Failed to create library element", "err", grafanaErr, "resource", file)
+			s.recordFailure(resourceTypes.LibraryElementResource, file, fmt.Errorf("failed to create library element: %w", grafanaErr))
 		} else {
+			s.recordSuccess(resourceTypes.LibraryElementResource, fmt.Sprintf("%s/%s", folderName, entity.Payload.Result.Name))
 			exported = append(exported, fmt.Sprintf("%s/%s", folderName, entity.Payload.Result.Name))
 		}
 	}
-	return exported
+	return exported, nil
 }
 
 // DeleteAllLibraryElements deletes all the Library Elements
-func (s *DashNGoImpl) DeleteAllLibraryElements(filter filters.V2Filter) []string {
+func (s *DashNGoImpl) DeleteAllLibraryElements(filter filters.V2Filter) ([]string, error) {
 	var entries []string
-	libraryElements := s.ListLibraryElements(filter)
+	libraryElements, err := s.ListLibraryElements(filter)
+	if err != nil {
+		return nil, err
+	}
 	for _, element := range libraryElements {
 
-		_, err := s.GetClient().LibraryElements.DeleteLibraryElementByUID(element.Entity.UID)
+		_, err = s.GetClient().LibraryElements.DeleteLibraryElementByUID(element.Entity.UID)
 		if err != nil {
-			logEntries := make([]any, 0)
 			var serr *library_elements.DeleteLibraryElementByUIDForbidden
 			if errors.As(err, &serr) {
-				logEntries = append(logEntries, []any{"ErrorMessage", *serr.GetPayload().Message}...)
+				err = fmt.Errorf("%s: %w", ptr.ValueOrDefault(serr.GetPayload().Message, ""), err)
 			}
-
-			logEntries = append(logEntries, []any{"panel", element.Entity.Name}...)
-			slog.Error("Failed to delete library panel", logEntries...)
+			s.recordFailure(resourceTypes.LibraryElementResource, element.Entity.Name, fmt.Errorf("failed to delete library panel: %w", err))
 			continue
 		}
+		s.recordSuccess(resourceTypes.LibraryElementResource, element.Entity.Name)
 		entries = append(entries, element.Entity.Name)
 	}
 
-	return entries
+	return entries, nil
 }
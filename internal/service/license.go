@@ -1,11 +1,35 @@
 package service
 
-// IsEnterprise will return a valid response if the grafana version is running an enterprise version
-func (s *DashNGoImpl) IsEnterprise() bool {
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+)
+
+// IsEnterprise will return a valid response if the grafana version is running an enterprise version.  An error is
+// only returned when Grafana could not be reached, a server without licensing support is not an enterprise server.
+func (s *DashNGoImpl) IsEnterprise() (bool, error) {
 	r, err := s.GetClient().Licensing.GetStatus()
 	if err != nil {
-		return false
+		var apiErr *runtime.APIError
+		if errors.As(err, &apiErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to retrieve license status: %w", err)
 	}
 
-	return r.IsSuccess()
+	return r.IsSuccess(), nil
+}
+
+// requireEnterprise returns ErrEnterpriseRequired unless Grafana is running an enterprise version
+func (s *DashNGoImpl) requireEnterprise() error {
+	enterprise, err := s.IsEnterprise()
+	if err != nil {
+		return err
+	}
+	if !enterprise {
+		return ErrEnterpriseRequired
+	}
+	return nil
 }
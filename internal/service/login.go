@@ -1,15 +1,18 @@
 package service
 
 import (
-	"crypto/tls"
-	"log"
+	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
 	"github.com/esnet/gdg/internal/config/domain"
 
 	"github.com/esnet/gdg/internal/api"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/grafana/grafana-openapi-client-go/models"
 
@@ -17,37 +20,66 @@ import (
 )
 
 // Login sets admin flag and provisions the Extended API for calls unsupported by the OpenAPI spec.
-func (s *DashNGoImpl) Login() {
+func (s *DashNGoImpl) Login() error {
 	var err error
+	if _, err = url.Parse(s.grafanaConf.GetURL()); err != nil {
+		return fmt.Errorf("invalid Grafana URL '%s': %w", s.grafanaConf.GetURL(), err)
+	}
 	if !s.gdgConfig.PluginConfig.Disabled && s.gdgConfig.PluginConfig.CipherPlugin != nil {
 		s.grafanaConf.UpdateSecureModel(s.encoder.DecodeValue)
 	}
+	transport, err := api.NewTransport(s.gdgConfig)
+	if err != nil {
+		return fmt.Errorf("invalid transport settings for context '%s': %w", s.gdgConfig.GetContext(), err)
+	}
+	s.transport = transport
 
-	// Will only succeed for BasicAuth
-	if s.grafanaConf.IsBasicAuth() {
+	// Will only succeed for BasicAuth, or tokens of a provider identifying a user
+	if s.grafanaConf.IsBasicAuth() || s.grafanaConf.HasTokenProvider() {
 		var userInfo *models.UserProfileDTO
 		userInfo, err = s.GetUserInfo()
 		// Sets state based on user permissions
 		if err == nil {
 			s.grafanaConf.SetGrafanaAdmin(userInfo.IsGrafanaAdmin)
+		} else {
+			slog.Debug("unable to retrieve user info, assuming user is not a Grafana admin", "err", err)
 		}
 	}
 
-	s.extended = api.NewExtendedApi(s.gdgConfig)
+	s.extended = api.NewExtendedApi(s.requestContext(), s.gdgConfig, s.httpTransport())
+	return nil
+}
+
+// httpTransport returns the transport shared by every request made to Grafana.  It's created on login, services that
+// didn't login create it on first use, every request fails when the transport settings are invalid.
+func (s *DashNGoImpl) httpTransport() http.RoundTripper {
+	if s.transport == nil {
+		transport, err := api.NewTransport(s.gdgConfig)
+		if err != nil {
+			return failingTransport{err: fmt.Errorf("invalid transport settings: %w", err)}
+		}
+		s.transport = transport
+	}
+	return s.transport
 }
 
-func ignoreSSL(transportConfig *client.TransportConfig) {
-	_, clientTransport := ignoreSSLErrors()
-	transportConfig.TLSConfig = clientTransport.TLSClientConfig
+// failingTransport fails every request with the same error
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
 }
 
 type NewClientOpts func(transportConfig *client.TransportConfig)
 
-func GetOrgNameClientOpts(cfg *domain.GDGAppConfiguration) NewClientOpts {
-	orgName := cfg.GetDefaultGrafanaConfig().OrganizationName
+// orgNameClientOpts returns the client options targeting the configured organization
+func (s *DashNGoImpl) orgNameClientOpts() NewClientOpts {
+	orgName := s.grafanaConf.OrganizationName
 	if orgName != "" {
 		return func(transportConfig *client.TransportConfig) {
-			orgId, err := api.NewExtendedApi(cfg).GetConfiguredOrgId(orgName)
+			orgId, err := api.NewExtendedApi(s.requestContext(), s.gdgConfig, s.httpTransport()).GetConfiguredOrgId(orgName)
 			if err != nil {
 				slog.Error("unable to determine org ID, falling back", slog.Any("err", err))
 				orgId = 1
@@ -62,44 +94,88 @@ func GetOrgNameClientOpts(cfg *domain.GDGAppConfiguration) NewClientOpts {
 	}
 }
 
+// newClientMu serializes the creation of Grafana clients
+var newClientMu sync.Mutex
+
 func (s *DashNGoImpl) getNewClient(opts ...NewClientOpts) (*client.GrafanaHTTPAPI, *client.TransportConfig) {
 	var err error
+	// the URL is validated on login, requests made using an invalid URL fail with an error
 	u, err := url.Parse(s.grafanaConf.GetURL())
 	if err != nil {
-		log.Fatal("invalid Grafana URL", s.grafanaConf.GetURL())
+		slog.Error("invalid Grafana URL", "url", s.grafanaConf.GetURL(), "err", err)
+		u = new(url.URL)
 	}
 	path, err := url.JoinPath(u.Path, "api")
 	if err != nil {
-		log.Fatal("invalid Grafana URL Path")
+		slog.Error("invalid Grafana URL Path", "err", err)
 	}
 
 	httpConfig := &client.TransportConfig{
-		Host:         u.Host,
-		BasePath:     path,
-		Schemes:      []string{u.Scheme},
-		NumRetries:   s.gdgConfig.GetAppGlobals().RetryCount,
-		RetryTimeout: s.gdgConfig.GetAppGlobals().GetRetryTimeout(),
-		Debug:        s.GetGlobals().ApiDebug,
+		Host:     u.Host,
+		BasePath: path,
+		Schemes:  []string{u.Scheme},
+		Debug:    s.GetGlobals().ApiDebug,
+		// retries, rate limits and TLS settings are handled by the shared transport
+		Client: &http.Client{Transport: s.httpTransport()},
 	}
 
 	// If more than one opts is passed, depend on the caller to setup his required configuration
 	if s.grafanaConf.IsBasicAuth() && len(opts) == 1 {
-		opts = append(opts, GetOrgNameClientOpts(s.gdgConfig))
+		opts = append(opts, s.orgNameClientOpts())
 	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt(httpConfig)
 		}
 	}
-	if s.gdgConfig.IgnoreSSL() {
-		ignoreSSL(httpConfig)
+
+	// the client sets the TLS configuration of http.DefaultTransport, clients are created one at a time
+	newClientMu.Lock()
+	grafanaClient := client.NewHTTPClientWithConfig(strfmt.Default, httpConfig)
+	newClientMu.Unlock()
+	boundTransport := &contextTransport{
+		next:    grafanaClient.Transport,
+		ctx:     s.requestContext(),
+		timeout: s.GetGlobals().GetRequestTimeout(),
 	}
+	return client.New(boundTransport, httpConfig, strfmt.Default), httpConfig
+}
 
-	return client.NewHTTPClientWithConfig(strfmt.Default, httpConfig), httpConfig
+// contextTransport binds every operation to the context of the service and limits it to the request timeout, retries
+// included.
+type contextTransport struct {
+	next    runtime.ClientTransport
+	ctx     context.Context
+	timeout time.Duration
 }
 
-// GetClient Returns a new defaultClient given token precedence over Basic Auth
+func (t *contextTransport) Submit(operation *runtime.ClientOperation) (any, error) {
+	if err := t.ctx.Err(); err != nil {
+		return nil, err
+	}
+	bound := *operation
+	if bound.Context == nil {
+		bound.Context = t.ctx
+	}
+	params := operation.Params
+	bound.Params = runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, registry strfmt.Registry) error {
+		if err := params.WriteToRequest(req, registry); err != nil {
+			return err
+		}
+		return req.SetTimeout(t.timeout)
+	})
+	return t.next.Submit(&bound)
+}
+
+// GetClient Returns a new defaultClient given token provider precedence over token and Basic Auth
 func (s *DashNGoImpl) GetClient() *client.GrafanaHTTPAPI {
+	// tokens of the provider are set by the shared transport
+	if s.grafanaConf.HasTokenProvider() {
+		grafanaClient, _ := s.getNewClient(func(clientCfg *client.TransportConfig) {
+			clientCfg.Debug = s.GetGlobals().ApiDebug
+		})
+		return grafanaClient
+	}
 	if s.grafanaConf.GetAPIToken() != "" {
 		grafanaClient, _ := s.getNewClient(func(clientCfg *client.TransportConfig) {
 			clientCfg.APIKey = s.grafanaConf.GetAPIToken()
@@ -119,11 +195,11 @@ func (s *DashNGoImpl) GetBasicClientWithOpts(opts ...NewClientOpts) *client.Graf
 }
 
 // GetAdminClient Returns the admin defaultClient if one is configured
-func (s *DashNGoImpl) GetAdminClient() *client.GrafanaHTTPAPI {
+func (s *DashNGoImpl) GetAdminClient() (*client.GrafanaHTTPAPI, error) {
 	if !s.grafanaConf.IsGrafanaAdmin() || s.grafanaConf.UserName == "" {
-		log.Fatal("Unable to get Grafana Admin SecureData. ")
+		return nil, ErrAdminRequired
 	}
-	return s.GetBasicClientWithOpts()
+	return s.GetBasicClientWithOpts(), nil
 }
 
 func (s *DashNGoImpl) getDefaultBasicOpts() []NewClientOpts {
@@ -137,12 +213,3 @@ func (s *DashNGoImpl) getDefaultBasicOpts() []NewClientOpts {
 func (s *DashNGoImpl) GetBasicAuthClient() *client.GrafanaHTTPAPI {
 	return s.GetBasicClientWithOpts()
 }
-
-// ignoreSSLErrors when called replaces the default http legacyClient to ignore invalid SSL issues.
-// only to be used for testing, highly discouraged in production.
-func ignoreSSLErrors() (*http.Client, *http.Transport) {
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
-	httpclient := &http.Client{Transport: customTransport}
-	return httpclient, customTransport
-}
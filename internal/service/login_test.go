@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	configDomain "github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newContextTestService returns a service using token auth against the given URL, without contacting it
+func newContextTestService(url string, globals *configDomain.AppGlobals) *DashNGoImpl {
+	grafanaConf := configDomain.NewGrafanaConfig("ctx")
+	grafanaConf.URL = url
+	grafanaConf.SetSecureAuth(configDomain.SecureModel{Token: "token"})
+	cfg := &configDomain.GDGAppConfiguration{
+		ContextName: "ctx",
+		Contexts:    map[string]*configDomain.GrafanaConfig{"ctx": grafanaConf},
+		Global:      globals,
+	}
+	s := &DashNGoImpl{}
+	setupConfigData(cfg, s)
+	return s
+}
+
+func TestRequestContext(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	s := newContextTestService(server.URL, &configDomain.AppGlobals{RequestTimeout: "50ms"})
+	_, err := s.GetServerInfo()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), calls.Load())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = s.WithContext(ctx).GetServerInfo()
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), calls.Load(), "no request is sent once the context is done")
+
+	_, err = s.GetServerInfo()
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the service is still bound to its own context")
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestNewGrafanaServiceUnknownContext(t *testing.T) {
+	cfg := &configDomain.GDGAppConfiguration{ContextName: "missing", Contexts: map[string]*configDomain.GrafanaConfig{}}
+	_, err := NewGrafanaService(t.Context(), cfg, storage.NewMemoryStorage())
+	assert.ErrorContains(t, err, "context: 'missing' is not found")
+	assert.Error(t, cfg.SetContext("missing"))
+}
+
+func TestNewDashNGo(t *testing.T) {
+	cfg := newContextTestService("http://localhost:3000", &configDomain.AppGlobals{ApiDebug: true}).gdgConfig
+	first, err := NewDashNGo(t.Context(), cfg)
+	require.NoError(t, err)
+	second, err := NewDashNGo(context.Background(), cfg)
+	require.NoError(t, err)
+	assert.NotSame(t, first, second, "every call returns a new service")
+	assert.Equal(t, t.Context(), first.requestContext())
+	assert.Equal(t, context.Background(), second.requestContext())
+	_, clientCfg := first.getNewClient()
+	assert.True(t, clientCfg.Debug, "api_debug is set on the client rather than the environment")
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/esnet/gdg/internal/version"
+	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
+)
+
+// ManifestFile name of the manifest written at the root of the output path
+const ManifestFile = "manifest.json"
+
+const (
+	grafanaEditionOSS        = "oss"
+	grafanaEditionEnterprise = "enterprise"
+)
+
+// BackupManifest records where and when the backups of a context were taken
+type BackupManifest struct {
+	GdgVersion     string                     `json:"gdgVersion"`
+	GrafanaVersion string                     `json:"grafanaVersion"`
+	GrafanaEdition string                     `json:"grafanaEdition"`
+	Context        string                     `json:"context"`
+	Timestamp      time.Time                  `json:"timestamp"`
+	Commands       map[string]ManifestCommand `json:"commands"`
+	Resources      map[string]int             `json:"resources"`
+	Files          map[string]string          `json:"files"`
+}
+
+// ManifestCommand the last invocation of a download command and the filters it was invoked with
+type ManifestCommand struct {
+	Filters   map[string]string `json:"filters,omitempty"`
+	Timestamp time.Time         `json:"timestamp"`
+}
+
+// ManifestRecorder is implemented by services maintaining a manifest of the backups
+type ManifestRecorder interface {
+	WriteManifest(command string, filters map[string]string) error
+	VerifyManifest() ([]string, error)
+}
+
+// WriteManifest records the download command that was just run along with the checksums of the files it wrote, which
+// are merged into the existing manifest, and refreshes the entity counts.  Files that are no longer part of the backup,
+// ie. removed by clear_output, are dropped from the manifest.
+func (s *DashNGoImpl) WriteManifest(command string, filters map[string]string) error {
+	ctx := s.requestContext()
+	manifest, err := s.readManifest(ctx)
+	if err != nil {
+		slog.Warn("Unable to read existing manifest, a new one will be created", "err", err)
+		manifest = nil
+	}
+	if manifest == nil {
+		manifest = &BackupManifest{Commands: make(map[string]ManifestCommand)}
+	}
+	now := time.Now().UTC()
+	manifest.GdgVersion = version.Version
+	if manifest.GrafanaVersion, manifest.GrafanaEdition, err = s.grafanaVersion(); err != nil {
+		return err
+	}
+	manifest.Context = s.gdgConfig.GetContext()
+	manifest.Timestamp = now
+	manifest.Commands[command] = ManifestCommand{Filters: filters, Timestamp: now}
+	if manifest.Files == nil {
+		manifest.Files = make(map[string]string)
+	}
+	state := s.shared()
+	state.mu.Lock()
+	maps.Copy(manifest.Files, state.written)
+	state.written = nil
+	state.mu.Unlock()
+	files, err := manifestFiles(ctx, s.storage, s.grafanaConf.OutputPath)
+	if err != nil {
+		return fmt.Errorf("unable to list backup files: %w", err)
+	}
+	maps.DeleteFunc(manifest.Files, func(file, _ string) bool {
+		return !files[file]
+	})
+	manifest.Resources = manifestResources(manifest.Files)
+
+	data, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return fmt.Errorf("unable to serialize manifest: %w", err)
+	}
+	return s.storage.WriteFile(ctx, s.manifestLocation(), data)
+}
+
+// VerifyManifest compares the manifest of the backup with the current environment, a warning is returned for every
+// version mismatch and every file that is missing.  Files aren't read here, the checksum of every file is verified
+// once the upload reads it, see readBackupFile.
+func (s *DashNGoImpl) VerifyManifest() ([]string, error) {
+	ctx := s.requestContext()
+	manifest, err := s.readManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		slog.Debug("No manifest found, skipping backup verification", "location", s.manifestLocation())
+		return nil, nil
+	}
+	var warnings []string
+	if manifest.GdgVersion != version.Version {
+		warnings = append(warnings, fmt.Sprintf("backup was taken with gdg %s, running %s", manifest.GdgVersion, version.Version))
+	}
+	grafanaVersion, edition, err := s.grafanaVersion()
+	if err != nil {
+		return nil, err
+	}
+	if manifest.GrafanaVersion != grafanaVersion || manifest.GrafanaEdition != edition {
+		warnings = append(warnings, fmt.Sprintf("backup was taken from Grafana %s (%s), uploading to Grafana %s (%s)",
+			manifest.GrafanaVersion, manifest.GrafanaEdition, grafanaVersion, edition))
+	}
+	if manifest.Context != s.gdgConfig.GetContext() {
+		warnings = append(warnings, fmt.Sprintf("backup was taken by context %s, current context is %s",
+			manifest.Context, s.gdgConfig.GetContext()))
+	}
+	files, err := manifestFiles(ctx, s.storage, s.grafanaConf.OutputPath)
+	if err != nil {
+		return warnings, err
+	}
+	for _, file := range slices.Sorted(maps.Keys(manifest.Files)) {
+		if !files[file] {
+			warnings = append(warnings, fmt.Sprintf("file %s listed in the manifest is missing", file))
+		}
+	}
+	state := s.shared()
+	state.mu.Lock()
+	state.verified = manifest.Files
+	state.mu.Unlock()
+	return warnings, nil
+}
+
+// checkFile compares the checksum recorded for the file with the current one, empty if they match
+func (m *BackupManifest) checkFile(file string, checksums map[string]string) string {
+	actual, ok := checksums[file]
+	if !ok {
+		return "listed in the manifest is missing"
+	} else if actual != m.Files[file] {
+		return "was modified since the backup was taken"
+	}
+	return ""
+}
+
+// writeBackupFile writes a file of the backup, its checksum is recorded for the manifest
+func (s *DashNGoImpl) writeBackupFile(filename string, data []byte) error {
+	if err := s.storage.WriteFile(s.requestContext(), filename, data); err != nil {
+		return err
+	}
+	rel, ok := s.manifestPath(filename)
+	if !ok {
+		return nil
+	}
+	state := s.shared()
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.written == nil {
+		state.written = make(map[string]string)
+	}
+	state.written[rel] = checksum(data)
+	return nil
+}
+
+// readBackupFile reads a file of the backup, a warning is logged if it was modified since the verified manifest was
+// written.
+func (s *DashNGoImpl) readBackupFile(filename string) ([]byte, error) {
+	data, err := s.storage.ReadFile(s.requestContext(), filename)
+	if err != nil {
+		return nil, err
+	}
+	rel, ok := s.manifestPath(filename)
+	if !ok {
+		return data, nil
+	}
+	state := s.shared()
+	state.mu.Lock()
+	expected, listed := state.verified[rel]
+	state.mu.Unlock()
+	if listed && expected != checksum(data) {
+		slog.Warn("Backup manifest mismatch", "warning", fmt.Sprintf("file %s was modified since the backup was taken", rel))
+	}
+	return data, nil
+}
+
+// manifestPath returns the path of the file relative to the output path, false for files the manifest doesn't track
+func (s *DashNGoImpl) manifestPath(filename string) (string, bool) {
+	root := path.Clean(filepath.ToSlash(s.grafanaConf.OutputPath))
+	name := path.Clean(filepath.ToSlash(storage.RelativeName(s.storage, filename)))
+	rel, ok := strings.CutPrefix(name, root+"/")
+	if root == "." {
+		rel, ok = name, !strings.HasPrefix(name, "../")
+	}
+	if !ok || rel == ManifestFile || isHiddenPath(rel) {
+		return "", false
+	}
+	return rel, true
+}
+
+func (s *DashNGoImpl) manifestLocation() string {
+	return path.Join(s.grafanaConf.OutputPath, ManifestFile)
+}
+
+// readManifest returns the manifest of the backup, nil if none was written yet
+func (s *DashNGoImpl) readManifest(ctx context.Context) (*BackupManifest, error) {
+	exists, err := s.storage.Exists(ctx, s.manifestLocation())
+	if err != nil || !exists {
+		return nil, err
+	}
+	data, err := s.storage.ReadFile(ctx, s.manifestLocation())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read manifest: %w", err)
+	}
+	manifest := new(BackupManifest)
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", s.manifestLocation(), err)
+	}
+	if manifest.Commands == nil {
+		manifest.Commands = make(map[string]ManifestCommand)
+	}
+	return manifest, nil
+}
+
+// grafanaVersion returns the version and edition of the Grafana server
+func (s *DashNGoImpl) grafanaVersion() (string, string, error) {
+	edition := grafanaEditionOSS
+	enterprise, err := s.IsEnterprise()
+	if err != nil {
+		return "", "", err
+	}
+	if enterprise {
+		edition = grafanaEditionEnterprise
+	}
+	info, err := s.GetServerInfo()
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("%v", info[SrvInfoVersionKey]), edition, nil
+}
+
+// manifestFiles returns every file of the backup found in the storage engine, keyed by its path relative to root
+func manifestFiles(ctx context.Context, engine storage.Storage, root string) (map[string]bool, error) {
+	files, err := engine.FindAllFiles(ctx, root, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list backup files: %w", err)
+	}
+	found := make(map[string]bool, len(files))
+	for _, file := range files {
+		rel := backupRelativePath(engine, root, file)
+		if rel == ManifestFile || isHiddenPath(rel) {
+			continue
+		}
+		found[rel] = true
+	}
+	return found, nil
+}
+
+// checksum returns the hex encoded SHA-256 checksum of the content
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// backupRelativePath returns the path of a file listed by the storage engine relative to the output path
+func backupRelativePath(engine storage.Storage, root, file string) string {
+	root = path.Clean(filepath.ToSlash(root))
+	name := path.Clean(filepath.ToSlash(storage.RelativeName(engine, file)))
+	if root == "." {
+		return name
+	}
+	return strings.TrimPrefix(name, root+"/")
+}
+
+// manifestResources counts the files of every resource type, namespaced resources are counted per organization
+func manifestResources(files map[string]string) map[string]int {
+	orgPrefix := string(resourceTypes.OrganizationMetaResource) + "_"
+	resources := make(map[string]int)
+	for file := range files {
+		segments := strings.Split(file, "/")
+		if len(segments) < 2 {
+			continue
+		}
+		key := segments[0]
+		if strings.HasPrefix(key, orgPrefix) && len(segments) > 2 {
+			key = path.Join(segments[0], segments[1])
+		}
+		resources[key]++
+	}
+	return resources
+}
+
+// isHiddenPath returns true for files such as the .git folder that aren't part of the backup
+func isHiddenPath(file string) bool {
+	for segment := range strings.SplitSeq(file, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	configDomain "github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestFiles(t *testing.T) {
+	ctx := t.Context()
+	root := filepath.Join(t.TempDir(), "test/data")
+	engine := storage.NewLocalStorage(ctx)
+	files := map[string]string{
+		"org_main-org/dashboards/general/a.json": `{"a":1}`,
+		"org_main-org/dashboards/general/b.json": `{"b":1}`,
+		"org_main-org/connections/prom.json":     `{"c":1}`,
+		"users/admin.json":                       `{"u":1}`,
+		ManifestFile:                             `{}`,
+		".git/HEAD":                              "ref",
+	}
+	for name, data := range files {
+		require.NoError(t, engine.WriteFile(ctx, filepath.Join(root, name), []byte(data)))
+	}
+
+	found, err := manifestFiles(ctx, engine, root)
+	require.NoError(t, err)
+	assert.Len(t, found, 4)
+	assert.NotContains(t, found, ManifestFile)
+	assert.NotContains(t, found, ".git/HEAD")
+	assert.Equal(t, "9959872f387301828f573c4ab39aad718d046c682a45d45a3ff967cf15f99e5b", checksum([]byte(files["users/admin.json"])))
+
+	checksums := make(map[string]string)
+	for file := range found {
+		checksums[file] = ""
+	}
+	assert.Equal(t, map[string]int{
+		"org_main-org/dashboards":  2,
+		"org_main-org/connections": 1,
+		"users":                    1,
+	}, manifestResources(checksums))
+}
+
+// newManifestTestService returns a service writing its backup to a temporary folder of the local disk
+func newManifestTestService(t *testing.T) (*DashNGoImpl, string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/health" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"12.1.0"}`))
+	}))
+	t.Cleanup(server.Close)
+	s := newContextTestService(server.URL, &configDomain.AppGlobals{})
+	s.storage = storage.NewLocalStorage(t.Context())
+	root := t.TempDir()
+	s.grafanaConf.OutputPath = root
+	return s, root
+}
+
+func TestWriteManifestMergesWrittenFiles(t *testing.T) {
+	s, root := newManifestTestService(t)
+	previous := &BackupManifest{Files: map[string]string{"users/old.json": "stale", "users/kept.json": "kept"}}
+	data, err := json.Marshal(previous)
+	require.NoError(t, err)
+	require.NoError(t, s.storage.WriteFile(t.Context(), s.manifestLocation(), data))
+	require.NoError(t, s.storage.WriteFile(t.Context(), filepath.Join(root, "users/kept.json"), []byte(`{}`)))
+	// files that weren't written by the command aren't read
+	require.NoError(t, s.storage.WriteFile(t.Context(), filepath.Join(root, "users/untracked.json"), []byte(`{}`)))
+
+	require.NoError(t, s.writeBackupFile(filepath.Join(root, "users/bob.json"), []byte(`{"login":"bob"}`)))
+	require.NoError(t, s.writeBackupFile(filepath.Join(t.TempDir(), "outside.json"), []byte(`{}`)))
+	require.NoError(t, s.WriteManifest("gdg backup users download", nil))
+
+	manifest, err := s.readManifest(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"users/kept.json": "kept", "users/bob.json": checksum([]byte(`{"login":"bob"}`))}, manifest.Files,
+		"files that are no longer part of the backup are dropped")
+	assert.Equal(t, map[string]int{"users": 2}, manifest.Resources)
+	assert.Equal(t, "12.1.0", manifest.GrafanaVersion)
+	assert.Empty(t, s.shared().written, "recorded checksums are reset once merged")
+
+	warnings, err := s.VerifyManifest()
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, manifest.Files, s.shared().verified)
+}
+
+func TestWriteManifestDeletedEntity(t *testing.T) {
+	s, root := newManifestTestService(t)
+	alice, bob := filepath.Join(root, "users/alice.json"), filepath.Join(root, "users/bob.json")
+	require.NoError(t, s.writeBackupFile(alice, []byte(`{"login":"alice"}`)))
+	require.NoError(t, s.writeBackupFile(bob, []byte(`{"login":"bob"}`)))
+	require.NoError(t, s.WriteManifest("gdg backup users download", nil))
+
+	// bob was deleted from Grafana, clear_output removes the users before they are downloaded again
+	require.NoError(t, s.storage.Delete(t.Context(), filepath.Join(root, "users")))
+	require.NoError(t, s.writeBackupFile(alice, []byte(`{"login":"alice"}`)))
+	require.NoError(t, s.WriteManifest("gdg backup users download", nil))
+
+	warnings, err := s.VerifyManifest()
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	manifest, err := s.readManifest(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"users": 1}, manifest.Resources)
+}
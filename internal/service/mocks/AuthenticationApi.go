@@ -5,7 +5,7 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -186,7 +186,7 @@ func (_c *AuthenticationApi_CreateServiceAccountToken_Call) RunAndReturn(run fun
 }
 
 // DecodeValue provides a mock function for the type AuthenticationApi
-func (_mock *AuthenticationApi) DecodeValue(in string) string {
+func (_mock *AuthenticationApi) DecodeValue(in string) (string, error) {
 	ret := _mock.Called(in)
 
 	if len(ret) == 0 {
@@ -194,12 +194,21 @@ func (_mock *AuthenticationApi) DecodeValue(in string) string {
 	}
 
 	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(in)
+	}
 	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
 		r0 = returnFunc(in)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // AuthenticationApi_DecodeValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecodeValue'
@@ -226,18 +235,18 @@ func (_c *AuthenticationApi_DecodeValue_Call) Run(run func(in string)) *Authenti
 	return _c
 }
 
-func (_c *AuthenticationApi_DecodeValue_Call) Return(s string) *AuthenticationApi_DecodeValue_Call {
-	_c.Call.Return(s)
+func (_c *AuthenticationApi_DecodeValue_Call) Return(s string, err error) *AuthenticationApi_DecodeValue_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *AuthenticationApi_DecodeValue_Call) RunAndReturn(run func(in string) string) *AuthenticationApi_DecodeValue_Call {
+func (_c *AuthenticationApi_DecodeValue_Call) RunAndReturn(run func(in string) (string, error)) *AuthenticationApi_DecodeValue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllServiceAccounts provides a mock function for the type AuthenticationApi
-func (_mock *AuthenticationApi) DeleteAllServiceAccounts() []string {
+func (_mock *AuthenticationApi) DeleteAllServiceAccounts() ([]string, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -245,6 +254,10 @@ func (_mock *AuthenticationApi) DeleteAllServiceAccounts() []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() []string); ok {
 		r0 = returnFunc()
 	} else {
@@ -252,7 +265,12 @@ func (_mock *AuthenticationApi) DeleteAllServiceAccounts() []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // AuthenticationApi_DeleteAllServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllServiceAccounts'
@@ -272,12 +290,12 @@ func (_c *AuthenticationApi_DeleteAllServiceAccounts_Call) Run(run func()) *Auth
 	return _c
 }
 
-func (_c *AuthenticationApi_DeleteAllServiceAccounts_Call) Return(strings []string) *AuthenticationApi_DeleteAllServiceAccounts_Call {
-	_c.Call.Return(strings)
+func (_c *AuthenticationApi_DeleteAllServiceAccounts_Call) Return(strings []string, err error) *AuthenticationApi_DeleteAllServiceAccounts_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *AuthenticationApi_DeleteAllServiceAccounts_Call) RunAndReturn(run func() []string) *AuthenticationApi_DeleteAllServiceAccounts_Call {
+func (_c *AuthenticationApi_DeleteAllServiceAccounts_Call) RunAndReturn(run func() ([]string, error)) *AuthenticationApi_DeleteAllServiceAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -334,7 +352,7 @@ func (_c *AuthenticationApi_DeleteServiceAccount_Call) RunAndReturn(run func(acc
 }
 
 // DeleteServiceAccountTokens provides a mock function for the type AuthenticationApi
-func (_mock *AuthenticationApi) DeleteServiceAccountTokens(serviceId int64) []string {
+func (_mock *AuthenticationApi) DeleteServiceAccountTokens(serviceId int64) ([]string, error) {
 	ret := _mock.Called(serviceId)
 
 	if len(ret) == 0 {
@@ -342,6 +360,10 @@ func (_mock *AuthenticationApi) DeleteServiceAccountTokens(serviceId int64) []st
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]string, error)); ok {
+		return returnFunc(serviceId)
+	}
 	if returnFunc, ok := ret.Get(0).(func(int64) []string); ok {
 		r0 = returnFunc(serviceId)
 	} else {
@@ -349,7 +371,12 @@ func (_mock *AuthenticationApi) DeleteServiceAccountTokens(serviceId int64) []st
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(serviceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // AuthenticationApi_DeleteServiceAccountTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccountTokens'
@@ -376,18 +403,18 @@ func (_c *AuthenticationApi_DeleteServiceAccountTokens_Call) Run(run func(servic
 	return _c
 }
 
-func (_c *AuthenticationApi_DeleteServiceAccountTokens_Call) Return(strings []string) *AuthenticationApi_DeleteServiceAccountTokens_Call {
-	_c.Call.Return(strings)
+func (_c *AuthenticationApi_DeleteServiceAccountTokens_Call) Return(strings []string, err error) *AuthenticationApi_DeleteServiceAccountTokens_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *AuthenticationApi_DeleteServiceAccountTokens_Call) RunAndReturn(run func(serviceId int64) []string) *AuthenticationApi_DeleteServiceAccountTokens_Call {
+func (_c *AuthenticationApi_DeleteServiceAccountTokens_Call) RunAndReturn(run func(serviceId int64) ([]string, error)) *AuthenticationApi_DeleteServiceAccountTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // EncodeValue provides a mock function for the type AuthenticationApi
-func (_mock *AuthenticationApi) EncodeValue(in string) string {
+func (_mock *AuthenticationApi) EncodeValue(in string) (string, error) {
 	ret := _mock.Called(in)
 
 	if len(ret) == 0 {
@@ -395,12 +422,21 @@ func (_mock *AuthenticationApi) EncodeValue(in string) string {
 	}
 
 	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(in)
+	}
 	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
 		r0 = returnFunc(in)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // AuthenticationApi_EncodeValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncodeValue'
@@ -427,18 +463,18 @@ func (_c *AuthenticationApi_EncodeValue_Call) Run(run func(in string)) *Authenti
 	return _c
 }
 
-func (_c *AuthenticationApi_EncodeValue_Call) Return(s string) *AuthenticationApi_EncodeValue_Call {
-	_c.Call.Return(s)
+func (_c *AuthenticationApi_EncodeValue_Call) Return(s string, err error) *AuthenticationApi_EncodeValue_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *AuthenticationApi_EncodeValue_Call) RunAndReturn(run func(in string) string) *AuthenticationApi_EncodeValue_Call {
+func (_c *AuthenticationApi_EncodeValue_Call) RunAndReturn(run func(in string) (string, error)) *AuthenticationApi_EncodeValue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListServiceAccounts provides a mock function for the type AuthenticationApi
-func (_mock *AuthenticationApi) ListServiceAccounts() []*domain.ServiceAccountDTOWithTokens {
+func (_mock *AuthenticationApi) ListServiceAccounts() ([]*domain.ServiceAccountDTOWithTokens, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -446,6 +482,10 @@ func (_mock *AuthenticationApi) ListServiceAccounts() []*domain.ServiceAccountDT
 	}
 
 	var r0 []*domain.ServiceAccountDTOWithTokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*domain.ServiceAccountDTOWithTokens, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() []*domain.ServiceAccountDTOWithTokens); ok {
 		r0 = returnFunc()
 	} else {
@@ -453,7 +493,12 @@ func (_mock *AuthenticationApi) ListServiceAccounts() []*domain.ServiceAccountDT
 			r0 = ret.Get(0).([]*domain.ServiceAccountDTOWithTokens)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // AuthenticationApi_ListServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServiceAccounts'
@@ -473,12 +518,12 @@ func (_c *AuthenticationApi_ListServiceAccounts_Call) Run(run func()) *Authentic
 	return _c
 }
 
-func (_c *AuthenticationApi_ListServiceAccounts_Call) Return(serviceAccountDTOWithTokenss []*domain.ServiceAccountDTOWithTokens) *AuthenticationApi_ListServiceAccounts_Call {
-	_c.Call.Return(serviceAccountDTOWithTokenss)
+func (_c *AuthenticationApi_ListServiceAccounts_Call) Return(serviceAccountDTOWithTokenss []*domain.ServiceAccountDTOWithTokens, err error) *AuthenticationApi_ListServiceAccounts_Call {
+	_c.Call.Return(serviceAccountDTOWithTokenss, err)
 	return _c
 }
 
-func (_c *AuthenticationApi_ListServiceAccounts_Call) RunAndReturn(run func() []*domain.ServiceAccountDTOWithTokens) *AuthenticationApi_ListServiceAccounts_Call {
+func (_c *AuthenticationApi_ListServiceAccounts_Call) RunAndReturn(run func() ([]*domain.ServiceAccountDTOWithTokens, error)) *AuthenticationApi_ListServiceAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -546,9 +591,20 @@ func (_c *AuthenticationApi_ListServiceAccountsTokens_Call) RunAndReturn(run fun
 }
 
 // Login provides a mock function for the type AuthenticationApi
-func (_mock *AuthenticationApi) Login() {
-	_mock.Called()
-	return
+func (_mock *AuthenticationApi) Login() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
 // AuthenticationApi_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
@@ -568,12 +624,12 @@ func (_c *AuthenticationApi_Login_Call) Run(run func()) *AuthenticationApi_Login
 	return _c
 }
 
-func (_c *AuthenticationApi_Login_Call) Return() *AuthenticationApi_Login_Call {
-	_c.Call.Return()
+func (_c *AuthenticationApi_Login_Call) Return(err error) *AuthenticationApi_Login_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *AuthenticationApi_Login_Call) RunAndReturn(run func()) *AuthenticationApi_Login_Call {
-	_c.Run(run)
+func (_c *AuthenticationApi_Login_Call) RunAndReturn(run func() error) *AuthenticationApi_Login_Call {
+	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,7 +38,7 @@ func (_m *ConnectionPermissions) EXPECT() *ConnectionPermissions_Expecter {
 }
 
 // DeleteAllConnectionPermissions provides a mock function for the type ConnectionPermissions
-func (_mock *ConnectionPermissions) DeleteAllConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *ConnectionPermissions) DeleteAllConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -46,6 +46,10 @@ func (_mock *ConnectionPermissions) DeleteAllConnectionPermissions(filter filter
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -53,7 +57,12 @@ func (_mock *ConnectionPermissions) DeleteAllConnectionPermissions(filter filter
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionPermissions_DeleteAllConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllConnectionPermissions'
@@ -80,18 +89,18 @@ func (_c *ConnectionPermissions_DeleteAllConnectionPermissions_Call) Run(run fun
 	return _c
 }
 
-func (_c *ConnectionPermissions_DeleteAllConnectionPermissions_Call) Return(strings []string) *ConnectionPermissions_DeleteAllConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionPermissions_DeleteAllConnectionPermissions_Call) Return(strings []string, err error) *ConnectionPermissions_DeleteAllConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionPermissions_DeleteAllConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionPermissions_DeleteAllConnectionPermissions_Call {
+func (_c *ConnectionPermissions_DeleteAllConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionPermissions_DeleteAllConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadConnectionPermissions provides a mock function for the type ConnectionPermissions
-func (_mock *ConnectionPermissions) DownloadConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *ConnectionPermissions) DownloadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -99,6 +108,10 @@ func (_mock *ConnectionPermissions) DownloadConnectionPermissions(filter filters
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -106,7 +119,12 @@ func (_mock *ConnectionPermissions) DownloadConnectionPermissions(filter filters
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionPermissions_DownloadConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadConnectionPermissions'
@@ -133,18 +151,18 @@ func (_c *ConnectionPermissions_DownloadConnectionPermissions_Call) Run(run func
 	return _c
 }
 
-func (_c *ConnectionPermissions_DownloadConnectionPermissions_Call) Return(strings []string) *ConnectionPermissions_DownloadConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionPermissions_DownloadConnectionPermissions_Call) Return(strings []string, err error) *ConnectionPermissions_DownloadConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionPermissions_DownloadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionPermissions_DownloadConnectionPermissions_Call {
+func (_c *ConnectionPermissions_DownloadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionPermissions_DownloadConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListConnectionPermissions provides a mock function for the type ConnectionPermissions
-func (_mock *ConnectionPermissions) ListConnectionPermissions(filter filters.V2Filter) []domain.ConnectionPermissionItem {
+func (_mock *ConnectionPermissions) ListConnectionPermissions(filter filters.V2Filter) ([]domain.ConnectionPermissionItem, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -152,6 +170,10 @@ func (_mock *ConnectionPermissions) ListConnectionPermissions(filter filters.V2F
 	}
 
 	var r0 []domain.ConnectionPermissionItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]domain.ConnectionPermissionItem, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []domain.ConnectionPermissionItem); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -159,7 +181,12 @@ func (_mock *ConnectionPermissions) ListConnectionPermissions(filter filters.V2F
 			r0 = ret.Get(0).([]domain.ConnectionPermissionItem)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionPermissions_ListConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnectionPermissions'
@@ -186,18 +213,18 @@ func (_c *ConnectionPermissions_ListConnectionPermissions_Call) Run(run func(fil
 	return _c
 }
 
-func (_c *ConnectionPermissions_ListConnectionPermissions_Call) Return(connectionPermissionItems []domain.ConnectionPermissionItem) *ConnectionPermissions_ListConnectionPermissions_Call {
-	_c.Call.Return(connectionPermissionItems)
+func (_c *ConnectionPermissions_ListConnectionPermissions_Call) Return(connectionPermissionItems []domain.ConnectionPermissionItem, err error) *ConnectionPermissions_ListConnectionPermissions_Call {
+	_c.Call.Return(connectionPermissionItems, err)
 	return _c
 }
 
-func (_c *ConnectionPermissions_ListConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []domain.ConnectionPermissionItem) *ConnectionPermissions_ListConnectionPermissions_Call {
+func (_c *ConnectionPermissions_ListConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]domain.ConnectionPermissionItem, error)) *ConnectionPermissions_ListConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadConnectionPermissions provides a mock function for the type ConnectionPermissions
-func (_mock *ConnectionPermissions) UploadConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *ConnectionPermissions) UploadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -205,6 +232,10 @@ func (_mock *ConnectionPermissions) UploadConnectionPermissions(filter filters.V
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -212,7 +243,12 @@ func (_mock *ConnectionPermissions) UploadConnectionPermissions(filter filters.V
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionPermissions_UploadConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadConnectionPermissions'
@@ -239,12 +275,12 @@ func (_c *ConnectionPermissions_UploadConnectionPermissions_Call) Run(run func(f
 	return _c
 }
 
-func (_c *ConnectionPermissions_UploadConnectionPermissions_Call) Return(strings []string) *ConnectionPermissions_UploadConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionPermissions_UploadConnectionPermissions_Call) Return(strings []string, err error) *ConnectionPermissions_UploadConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionPermissions_UploadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionPermissions_UploadConnectionPermissions_Call {
+func (_c *ConnectionPermissions_UploadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionPermissions_UploadConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,7 +39,7 @@ func (_m *ConnectionsApi) EXPECT() *ConnectionsApi_Expecter {
 }
 
 // DeleteAllConnectionPermissions provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) DeleteAllConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *ConnectionsApi) DeleteAllConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -47,6 +47,10 @@ func (_mock *ConnectionsApi) DeleteAllConnectionPermissions(filter filters.V2Fil
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -54,7 +58,12 @@ func (_mock *ConnectionsApi) DeleteAllConnectionPermissions(filter filters.V2Fil
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_DeleteAllConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllConnectionPermissions'
@@ -81,18 +90,18 @@ func (_c *ConnectionsApi_DeleteAllConnectionPermissions_Call) Run(run func(filte
 	return _c
 }
 
-func (_c *ConnectionsApi_DeleteAllConnectionPermissions_Call) Return(strings []string) *ConnectionsApi_DeleteAllConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionsApi_DeleteAllConnectionPermissions_Call) Return(strings []string, err error) *ConnectionsApi_DeleteAllConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_DeleteAllConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionsApi_DeleteAllConnectionPermissions_Call {
+func (_c *ConnectionsApi_DeleteAllConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionsApi_DeleteAllConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllConnections provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) DeleteAllConnections(filter filters.V2Filter) []string {
+func (_mock *ConnectionsApi) DeleteAllConnections(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -100,6 +109,10 @@ func (_mock *ConnectionsApi) DeleteAllConnections(filter filters.V2Filter) []str
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -107,7 +120,12 @@ func (_mock *ConnectionsApi) DeleteAllConnections(filter filters.V2Filter) []str
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_DeleteAllConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllConnections'
@@ -134,18 +152,18 @@ func (_c *ConnectionsApi_DeleteAllConnections_Call) Run(run func(filter filters.
 	return _c
 }
 
-func (_c *ConnectionsApi_DeleteAllConnections_Call) Return(strings []string) *ConnectionsApi_DeleteAllConnections_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionsApi_DeleteAllConnections_Call) Return(strings []string, err error) *ConnectionsApi_DeleteAllConnections_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_DeleteAllConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionsApi_DeleteAllConnections_Call {
+func (_c *ConnectionsApi_DeleteAllConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionsApi_DeleteAllConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadConnectionPermissions provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) DownloadConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *ConnectionsApi) DownloadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -153,6 +171,10 @@ func (_mock *ConnectionsApi) DownloadConnectionPermissions(filter filters.V2Filt
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -160,7 +182,12 @@ func (_mock *ConnectionsApi) DownloadConnectionPermissions(filter filters.V2Filt
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_DownloadConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadConnectionPermissions'
@@ -187,18 +214,18 @@ func (_c *ConnectionsApi_DownloadConnectionPermissions_Call) Run(run func(filter
 	return _c
 }
 
-func (_c *ConnectionsApi_DownloadConnectionPermissions_Call) Return(strings []string) *ConnectionsApi_DownloadConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionsApi_DownloadConnectionPermissions_Call) Return(strings []string, err error) *ConnectionsApi_DownloadConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_DownloadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionsApi_DownloadConnectionPermissions_Call {
+func (_c *ConnectionsApi_DownloadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionsApi_DownloadConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadConnections provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) DownloadConnections(filter filters.V2Filter) []string {
+func (_mock *ConnectionsApi) DownloadConnections(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -206,6 +233,10 @@ func (_mock *ConnectionsApi) DownloadConnections(filter filters.V2Filter) []stri
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -213,7 +244,12 @@ func (_mock *ConnectionsApi) DownloadConnections(filter filters.V2Filter) []stri
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_DownloadConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadConnections'
@@ -240,18 +276,18 @@ func (_c *ConnectionsApi_DownloadConnections_Call) Run(run func(filter filters.V
 	return _c
 }
 
-func (_c *ConnectionsApi_DownloadConnections_Call) Return(strings []string) *ConnectionsApi_DownloadConnections_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionsApi_DownloadConnections_Call) Return(strings []string, err error) *ConnectionsApi_DownloadConnections_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_DownloadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionsApi_DownloadConnections_Call {
+func (_c *ConnectionsApi_DownloadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionsApi_DownloadConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListConnectionPermissions provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) ListConnectionPermissions(filter filters.V2Filter) []domain.ConnectionPermissionItem {
+func (_mock *ConnectionsApi) ListConnectionPermissions(filter filters.V2Filter) ([]domain.ConnectionPermissionItem, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -259,6 +295,10 @@ func (_mock *ConnectionsApi) ListConnectionPermissions(filter filters.V2Filter)
 	}
 
 	var r0 []domain.ConnectionPermissionItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]domain.ConnectionPermissionItem, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []domain.ConnectionPermissionItem); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -266,7 +306,12 @@ func (_mock *ConnectionsApi) ListConnectionPermissions(filter filters.V2Filter)
 			r0 = ret.Get(0).([]domain.ConnectionPermissionItem)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_ListConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnectionPermissions'
@@ -293,18 +338,18 @@ func (_c *ConnectionsApi_ListConnectionPermissions_Call) Run(run func(filter fil
 	return _c
 }
 
-func (_c *ConnectionsApi_ListConnectionPermissions_Call) Return(connectionPermissionItems []domain.ConnectionPermissionItem) *ConnectionsApi_ListConnectionPermissions_Call {
-	_c.Call.Return(connectionPermissionItems)
+func (_c *ConnectionsApi_ListConnectionPermissions_Call) Return(connectionPermissionItems []domain.ConnectionPermissionItem, err error) *ConnectionsApi_ListConnectionPermissions_Call {
+	_c.Call.Return(connectionPermissionItems, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_ListConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []domain.ConnectionPermissionItem) *ConnectionsApi_ListConnectionPermissions_Call {
+func (_c *ConnectionsApi_ListConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]domain.ConnectionPermissionItem, error)) *ConnectionsApi_ListConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListConnections provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) ListConnections(filter filters.V2Filter) []models.DataSourceListItemDTO {
+func (_mock *ConnectionsApi) ListConnections(filter filters.V2Filter) ([]models.DataSourceListItemDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -312,6 +357,10 @@ func (_mock *ConnectionsApi) ListConnections(filter filters.V2Filter) []models.D
 	}
 
 	var r0 []models.DataSourceListItemDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]models.DataSourceListItemDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []models.DataSourceListItemDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -319,7 +368,12 @@ func (_mock *ConnectionsApi) ListConnections(filter filters.V2Filter) []models.D
 			r0 = ret.Get(0).([]models.DataSourceListItemDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_ListConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnections'
@@ -346,18 +400,18 @@ func (_c *ConnectionsApi_ListConnections_Call) Run(run func(filter filters.V2Fil
 	return _c
 }
 
-func (_c *ConnectionsApi_ListConnections_Call) Return(dataSourceListItemDTOs []models.DataSourceListItemDTO) *ConnectionsApi_ListConnections_Call {
-	_c.Call.Return(dataSourceListItemDTOs)
+func (_c *ConnectionsApi_ListConnections_Call) Return(dataSourceListItemDTOs []models.DataSourceListItemDTO, err error) *ConnectionsApi_ListConnections_Call {
+	_c.Call.Return(dataSourceListItemDTOs, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_ListConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []models.DataSourceListItemDTO) *ConnectionsApi_ListConnections_Call {
+func (_c *ConnectionsApi_ListConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]models.DataSourceListItemDTO, error)) *ConnectionsApi_ListConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadConnectionPermissions provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) UploadConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *ConnectionsApi) UploadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -365,6 +419,10 @@ func (_mock *ConnectionsApi) UploadConnectionPermissions(filter filters.V2Filter
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -372,7 +430,12 @@ func (_mock *ConnectionsApi) UploadConnectionPermissions(filter filters.V2Filter
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_UploadConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadConnectionPermissions'
@@ -399,18 +462,18 @@ func (_c *ConnectionsApi_UploadConnectionPermissions_Call) Run(run func(filter f
 	return _c
 }
 
-func (_c *ConnectionsApi_UploadConnectionPermissions_Call) Return(strings []string) *ConnectionsApi_UploadConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionsApi_UploadConnectionPermissions_Call) Return(strings []string, err error) *ConnectionsApi_UploadConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_UploadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionsApi_UploadConnectionPermissions_Call {
+func (_c *ConnectionsApi_UploadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionsApi_UploadConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadConnections provides a mock function for the type ConnectionsApi
-func (_mock *ConnectionsApi) UploadConnections(filter filters.V2Filter) []string {
+func (_mock *ConnectionsApi) UploadConnections(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -418,6 +481,10 @@ func (_mock *ConnectionsApi) UploadConnections(filter filters.V2Filter) []string
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -425,7 +492,12 @@ func (_mock *ConnectionsApi) UploadConnections(filter filters.V2Filter) []string
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ConnectionsApi_UploadConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadConnections'
@@ -452,12 +524,12 @@ func (_c *ConnectionsApi_UploadConnections_Call) Run(run func(filter filters.V2F
 	return _c
 }
 
-func (_c *ConnectionsApi_UploadConnections_Call) Return(strings []string) *ConnectionsApi_UploadConnections_Call {
-	_c.Call.Return(strings)
+func (_c *ConnectionsApi_UploadConnections_Call) Return(strings []string, err error) *ConnectionsApi_UploadConnections_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ConnectionsApi_UploadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *ConnectionsApi_UploadConnections_Call {
+func (_c *ConnectionsApi_UploadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *ConnectionsApi_UploadConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
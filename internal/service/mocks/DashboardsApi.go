@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,7 +38,7 @@ func (_m *DashboardsApi) EXPECT() *DashboardsApi_Expecter {
 }
 
 // DeleteAllDashboards provides a mock function for the type DashboardsApi
-func (_mock *DashboardsApi) DeleteAllDashboards(filter filters.V2Filter) []string {
+func (_mock *DashboardsApi) DeleteAllDashboards(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -46,6 +46,10 @@ func (_mock *DashboardsApi) DeleteAllDashboards(filter filters.V2Filter) []strin
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -53,7 +57,12 @@ func (_mock *DashboardsApi) DeleteAllDashboards(filter filters.V2Filter) []strin
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // DashboardsApi_DeleteAllDashboards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllDashboards'
@@ -80,18 +89,18 @@ func (_c *DashboardsApi_DeleteAllDashboards_Call) Run(run func(filter filters.V2
 	return _c
 }
 
-func (_c *DashboardsApi_DeleteAllDashboards_Call) Return(strings []string) *DashboardsApi_DeleteAllDashboards_Call {
-	_c.Call.Return(strings)
+func (_c *DashboardsApi_DeleteAllDashboards_Call) Return(strings []string, err error) *DashboardsApi_DeleteAllDashboards_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *DashboardsApi_DeleteAllDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *DashboardsApi_DeleteAllDashboards_Call {
+func (_c *DashboardsApi_DeleteAllDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *DashboardsApi_DeleteAllDashboards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadDashboards provides a mock function for the type DashboardsApi
-func (_mock *DashboardsApi) DownloadDashboards(filter filters.V2Filter) []string {
+func (_mock *DashboardsApi) DownloadDashboards(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -99,6 +108,10 @@ func (_mock *DashboardsApi) DownloadDashboards(filter filters.V2Filter) []string
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -106,7 +119,12 @@ func (_mock *DashboardsApi) DownloadDashboards(filter filters.V2Filter) []string
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // DashboardsApi_DownloadDashboards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadDashboards'
@@ -133,18 +151,18 @@ func (_c *DashboardsApi_DownloadDashboards_Call) Run(run func(filter filters.V2F
 	return _c
 }
 
-func (_c *DashboardsApi_DownloadDashboards_Call) Return(strings []string) *DashboardsApi_DownloadDashboards_Call {
-	_c.Call.Return(strings)
+func (_c *DashboardsApi_DownloadDashboards_Call) Return(strings []string, err error) *DashboardsApi_DownloadDashboards_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *DashboardsApi_DownloadDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *DashboardsApi_DownloadDashboards_Call {
+func (_c *DashboardsApi_DownloadDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *DashboardsApi_DownloadDashboards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListDashboards provides a mock function for the type DashboardsApi
-func (_mock *DashboardsApi) ListDashboards(filter filters.V2Filter) []*domain.NestedHit {
+func (_mock *DashboardsApi) ListDashboards(filter filters.V2Filter) ([]*domain.NestedHit, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -152,6 +170,10 @@ func (_mock *DashboardsApi) ListDashboards(filter filters.V2Filter) []*domain.Ne
 	}
 
 	var r0 []*domain.NestedHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.NestedHit, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.NestedHit); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -159,7 +181,12 @@ func (_mock *DashboardsApi) ListDashboards(filter filters.V2Filter) []*domain.Ne
 			r0 = ret.Get(0).([]*domain.NestedHit)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // DashboardsApi_ListDashboards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDashboards'
@@ -186,12 +213,12 @@ func (_c *DashboardsApi_ListDashboards_Call) Run(run func(filter filters.V2Filte
 	return _c
 }
 
-func (_c *DashboardsApi_ListDashboards_Call) Return(nestedHits []*domain.NestedHit) *DashboardsApi_ListDashboards_Call {
-	_c.Call.Return(nestedHits)
+func (_c *DashboardsApi_ListDashboards_Call) Return(nestedHits []*domain.NestedHit, err error) *DashboardsApi_ListDashboards_Call {
+	_c.Call.Return(nestedHits, err)
 	return _c
 }
 
-func (_c *DashboardsApi_ListDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) []*domain.NestedHit) *DashboardsApi_ListDashboards_Call {
+func (_c *DashboardsApi_ListDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.NestedHit, error)) *DashboardsApi_ListDashboards_Call {
 	_c.Call.Return(run)
 	return _c
 }
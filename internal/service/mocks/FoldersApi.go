@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,7 +39,7 @@ func (_m *FoldersApi) EXPECT() *FoldersApi_Expecter {
 }
 
 // DeleteAllFolders provides a mock function for the type FoldersApi
-func (_mock *FoldersApi) DeleteAllFolders(filter filters.V2Filter) []string {
+func (_mock *FoldersApi) DeleteAllFolders(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -47,6 +47,10 @@ func (_mock *FoldersApi) DeleteAllFolders(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -54,7 +58,12 @@ func (_mock *FoldersApi) DeleteAllFolders(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // FoldersApi_DeleteAllFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllFolders'
@@ -81,18 +90,18 @@ func (_c *FoldersApi_DeleteAllFolders_Call) Run(run func(filter filters.V2Filter
 	return _c
 }
 
-func (_c *FoldersApi_DeleteAllFolders_Call) Return(strings []string) *FoldersApi_DeleteAllFolders_Call {
-	_c.Call.Return(strings)
+func (_c *FoldersApi_DeleteAllFolders_Call) Return(strings []string, err error) *FoldersApi_DeleteAllFolders_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *FoldersApi_DeleteAllFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *FoldersApi_DeleteAllFolders_Call {
+func (_c *FoldersApi_DeleteAllFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *FoldersApi_DeleteAllFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadFolderPermissions provides a mock function for the type FoldersApi
-func (_mock *FoldersApi) DownloadFolderPermissions(filter filters.V2Filter) []string {
+func (_mock *FoldersApi) DownloadFolderPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -100,6 +109,10 @@ func (_mock *FoldersApi) DownloadFolderPermissions(filter filters.V2Filter) []st
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -107,7 +120,12 @@ func (_mock *FoldersApi) DownloadFolderPermissions(filter filters.V2Filter) []st
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // FoldersApi_DownloadFolderPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFolderPermissions'
@@ -134,18 +152,18 @@ func (_c *FoldersApi_DownloadFolderPermissions_Call) Run(run func(filter filters
 	return _c
 }
 
-func (_c *FoldersApi_DownloadFolderPermissions_Call) Return(strings []string) *FoldersApi_DownloadFolderPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *FoldersApi_DownloadFolderPermissions_Call) Return(strings []string, err error) *FoldersApi_DownloadFolderPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *FoldersApi_DownloadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *FoldersApi_DownloadFolderPermissions_Call {
+func (_c *FoldersApi_DownloadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *FoldersApi_DownloadFolderPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadFolders provides a mock function for the type FoldersApi
-func (_mock *FoldersApi) DownloadFolders(filter filters.V2Filter) []string {
+func (_mock *FoldersApi) DownloadFolders(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -153,6 +171,10 @@ func (_mock *FoldersApi) DownloadFolders(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -160,7 +182,12 @@ func (_mock *FoldersApi) DownloadFolders(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // FoldersApi_DownloadFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFolders'
@@ -187,18 +214,18 @@ func (_c *FoldersApi_DownloadFolders_Call) Run(run func(filter filters.V2Filter)
 	return _c
 }
 
-func (_c *FoldersApi_DownloadFolders_Call) Return(strings []string) *FoldersApi_DownloadFolders_Call {
-	_c.Call.Return(strings)
+func (_c *FoldersApi_DownloadFolders_Call) Return(strings []string, err error) *FoldersApi_DownloadFolders_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *FoldersApi_DownloadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *FoldersApi_DownloadFolders_Call {
+func (_c *FoldersApi_DownloadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *FoldersApi_DownloadFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListFolderPermissions provides a mock function for the type FoldersApi
-func (_mock *FoldersApi) ListFolderPermissions(filter filters.V2Filter) map[*domain.NestedHit][]*models.DashboardACLInfoDTO {
+func (_mock *FoldersApi) ListFolderPermissions(filter filters.V2Filter) (map[*domain.NestedHit][]*models.DashboardACLInfoDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -206,6 +233,10 @@ func (_mock *FoldersApi) ListFolderPermissions(filter filters.V2Filter) map[*dom
 	}
 
 	var r0 map[*domain.NestedHit][]*models.DashboardACLInfoDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*domain.NestedHit][]*models.DashboardACLInfoDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*domain.NestedHit][]*models.DashboardACLInfoDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -213,7 +244,12 @@ func (_mock *FoldersApi) ListFolderPermissions(filter filters.V2Filter) map[*dom
 			r0 = ret.Get(0).(map[*domain.NestedHit][]*models.DashboardACLInfoDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // FoldersApi_ListFolderPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFolderPermissions'
@@ -240,18 +276,18 @@ func (_c *FoldersApi_ListFolderPermissions_Call) Run(run func(filter filters.V2F
 	return _c
 }
 
-func (_c *FoldersApi_ListFolderPermissions_Call) Return(nestedHitToDashboardACLInfoDTOs map[*domain.NestedHit][]*models.DashboardACLInfoDTO) *FoldersApi_ListFolderPermissions_Call {
-	_c.Call.Return(nestedHitToDashboardACLInfoDTOs)
+func (_c *FoldersApi_ListFolderPermissions_Call) Return(nestedHitToDashboardACLInfoDTOs map[*domain.NestedHit][]*models.DashboardACLInfoDTO, err error) *FoldersApi_ListFolderPermissions_Call {
+	_c.Call.Return(nestedHitToDashboardACLInfoDTOs, err)
 	return _c
 }
 
-func (_c *FoldersApi_ListFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) map[*domain.NestedHit][]*models.DashboardACLInfoDTO) *FoldersApi_ListFolderPermissions_Call {
+func (_c *FoldersApi_ListFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*domain.NestedHit][]*models.DashboardACLInfoDTO, error)) *FoldersApi_ListFolderPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListFolders provides a mock function for the type FoldersApi
-func (_mock *FoldersApi) ListFolders(filter filters.V2Filter) []*domain.NestedHit {
+func (_mock *FoldersApi) ListFolders(filter filters.V2Filter) ([]*domain.NestedHit, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -259,6 +295,10 @@ func (_mock *FoldersApi) ListFolders(filter filters.V2Filter) []*domain.NestedHi
 	}
 
 	var r0 []*domain.NestedHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.NestedHit, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.NestedHit); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -266,7 +306,12 @@ func (_mock *FoldersApi) ListFolders(filter filters.V2Filter) []*domain.NestedHi
 			r0 = ret.Get(0).([]*domain.NestedHit)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // FoldersApi_ListFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFolders'
@@ -293,18 +338,18 @@ func (_c *FoldersApi_ListFolders_Call) Run(run func(filter filters.V2Filter)) *F
 	return _c
 }
 
-func (_c *FoldersApi_ListFolders_Call) Return(nestedHits []*domain.NestedHit) *FoldersApi_ListFolders_Call {
-	_c.Call.Return(nestedHits)
+func (_c *FoldersApi_ListFolders_Call) Return(nestedHits []*domain.NestedHit, err error) *FoldersApi_ListFolders_Call {
+	_c.Call.Return(nestedHits, err)
 	return _c
 }
 
-func (_c *FoldersApi_ListFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []*domain.NestedHit) *FoldersApi_ListFolders_Call {
+func (_c *FoldersApi_ListFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.NestedHit, error)) *FoldersApi_ListFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadFolderPermissions provides a mock function for the type FoldersApi
-func (_mock *FoldersApi) UploadFolderPermissions(filter filters.V2Filter) []string {
+func (_mock *FoldersApi) UploadFolderPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -312,6 +357,10 @@ func (_mock *FoldersApi) UploadFolderPermissions(filter filters.V2Filter) []stri
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -319,7 +368,12 @@ func (_mock *FoldersApi) UploadFolderPermissions(filter filters.V2Filter) []stri
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // FoldersApi_UploadFolderPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFolderPermissions'
@@ -346,18 +400,18 @@ func (_c *FoldersApi_UploadFolderPermissions_Call) Run(run func(filter filters.V
 	return _c
 }
 
-func (_c *FoldersApi_UploadFolderPermissions_Call) Return(strings []string) *FoldersApi_UploadFolderPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *FoldersApi_UploadFolderPermissions_Call) Return(strings []string, err error) *FoldersApi_UploadFolderPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *FoldersApi_UploadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *FoldersApi_UploadFolderPermissions_Call {
+func (_c *FoldersApi_UploadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *FoldersApi_UploadFolderPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadFolders provides a mock function for the type FoldersApi
-func (_mock *FoldersApi) UploadFolders(filter filters.V2Filter) []string {
+func (_mock *FoldersApi) UploadFolders(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -365,6 +419,10 @@ func (_mock *FoldersApi) UploadFolders(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -372,7 +430,12 @@ func (_mock *FoldersApi) UploadFolders(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // FoldersApi_UploadFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFolders'
@@ -399,12 +462,12 @@ func (_c *FoldersApi_UploadFolders_Call) Run(run func(filter filters.V2Filter))
 	return _c
 }
 
-func (_c *FoldersApi_UploadFolders_Call) Return(strings []string) *FoldersApi_UploadFolders_Call {
-	_c.Call.Return(strings)
+func (_c *FoldersApi_UploadFolders_Call) Return(strings []string, err error) *FoldersApi_UploadFolders_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *FoldersApi_UploadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *FoldersApi_UploadFolders_Call {
+func (_c *FoldersApi_UploadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *FoldersApi_UploadFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,10 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/internal/service"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -412,6 +414,80 @@ func (_c *GrafanaService_ClearDashboardPermissions_Call) RunAndReturn(run func(f
 	return _c
 }
 
+// CopyStorage provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) CopyStorage(from string, to string, resume bool) ([]storage.CopyResult, error) {
+	ret := _mock.Called(from, to, resume)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyStorage")
+	}
+
+	var r0 []storage.CopyResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) ([]storage.CopyResult, error)); ok {
+		return returnFunc(from, to, resume)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) []storage.CopyResult); ok {
+		r0 = returnFunc(from, to, resume)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]storage.CopyResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = returnFunc(from, to, resume)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_CopyStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyStorage'
+type GrafanaService_CopyStorage_Call struct {
+	*mock.Call
+}
+
+// CopyStorage is a helper method to define mock.On call
+//   - from string
+//   - to string
+//   - resume bool
+func (_e *GrafanaService_Expecter) CopyStorage(from interface{}, to interface{}, resume interface{}) *GrafanaService_CopyStorage_Call {
+	return &GrafanaService_CopyStorage_Call{Call: _e.mock.On("CopyStorage", from, to, resume)}
+}
+
+func (_c *GrafanaService_CopyStorage_Call) Run(run func(from string, to string, resume bool)) *GrafanaService_CopyStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_CopyStorage_Call) Return(copyResults []storage.CopyResult, err error) *GrafanaService_CopyStorage_Call {
+	_c.Call.Return(copyResults, err)
+	return _c
+}
+
+func (_c *GrafanaService_CopyStorage_Call) RunAndReturn(run func(from string, to string, resume bool) ([]storage.CopyResult, error)) *GrafanaService_CopyStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateServiceAccount provides a mock function for the type GrafanaService
 func (_mock *GrafanaService) CreateServiceAccount(name string, role string, expiration int64) (*models.ServiceAccountDTO, error) {
 	ret := _mock.Called(name, role, expiration)
@@ -561,7 +637,7 @@ func (_c *GrafanaService_CreateServiceAccountToken_Call) RunAndReturn(run func(s
 }
 
 // DecodeValue provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DecodeValue(in string) string {
+func (_mock *GrafanaService) DecodeValue(in string) (string, error) {
 	ret := _mock.Called(in)
 
 	if len(ret) == 0 {
@@ -569,12 +645,21 @@ func (_mock *GrafanaService) DecodeValue(in string) string {
 	}
 
 	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(in)
+	}
 	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
 		r0 = returnFunc(in)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DecodeValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecodeValue'
@@ -601,18 +686,18 @@ func (_c *GrafanaService_DecodeValue_Call) Run(run func(in string)) *GrafanaServ
 	return _c
 }
 
-func (_c *GrafanaService_DecodeValue_Call) Return(s string) *GrafanaService_DecodeValue_Call {
-	_c.Call.Return(s)
+func (_c *GrafanaService_DecodeValue_Call) Return(s string, err error) *GrafanaService_DecodeValue_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *GrafanaService_DecodeValue_Call) RunAndReturn(run func(in string) string) *GrafanaService_DecodeValue_Call {
+func (_c *GrafanaService_DecodeValue_Call) RunAndReturn(run func(in string) (string, error)) *GrafanaService_DecodeValue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllConnectionPermissions provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteAllConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DeleteAllConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -620,6 +705,10 @@ func (_mock *GrafanaService) DeleteAllConnectionPermissions(filter filters.V2Fil
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -627,7 +716,12 @@ func (_mock *GrafanaService) DeleteAllConnectionPermissions(filter filters.V2Fil
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteAllConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllConnectionPermissions'
@@ -654,18 +748,18 @@ func (_c *GrafanaService_DeleteAllConnectionPermissions_Call) Run(run func(filte
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllConnectionPermissions_Call) Return(strings []string) *GrafanaService_DeleteAllConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteAllConnectionPermissions_Call) Return(strings []string, err error) *GrafanaService_DeleteAllConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DeleteAllConnectionPermissions_Call {
+func (_c *GrafanaService_DeleteAllConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllConnections provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteAllConnections(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DeleteAllConnections(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -673,6 +767,10 @@ func (_mock *GrafanaService) DeleteAllConnections(filter filters.V2Filter) []str
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -680,7 +778,12 @@ func (_mock *GrafanaService) DeleteAllConnections(filter filters.V2Filter) []str
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteAllConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllConnections'
@@ -707,18 +810,18 @@ func (_c *GrafanaService_DeleteAllConnections_Call) Run(run func(filter filters.
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllConnections_Call) Return(strings []string) *GrafanaService_DeleteAllConnections_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteAllConnections_Call) Return(strings []string, err error) *GrafanaService_DeleteAllConnections_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DeleteAllConnections_Call {
+func (_c *GrafanaService_DeleteAllConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllDashboards provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteAllDashboards(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DeleteAllDashboards(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -726,6 +829,10 @@ func (_mock *GrafanaService) DeleteAllDashboards(filter filters.V2Filter) []stri
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -733,7 +840,12 @@ func (_mock *GrafanaService) DeleteAllDashboards(filter filters.V2Filter) []stri
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteAllDashboards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllDashboards'
@@ -760,18 +872,18 @@ func (_c *GrafanaService_DeleteAllDashboards_Call) Run(run func(filter filters.V
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllDashboards_Call) Return(strings []string) *GrafanaService_DeleteAllDashboards_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteAllDashboards_Call) Return(strings []string, err error) *GrafanaService_DeleteAllDashboards_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DeleteAllDashboards_Call {
+func (_c *GrafanaService_DeleteAllDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllDashboards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllFolders provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteAllFolders(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DeleteAllFolders(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -779,6 +891,10 @@ func (_mock *GrafanaService) DeleteAllFolders(filter filters.V2Filter) []string
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -786,7 +902,12 @@ func (_mock *GrafanaService) DeleteAllFolders(filter filters.V2Filter) []string
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteAllFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllFolders'
@@ -813,18 +934,18 @@ func (_c *GrafanaService_DeleteAllFolders_Call) Run(run func(filter filters.V2Fi
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllFolders_Call) Return(strings []string) *GrafanaService_DeleteAllFolders_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteAllFolders_Call) Return(strings []string, err error) *GrafanaService_DeleteAllFolders_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DeleteAllFolders_Call {
+func (_c *GrafanaService_DeleteAllFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllLibraryElements provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteAllLibraryElements(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DeleteAllLibraryElements(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -832,6 +953,10 @@ func (_mock *GrafanaService) DeleteAllLibraryElements(filter filters.V2Filter) [
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -839,7 +964,12 @@ func (_mock *GrafanaService) DeleteAllLibraryElements(filter filters.V2Filter) [
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteAllLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllLibraryElements'
@@ -866,18 +996,142 @@ func (_c *GrafanaService_DeleteAllLibraryElements_Call) Run(run func(filter filt
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllLibraryElements_Call) Return(strings []string) *GrafanaService_DeleteAllLibraryElements_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteAllLibraryElements_Call) Return(strings []string, err error) *GrafanaService_DeleteAllLibraryElements_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *GrafanaService_DeleteAllLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllLibraryElements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAllOrganizations provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) DeleteAllOrganizations(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllOrganizations")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_DeleteAllOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllOrganizations'
+type GrafanaService_DeleteAllOrganizations_Call struct {
+	*mock.Call
+}
+
+// DeleteAllOrganizations is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *GrafanaService_Expecter) DeleteAllOrganizations(filter interface{}) *GrafanaService_DeleteAllOrganizations_Call {
+	return &GrafanaService_DeleteAllOrganizations_Call{Call: _e.mock.On("DeleteAllOrganizations", filter)}
+}
+
+func (_c *GrafanaService_DeleteAllOrganizations_Call) Run(run func(filter filters.V2Filter)) *GrafanaService_DeleteAllOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_DeleteAllOrganizations_Call) Return(strings []string, err error) *GrafanaService_DeleteAllOrganizations_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *GrafanaService_DeleteAllOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAllRoles provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) DeleteAllRoles(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_DeleteAllRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllRoles'
+type GrafanaService_DeleteAllRoles_Call struct {
+	*mock.Call
+}
+
+// DeleteAllRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *GrafanaService_Expecter) DeleteAllRoles(filter interface{}) *GrafanaService_DeleteAllRoles_Call {
+	return &GrafanaService_DeleteAllRoles_Call{Call: _e.mock.On("DeleteAllRoles", filter)}
+}
+
+func (_c *GrafanaService_DeleteAllRoles_Call) Run(run func(filter filters.V2Filter)) *GrafanaService_DeleteAllRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_DeleteAllRoles_Call) Return(strings []string, err error) *GrafanaService_DeleteAllRoles_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DeleteAllLibraryElements_Call {
+func (_c *GrafanaService_DeleteAllRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllRoles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllServiceAccounts provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteAllServiceAccounts() []string {
+func (_mock *GrafanaService) DeleteAllServiceAccounts() ([]string, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -885,6 +1139,10 @@ func (_mock *GrafanaService) DeleteAllServiceAccounts() []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() []string); ok {
 		r0 = returnFunc()
 	} else {
@@ -892,7 +1150,12 @@ func (_mock *GrafanaService) DeleteAllServiceAccounts() []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteAllServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllServiceAccounts'
@@ -912,18 +1175,18 @@ func (_c *GrafanaService_DeleteAllServiceAccounts_Call) Run(run func()) *Grafana
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllServiceAccounts_Call) Return(strings []string) *GrafanaService_DeleteAllServiceAccounts_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteAllServiceAccounts_Call) Return(strings []string, err error) *GrafanaService_DeleteAllServiceAccounts_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllServiceAccounts_Call) RunAndReturn(run func() []string) *GrafanaService_DeleteAllServiceAccounts_Call {
+func (_c *GrafanaService_DeleteAllServiceAccounts_Call) RunAndReturn(run func() ([]string, error)) *GrafanaService_DeleteAllServiceAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteAllUsers provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteAllUsers(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DeleteAllUsers(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -931,6 +1194,10 @@ func (_mock *GrafanaService) DeleteAllUsers(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -938,7 +1205,12 @@ func (_mock *GrafanaService) DeleteAllUsers(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllUsers'
@@ -965,12 +1237,12 @@ func (_c *GrafanaService_DeleteAllUsers_Call) Run(run func(filter filters.V2Filt
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllUsers_Call) Return(strings []string) *GrafanaService_DeleteAllUsers_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteAllUsers_Call) Return(strings []string, err error) *GrafanaService_DeleteAllUsers_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteAllUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DeleteAllUsers_Call {
+func (_c *GrafanaService_DeleteAllUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DeleteAllUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1027,7 +1299,7 @@ func (_c *GrafanaService_DeleteServiceAccount_Call) RunAndReturn(run func(accoun
 }
 
 // DeleteServiceAccountTokens provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DeleteServiceAccountTokens(serviceId int64) []string {
+func (_mock *GrafanaService) DeleteServiceAccountTokens(serviceId int64) ([]string, error) {
 	ret := _mock.Called(serviceId)
 
 	if len(ret) == 0 {
@@ -1035,6 +1307,10 @@ func (_mock *GrafanaService) DeleteServiceAccountTokens(serviceId int64) []strin
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]string, error)); ok {
+		return returnFunc(serviceId)
+	}
 	if returnFunc, ok := ret.Get(0).(func(int64) []string); ok {
 		r0 = returnFunc(serviceId)
 	} else {
@@ -1042,7 +1318,12 @@ func (_mock *GrafanaService) DeleteServiceAccountTokens(serviceId int64) []strin
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(serviceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DeleteServiceAccountTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccountTokens'
@@ -1069,12 +1350,12 @@ func (_c *GrafanaService_DeleteServiceAccountTokens_Call) Run(run func(serviceId
 	return _c
 }
 
-func (_c *GrafanaService_DeleteServiceAccountTokens_Call) Return(strings []string) *GrafanaService_DeleteServiceAccountTokens_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DeleteServiceAccountTokens_Call) Return(strings []string, err error) *GrafanaService_DeleteServiceAccountTokens_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DeleteServiceAccountTokens_Call) RunAndReturn(run func(serviceId int64) []string) *GrafanaService_DeleteServiceAccountTokens_Call {
+func (_c *GrafanaService_DeleteServiceAccountTokens_Call) RunAndReturn(run func(serviceId int64) ([]string, error)) *GrafanaService_DeleteServiceAccountTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1198,12 +1479,67 @@ func (_c *GrafanaService_DeleteUserFromOrg_Call) RunAndReturn(run func(orgId str
 	return _c
 }
 
-// DownloadAlertNotifications provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadAlertNotifications() (string, error) {
+// DiffSSOSettings provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) DiffSSOSettings() ([]domain.SSOSettingsDiff, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for DownloadAlertNotifications")
+		panic("no return value specified for DiffSSOSettings")
+	}
+
+	var r0 []domain.SSOSettingsDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]domain.SSOSettingsDiff, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []domain.SSOSettingsDiff); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SSOSettingsDiff)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_DiffSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffSSOSettings'
+type GrafanaService_DiffSSOSettings_Call struct {
+	*mock.Call
+}
+
+// DiffSSOSettings is a helper method to define mock.On call
+func (_e *GrafanaService_Expecter) DiffSSOSettings() *GrafanaService_DiffSSOSettings_Call {
+	return &GrafanaService_DiffSSOSettings_Call{Call: _e.mock.On("DiffSSOSettings")}
+}
+
+func (_c *GrafanaService_DiffSSOSettings_Call) Run(run func()) *GrafanaService_DiffSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GrafanaService_DiffSSOSettings_Call) Return(sSOSettingsDiffs []domain.SSOSettingsDiff, err error) *GrafanaService_DiffSSOSettings_Call {
+	_c.Call.Return(sSOSettingsDiffs, err)
+	return _c
+}
+
+func (_c *GrafanaService_DiffSSOSettings_Call) RunAndReturn(run func() ([]domain.SSOSettingsDiff, error)) *GrafanaService_DiffSSOSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadAlertNotifications provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) DownloadAlertNotifications() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadAlertNotifications")
 	}
 
 	var r0 string
@@ -1420,7 +1756,7 @@ func (_c *GrafanaService_DownloadAlertTimings_Call) RunAndReturn(run func() (str
 }
 
 // DownloadConnectionPermissions provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1428,6 +1764,10 @@ func (_mock *GrafanaService) DownloadConnectionPermissions(filter filters.V2Filt
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1435,7 +1775,12 @@ func (_mock *GrafanaService) DownloadConnectionPermissions(filter filters.V2Filt
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadConnectionPermissions'
@@ -1462,18 +1807,18 @@ func (_c *GrafanaService_DownloadConnectionPermissions_Call) Run(run func(filter
 	return _c
 }
 
-func (_c *GrafanaService_DownloadConnectionPermissions_Call) Return(strings []string) *GrafanaService_DownloadConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadConnectionPermissions_Call) Return(strings []string, err error) *GrafanaService_DownloadConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadConnectionPermissions_Call {
+func (_c *GrafanaService_DownloadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadConnections provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadConnections(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadConnections(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1481,6 +1826,10 @@ func (_mock *GrafanaService) DownloadConnections(filter filters.V2Filter) []stri
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1488,7 +1837,12 @@ func (_mock *GrafanaService) DownloadConnections(filter filters.V2Filter) []stri
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadConnections'
@@ -1515,12 +1869,12 @@ func (_c *GrafanaService_DownloadConnections_Call) Run(run func(filter filters.V
 	return _c
 }
 
-func (_c *GrafanaService_DownloadConnections_Call) Return(strings []string) *GrafanaService_DownloadConnections_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadConnections_Call) Return(strings []string, err error) *GrafanaService_DownloadConnections_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadConnections_Call {
+func (_c *GrafanaService_DownloadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1641,7 +1995,7 @@ func (_c *GrafanaService_DownloadDashboardPermissions_Call) RunAndReturn(run fun
 }
 
 // DownloadDashboards provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadDashboards(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadDashboards(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1649,6 +2003,10 @@ func (_mock *GrafanaService) DownloadDashboards(filter filters.V2Filter) []strin
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1656,7 +2014,12 @@ func (_mock *GrafanaService) DownloadDashboards(filter filters.V2Filter) []strin
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadDashboards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadDashboards'
@@ -1683,18 +2046,18 @@ func (_c *GrafanaService_DownloadDashboards_Call) Run(run func(filter filters.V2
 	return _c
 }
 
-func (_c *GrafanaService_DownloadDashboards_Call) Return(strings []string) *GrafanaService_DownloadDashboards_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadDashboards_Call) Return(strings []string, err error) *GrafanaService_DownloadDashboards_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadDashboards_Call {
+func (_c *GrafanaService_DownloadDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadDashboards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadFolderPermissions provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadFolderPermissions(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadFolderPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1702,6 +2065,10 @@ func (_mock *GrafanaService) DownloadFolderPermissions(filter filters.V2Filter)
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1709,7 +2076,12 @@ func (_mock *GrafanaService) DownloadFolderPermissions(filter filters.V2Filter)
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadFolderPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFolderPermissions'
@@ -1736,18 +2108,18 @@ func (_c *GrafanaService_DownloadFolderPermissions_Call) Run(run func(filter fil
 	return _c
 }
 
-func (_c *GrafanaService_DownloadFolderPermissions_Call) Return(strings []string) *GrafanaService_DownloadFolderPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadFolderPermissions_Call) Return(strings []string, err error) *GrafanaService_DownloadFolderPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadFolderPermissions_Call {
+func (_c *GrafanaService_DownloadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadFolderPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadFolders provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadFolders(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadFolders(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1755,6 +2127,10 @@ func (_mock *GrafanaService) DownloadFolders(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1762,7 +2138,12 @@ func (_mock *GrafanaService) DownloadFolders(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadFolders'
@@ -1789,18 +2170,18 @@ func (_c *GrafanaService_DownloadFolders_Call) Run(run func(filter filters.V2Fil
 	return _c
 }
 
-func (_c *GrafanaService_DownloadFolders_Call) Return(strings []string) *GrafanaService_DownloadFolders_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadFolders_Call) Return(strings []string, err error) *GrafanaService_DownloadFolders_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadFolders_Call {
+func (_c *GrafanaService_DownloadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadLibraryElements provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadLibraryElements(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadLibraryElements(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1808,6 +2189,10 @@ func (_mock *GrafanaService) DownloadLibraryElements(filter filters.V2Filter) []
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1815,7 +2200,12 @@ func (_mock *GrafanaService) DownloadLibraryElements(filter filters.V2Filter) []
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadLibraryElements'
@@ -1842,18 +2232,18 @@ func (_c *GrafanaService_DownloadLibraryElements_Call) Run(run func(filter filte
 	return _c
 }
 
-func (_c *GrafanaService_DownloadLibraryElements_Call) Return(strings []string) *GrafanaService_DownloadLibraryElements_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadLibraryElements_Call) Return(strings []string, err error) *GrafanaService_DownloadLibraryElements_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadLibraryElements_Call {
+func (_c *GrafanaService_DownloadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadLibraryElements_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadOrganizations provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadOrganizations(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadOrganizations(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1861,6 +2251,10 @@ func (_mock *GrafanaService) DownloadOrganizations(filter filters.V2Filter) []st
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1868,7 +2262,12 @@ func (_mock *GrafanaService) DownloadOrganizations(filter filters.V2Filter) []st
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadOrganizations'
@@ -1895,18 +2294,135 @@ func (_c *GrafanaService_DownloadOrganizations_Call) Run(run func(filter filters
 	return _c
 }
 
-func (_c *GrafanaService_DownloadOrganizations_Call) Return(strings []string) *GrafanaService_DownloadOrganizations_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadOrganizations_Call) Return(strings []string, err error) *GrafanaService_DownloadOrganizations_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *GrafanaService_DownloadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadRoles provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) DownloadRoles(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_DownloadRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadRoles'
+type GrafanaService_DownloadRoles_Call struct {
+	*mock.Call
+}
+
+// DownloadRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *GrafanaService_Expecter) DownloadRoles(filter interface{}) *GrafanaService_DownloadRoles_Call {
+	return &GrafanaService_DownloadRoles_Call{Call: _e.mock.On("DownloadRoles", filter)}
+}
+
+func (_c *GrafanaService_DownloadRoles_Call) Run(run func(filter filters.V2Filter)) *GrafanaService_DownloadRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_DownloadRoles_Call) Return(strings []string, err error) *GrafanaService_DownloadRoles_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *GrafanaService_DownloadRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadSSOSettings provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) DownloadSSOSettings() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadSSOSettings")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_DownloadSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadSSOSettings'
+type GrafanaService_DownloadSSOSettings_Call struct {
+	*mock.Call
+}
+
+// DownloadSSOSettings is a helper method to define mock.On call
+func (_e *GrafanaService_Expecter) DownloadSSOSettings() *GrafanaService_DownloadSSOSettings_Call {
+	return &GrafanaService_DownloadSSOSettings_Call{Call: _e.mock.On("DownloadSSOSettings")}
+}
+
+func (_c *GrafanaService_DownloadSSOSettings_Call) Run(run func()) *GrafanaService_DownloadSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GrafanaService_DownloadSSOSettings_Call) Return(strings []string, err error) *GrafanaService_DownloadSSOSettings_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadOrganizations_Call {
+func (_c *GrafanaService_DownloadSSOSettings_Call) RunAndReturn(run func() ([]string, error)) *GrafanaService_DownloadSSOSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadTeams provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (_mock *GrafanaService) DownloadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1914,6 +2430,10 @@ func (_mock *GrafanaService) DownloadTeams(filter filters.V2Filter) map[*models.
 	}
 
 	var r0 map[*models.TeamDTO][]*models.TeamMemberDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1921,7 +2441,12 @@ func (_mock *GrafanaService) DownloadTeams(filter filters.V2Filter) map[*models.
 			r0 = ret.Get(0).(map[*models.TeamDTO][]*models.TeamMemberDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadTeams'
@@ -1948,18 +2473,18 @@ func (_c *GrafanaService_DownloadTeams_Call) Run(run func(filter filters.V2Filte
 	return _c
 }
 
-func (_c *GrafanaService_DownloadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO) *GrafanaService_DownloadTeams_Call {
-	_c.Call.Return(teamDTOToTeamMemberDTOs)
+func (_c *GrafanaService_DownloadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO, err error) *GrafanaService_DownloadTeams_Call {
+	_c.Call.Return(teamDTOToTeamMemberDTOs, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO) *GrafanaService_DownloadTeams_Call {
+func (_c *GrafanaService_DownloadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)) *GrafanaService_DownloadTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadUsers provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) DownloadUsers(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) DownloadUsers(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -1967,6 +2492,10 @@ func (_mock *GrafanaService) DownloadUsers(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -1974,7 +2503,12 @@ func (_mock *GrafanaService) DownloadUsers(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_DownloadUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadUsers'
@@ -2001,18 +2535,18 @@ func (_c *GrafanaService_DownloadUsers_Call) Run(run func(filter filters.V2Filte
 	return _c
 }
 
-func (_c *GrafanaService_DownloadUsers_Call) Return(strings []string) *GrafanaService_DownloadUsers_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_DownloadUsers_Call) Return(strings []string, err error) *GrafanaService_DownloadUsers_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_DownloadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_DownloadUsers_Call {
+func (_c *GrafanaService_DownloadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_DownloadUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // EncodeValue provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) EncodeValue(in string) string {
+func (_mock *GrafanaService) EncodeValue(in string) (string, error) {
 	ret := _mock.Called(in)
 
 	if len(ret) == 0 {
@@ -2020,12 +2554,21 @@ func (_mock *GrafanaService) EncodeValue(in string) string {
 	}
 
 	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(in)
+	}
 	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
 		r0 = returnFunc(in)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_EncodeValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncodeValue'
@@ -2052,12 +2595,12 @@ func (_c *GrafanaService_EncodeValue_Call) Run(run func(in string)) *GrafanaServ
 	return _c
 }
 
-func (_c *GrafanaService_EncodeValue_Call) Return(s string) *GrafanaService_EncodeValue_Call {
-	_c.Call.Return(s)
+func (_c *GrafanaService_EncodeValue_Call) Return(s string, err error) *GrafanaService_EncodeValue_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *GrafanaService_EncodeValue_Call) RunAndReturn(run func(in string) string) *GrafanaService_EncodeValue_Call {
+func (_c *GrafanaService_EncodeValue_Call) RunAndReturn(run func(in string) (string, error)) *GrafanaService_EncodeValue_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2125,7 +2668,7 @@ func (_c *GrafanaService_GetOrgPreferences_Call) RunAndReturn(run func(orgName s
 }
 
 // GetServerInfo provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) GetServerInfo() map[string]any {
+func (_mock *GrafanaService) GetServerInfo() (map[string]any, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -2133,6 +2676,10 @@ func (_mock *GrafanaService) GetServerInfo() map[string]any {
 	}
 
 	var r0 map[string]any
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (map[string]any, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() map[string]any); ok {
 		r0 = returnFunc()
 	} else {
@@ -2140,7 +2687,12 @@ func (_mock *GrafanaService) GetServerInfo() map[string]any {
 			r0 = ret.Get(0).(map[string]any)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_GetServerInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServerInfo'
@@ -2160,18 +2712,18 @@ func (_c *GrafanaService_GetServerInfo_Call) Run(run func()) *GrafanaService_Get
 	return _c
 }
 
-func (_c *GrafanaService_GetServerInfo_Call) Return(stringToV map[string]any) *GrafanaService_GetServerInfo_Call {
-	_c.Call.Return(stringToV)
+func (_c *GrafanaService_GetServerInfo_Call) Return(stringToV map[string]any, err error) *GrafanaService_GetServerInfo_Call {
+	_c.Call.Return(stringToV, err)
 	return _c
 }
 
-func (_c *GrafanaService_GetServerInfo_Call) RunAndReturn(run func() map[string]any) *GrafanaService_GetServerInfo_Call {
+func (_c *GrafanaService_GetServerInfo_Call) RunAndReturn(run func() (map[string]any, error)) *GrafanaService_GetServerInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetTokenOrganization provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) GetTokenOrganization() *models.OrgDetailsDTO {
+func (_mock *GrafanaService) GetTokenOrganization() (*models.OrgDetailsDTO, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -2179,6 +2731,10 @@ func (_mock *GrafanaService) GetTokenOrganization() *models.OrgDetailsDTO {
 	}
 
 	var r0 *models.OrgDetailsDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*models.OrgDetailsDTO, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() *models.OrgDetailsDTO); ok {
 		r0 = returnFunc()
 	} else {
@@ -2186,7 +2742,12 @@ func (_mock *GrafanaService) GetTokenOrganization() *models.OrgDetailsDTO {
 			r0 = ret.Get(0).(*models.OrgDetailsDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_GetTokenOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenOrganization'
@@ -2206,12 +2767,12 @@ func (_c *GrafanaService_GetTokenOrganization_Call) Run(run func()) *GrafanaServ
 	return _c
 }
 
-func (_c *GrafanaService_GetTokenOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO) *GrafanaService_GetTokenOrganization_Call {
-	_c.Call.Return(orgDetailsDTO)
+func (_c *GrafanaService_GetTokenOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO, err error) *GrafanaService_GetTokenOrganization_Call {
+	_c.Call.Return(orgDetailsDTO, err)
 	return _c
 }
 
-func (_c *GrafanaService_GetTokenOrganization_Call) RunAndReturn(run func() *models.OrgDetailsDTO) *GrafanaService_GetTokenOrganization_Call {
+func (_c *GrafanaService_GetTokenOrganization_Call) RunAndReturn(run func() (*models.OrgDetailsDTO, error)) *GrafanaService_GetTokenOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2272,7 +2833,7 @@ func (_c *GrafanaService_GetUserInfo_Call) RunAndReturn(run func() (*models.User
 }
 
 // GetUserOrganization provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) GetUserOrganization() *models.OrgDetailsDTO {
+func (_mock *GrafanaService) GetUserOrganization() (*models.OrgDetailsDTO, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -2280,6 +2841,10 @@ func (_mock *GrafanaService) GetUserOrganization() *models.OrgDetailsDTO {
 	}
 
 	var r0 *models.OrgDetailsDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*models.OrgDetailsDTO, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() *models.OrgDetailsDTO); ok {
 		r0 = returnFunc()
 	} else {
@@ -2287,7 +2852,12 @@ func (_mock *GrafanaService) GetUserOrganization() *models.OrgDetailsDTO {
 			r0 = ret.Get(0).(*models.OrgDetailsDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_GetUserOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserOrganization'
@@ -2307,20 +2877,31 @@ func (_c *GrafanaService_GetUserOrganization_Call) Run(run func()) *GrafanaServi
 	return _c
 }
 
-func (_c *GrafanaService_GetUserOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO) *GrafanaService_GetUserOrganization_Call {
-	_c.Call.Return(orgDetailsDTO)
+func (_c *GrafanaService_GetUserOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO, err error) *GrafanaService_GetUserOrganization_Call {
+	_c.Call.Return(orgDetailsDTO, err)
 	return _c
 }
 
-func (_c *GrafanaService_GetUserOrganization_Call) RunAndReturn(run func() *models.OrgDetailsDTO) *GrafanaService_GetUserOrganization_Call {
+func (_c *GrafanaService_GetUserOrganization_Call) RunAndReturn(run func() (*models.OrgDetailsDTO, error)) *GrafanaService_GetUserOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // InitOrganizations provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) InitOrganizations() {
-	_mock.Called()
-	return
+func (_mock *GrafanaService) InitOrganizations() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitOrganizations")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
 // GrafanaService_InitOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitOrganizations'
@@ -2340,18 +2921,18 @@ func (_c *GrafanaService_InitOrganizations_Call) Run(run func()) *GrafanaService
 	return _c
 }
 
-func (_c *GrafanaService_InitOrganizations_Call) Return() *GrafanaService_InitOrganizations_Call {
-	_c.Call.Return()
+func (_c *GrafanaService_InitOrganizations_Call) Return(err error) *GrafanaService_InitOrganizations_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *GrafanaService_InitOrganizations_Call) RunAndReturn(run func()) *GrafanaService_InitOrganizations_Call {
-	_c.Run(run)
+func (_c *GrafanaService_InitOrganizations_Call) RunAndReturn(run func() error) *GrafanaService_InitOrganizations_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
 // IsEnterprise provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) IsEnterprise() bool {
+func (_mock *GrafanaService) IsEnterprise() (bool, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -2359,12 +2940,21 @@ func (_mock *GrafanaService) IsEnterprise() bool {
 	}
 
 	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (bool, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() bool); ok {
 		r0 = returnFunc()
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_IsEnterprise_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEnterprise'
@@ -2384,12 +2974,12 @@ func (_c *GrafanaService_IsEnterprise_Call) Run(run func()) *GrafanaService_IsEn
 	return _c
 }
 
-func (_c *GrafanaService_IsEnterprise_Call) Return(b bool) *GrafanaService_IsEnterprise_Call {
-	_c.Call.Return(b)
+func (_c *GrafanaService_IsEnterprise_Call) Return(b bool, err error) *GrafanaService_IsEnterprise_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *GrafanaService_IsEnterprise_Call) RunAndReturn(run func() bool) *GrafanaService_IsEnterprise_Call {
+func (_c *GrafanaService_IsEnterprise_Call) RunAndReturn(run func() (bool, error)) *GrafanaService_IsEnterprise_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2622,7 +3212,7 @@ func (_c *GrafanaService_ListAlertTimings_Call) RunAndReturn(run func() ([]*mode
 }
 
 // ListConnectionPermissions provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListConnectionPermissions(filter filters.V2Filter) []domain.ConnectionPermissionItem {
+func (_mock *GrafanaService) ListConnectionPermissions(filter filters.V2Filter) ([]domain.ConnectionPermissionItem, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -2630,6 +3220,10 @@ func (_mock *GrafanaService) ListConnectionPermissions(filter filters.V2Filter)
 	}
 
 	var r0 []domain.ConnectionPermissionItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]domain.ConnectionPermissionItem, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []domain.ConnectionPermissionItem); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -2637,7 +3231,12 @@ func (_mock *GrafanaService) ListConnectionPermissions(filter filters.V2Filter)
 			r0 = ret.Get(0).([]domain.ConnectionPermissionItem)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnectionPermissions'
@@ -2664,18 +3263,18 @@ func (_c *GrafanaService_ListConnectionPermissions_Call) Run(run func(filter fil
 	return _c
 }
 
-func (_c *GrafanaService_ListConnectionPermissions_Call) Return(connectionPermissionItems []domain.ConnectionPermissionItem) *GrafanaService_ListConnectionPermissions_Call {
-	_c.Call.Return(connectionPermissionItems)
+func (_c *GrafanaService_ListConnectionPermissions_Call) Return(connectionPermissionItems []domain.ConnectionPermissionItem, err error) *GrafanaService_ListConnectionPermissions_Call {
+	_c.Call.Return(connectionPermissionItems, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []domain.ConnectionPermissionItem) *GrafanaService_ListConnectionPermissions_Call {
+func (_c *GrafanaService_ListConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]domain.ConnectionPermissionItem, error)) *GrafanaService_ListConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListConnections provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListConnections(filter filters.V2Filter) []models.DataSourceListItemDTO {
+func (_mock *GrafanaService) ListConnections(filter filters.V2Filter) ([]models.DataSourceListItemDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -2683,6 +3282,10 @@ func (_mock *GrafanaService) ListConnections(filter filters.V2Filter) []models.D
 	}
 
 	var r0 []models.DataSourceListItemDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]models.DataSourceListItemDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []models.DataSourceListItemDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -2690,7 +3293,12 @@ func (_mock *GrafanaService) ListConnections(filter filters.V2Filter) []models.D
 			r0 = ret.Get(0).([]models.DataSourceListItemDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnections'
@@ -2717,12 +3325,12 @@ func (_c *GrafanaService_ListConnections_Call) Run(run func(filter filters.V2Fil
 	return _c
 }
 
-func (_c *GrafanaService_ListConnections_Call) Return(dataSourceListItemDTOs []models.DataSourceListItemDTO) *GrafanaService_ListConnections_Call {
-	_c.Call.Return(dataSourceListItemDTOs)
+func (_c *GrafanaService_ListConnections_Call) Return(dataSourceListItemDTOs []models.DataSourceListItemDTO, err error) *GrafanaService_ListConnections_Call {
+	_c.Call.Return(dataSourceListItemDTOs, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []models.DataSourceListItemDTO) *GrafanaService_ListConnections_Call {
+func (_c *GrafanaService_ListConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]models.DataSourceListItemDTO, error)) *GrafanaService_ListConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2845,7 +3453,7 @@ func (_c *GrafanaService_ListDashboardPermissions_Call) RunAndReturn(run func(fi
 }
 
 // ListDashboards provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListDashboards(filter filters.V2Filter) []*domain.NestedHit {
+func (_mock *GrafanaService) ListDashboards(filter filters.V2Filter) ([]*domain.NestedHit, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -2853,6 +3461,10 @@ func (_mock *GrafanaService) ListDashboards(filter filters.V2Filter) []*domain.N
 	}
 
 	var r0 []*domain.NestedHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.NestedHit, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.NestedHit); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -2860,7 +3472,12 @@ func (_mock *GrafanaService) ListDashboards(filter filters.V2Filter) []*domain.N
 			r0 = ret.Get(0).([]*domain.NestedHit)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListDashboards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDashboards'
@@ -2887,18 +3504,18 @@ func (_c *GrafanaService_ListDashboards_Call) Run(run func(filter filters.V2Filt
 	return _c
 }
 
-func (_c *GrafanaService_ListDashboards_Call) Return(nestedHits []*domain.NestedHit) *GrafanaService_ListDashboards_Call {
-	_c.Call.Return(nestedHits)
+func (_c *GrafanaService_ListDashboards_Call) Return(nestedHits []*domain.NestedHit, err error) *GrafanaService_ListDashboards_Call {
+	_c.Call.Return(nestedHits, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) []*domain.NestedHit) *GrafanaService_ListDashboards_Call {
+func (_c *GrafanaService_ListDashboards_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.NestedHit, error)) *GrafanaService_ListDashboards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListFolderPermissions provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListFolderPermissions(filter filters.V2Filter) map[*domain.NestedHit][]*models.DashboardACLInfoDTO {
+func (_mock *GrafanaService) ListFolderPermissions(filter filters.V2Filter) (map[*domain.NestedHit][]*models.DashboardACLInfoDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -2906,6 +3523,10 @@ func (_mock *GrafanaService) ListFolderPermissions(filter filters.V2Filter) map[
 	}
 
 	var r0 map[*domain.NestedHit][]*models.DashboardACLInfoDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*domain.NestedHit][]*models.DashboardACLInfoDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*domain.NestedHit][]*models.DashboardACLInfoDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -2913,7 +3534,12 @@ func (_mock *GrafanaService) ListFolderPermissions(filter filters.V2Filter) map[
 			r0 = ret.Get(0).(map[*domain.NestedHit][]*models.DashboardACLInfoDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListFolderPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFolderPermissions'
@@ -2940,18 +3566,18 @@ func (_c *GrafanaService_ListFolderPermissions_Call) Run(run func(filter filters
 	return _c
 }
 
-func (_c *GrafanaService_ListFolderPermissions_Call) Return(nestedHitToDashboardACLInfoDTOs map[*domain.NestedHit][]*models.DashboardACLInfoDTO) *GrafanaService_ListFolderPermissions_Call {
-	_c.Call.Return(nestedHitToDashboardACLInfoDTOs)
+func (_c *GrafanaService_ListFolderPermissions_Call) Return(nestedHitToDashboardACLInfoDTOs map[*domain.NestedHit][]*models.DashboardACLInfoDTO, err error) *GrafanaService_ListFolderPermissions_Call {
+	_c.Call.Return(nestedHitToDashboardACLInfoDTOs, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) map[*domain.NestedHit][]*models.DashboardACLInfoDTO) *GrafanaService_ListFolderPermissions_Call {
+func (_c *GrafanaService_ListFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*domain.NestedHit][]*models.DashboardACLInfoDTO, error)) *GrafanaService_ListFolderPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListFolders provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListFolders(filter filters.V2Filter) []*domain.NestedHit {
+func (_mock *GrafanaService) ListFolders(filter filters.V2Filter) ([]*domain.NestedHit, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -2959,6 +3585,10 @@ func (_mock *GrafanaService) ListFolders(filter filters.V2Filter) []*domain.Nest
 	}
 
 	var r0 []*domain.NestedHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.NestedHit, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.NestedHit); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -2966,7 +3596,12 @@ func (_mock *GrafanaService) ListFolders(filter filters.V2Filter) []*domain.Nest
 			r0 = ret.Get(0).([]*domain.NestedHit)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFolders'
@@ -2993,18 +3628,18 @@ func (_c *GrafanaService_ListFolders_Call) Run(run func(filter filters.V2Filter)
 	return _c
 }
 
-func (_c *GrafanaService_ListFolders_Call) Return(nestedHits []*domain.NestedHit) *GrafanaService_ListFolders_Call {
-	_c.Call.Return(nestedHits)
+func (_c *GrafanaService_ListFolders_Call) Return(nestedHits []*domain.NestedHit, err error) *GrafanaService_ListFolders_Call {
+	_c.Call.Return(nestedHits, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []*domain.NestedHit) *GrafanaService_ListFolders_Call {
+func (_c *GrafanaService_ListFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.NestedHit, error)) *GrafanaService_ListFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListLibraryElements provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListLibraryElements(filter filters.V2Filter) []*domain.WithNested[models.LibraryElementDTO] {
+func (_mock *GrafanaService) ListLibraryElements(filter filters.V2Filter) ([]*domain.WithNested[models.LibraryElementDTO], error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -3012,6 +3647,10 @@ func (_mock *GrafanaService) ListLibraryElements(filter filters.V2Filter) []*dom
 	}
 
 	var r0 []*domain.WithNested[models.LibraryElementDTO]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.WithNested[models.LibraryElementDTO], error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.WithNested[models.LibraryElementDTO]); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -3019,7 +3658,12 @@ func (_mock *GrafanaService) ListLibraryElements(filter filters.V2Filter) []*dom
 			r0 = ret.Get(0).([]*domain.WithNested[models.LibraryElementDTO])
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLibraryElements'
@@ -3046,18 +3690,18 @@ func (_c *GrafanaService_ListLibraryElements_Call) Run(run func(filter filters.V
 	return _c
 }
 
-func (_c *GrafanaService_ListLibraryElements_Call) Return(withNesteds []*domain.WithNested[models.LibraryElementDTO]) *GrafanaService_ListLibraryElements_Call {
-	_c.Call.Return(withNesteds)
+func (_c *GrafanaService_ListLibraryElements_Call) Return(withNesteds []*domain.WithNested[models.LibraryElementDTO], err error) *GrafanaService_ListLibraryElements_Call {
+	_c.Call.Return(withNesteds, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []*domain.WithNested[models.LibraryElementDTO]) *GrafanaService_ListLibraryElements_Call {
+func (_c *GrafanaService_ListLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.WithNested[models.LibraryElementDTO], error)) *GrafanaService_ListLibraryElements_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListLibraryElementsConnections provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) []*models.DashboardFullWithMeta {
+func (_mock *GrafanaService) ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) ([]*models.DashboardFullWithMeta, error) {
 	ret := _mock.Called(filter, connectionID)
 
 	if len(ret) == 0 {
@@ -3065,6 +3709,10 @@ func (_mock *GrafanaService) ListLibraryElementsConnections(filter filters.V2Fil
 	}
 
 	var r0 []*models.DashboardFullWithMeta
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, string) ([]*models.DashboardFullWithMeta, error)); ok {
+		return returnFunc(filter, connectionID)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, string) []*models.DashboardFullWithMeta); ok {
 		r0 = returnFunc(filter, connectionID)
 	} else {
@@ -3072,7 +3720,12 @@ func (_mock *GrafanaService) ListLibraryElementsConnections(filter filters.V2Fil
 			r0 = ret.Get(0).([]*models.DashboardFullWithMeta)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, string) error); ok {
+		r1 = returnFunc(filter, connectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListLibraryElementsConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLibraryElementsConnections'
@@ -3105,18 +3758,18 @@ func (_c *GrafanaService_ListLibraryElementsConnections_Call) Run(run func(filte
 	return _c
 }
 
-func (_c *GrafanaService_ListLibraryElementsConnections_Call) Return(dashboardFullWithMetas []*models.DashboardFullWithMeta) *GrafanaService_ListLibraryElementsConnections_Call {
-	_c.Call.Return(dashboardFullWithMetas)
+func (_c *GrafanaService_ListLibraryElementsConnections_Call) Return(dashboardFullWithMetas []*models.DashboardFullWithMeta, err error) *GrafanaService_ListLibraryElementsConnections_Call {
+	_c.Call.Return(dashboardFullWithMetas, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListLibraryElementsConnections_Call) RunAndReturn(run func(filter filters.V2Filter, connectionID string) []*models.DashboardFullWithMeta) *GrafanaService_ListLibraryElementsConnections_Call {
+func (_c *GrafanaService_ListLibraryElementsConnections_Call) RunAndReturn(run func(filter filters.V2Filter, connectionID string) ([]*models.DashboardFullWithMeta, error)) *GrafanaService_ListLibraryElementsConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListOrgUsers provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
+func (_mock *GrafanaService) ListOrgUsers(orgId int64) ([]*models.OrgUserDTO, error) {
 	ret := _mock.Called(orgId)
 
 	if len(ret) == 0 {
@@ -3124,6 +3777,10 @@ func (_mock *GrafanaService) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
 	}
 
 	var r0 []*models.OrgUserDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]*models.OrgUserDTO, error)); ok {
+		return returnFunc(orgId)
+	}
 	if returnFunc, ok := ret.Get(0).(func(int64) []*models.OrgUserDTO); ok {
 		r0 = returnFunc(orgId)
 	} else {
@@ -3131,7 +3788,12 @@ func (_mock *GrafanaService) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
 			r0 = ret.Get(0).([]*models.OrgUserDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListOrgUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrgUsers'
@@ -3158,18 +3820,18 @@ func (_c *GrafanaService_ListOrgUsers_Call) Run(run func(orgId int64)) *GrafanaS
 	return _c
 }
 
-func (_c *GrafanaService_ListOrgUsers_Call) Return(orgUserDTOs []*models.OrgUserDTO) *GrafanaService_ListOrgUsers_Call {
-	_c.Call.Return(orgUserDTOs)
+func (_c *GrafanaService_ListOrgUsers_Call) Return(orgUserDTOs []*models.OrgUserDTO, err error) *GrafanaService_ListOrgUsers_Call {
+	_c.Call.Return(orgUserDTOs, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListOrgUsers_Call) RunAndReturn(run func(orgId int64) []*models.OrgUserDTO) *GrafanaService_ListOrgUsers_Call {
+func (_c *GrafanaService_ListOrgUsers_Call) RunAndReturn(run func(orgId int64) ([]*models.OrgUserDTO, error)) *GrafanaService_ListOrgUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListOrganizations provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListOrganizations(filter filters.V2Filter, withPreferences bool) []*domain.OrgsDTOWithPreferences {
+func (_mock *GrafanaService) ListOrganizations(filter filters.V2Filter, withPreferences bool) ([]*domain.OrgsDTOWithPreferences, error) {
 	ret := _mock.Called(filter, withPreferences)
 
 	if len(ret) == 0 {
@@ -3177,6 +3839,10 @@ func (_mock *GrafanaService) ListOrganizations(filter filters.V2Filter, withPref
 	}
 
 	var r0 []*domain.OrgsDTOWithPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, bool) ([]*domain.OrgsDTOWithPreferences, error)); ok {
+		return returnFunc(filter, withPreferences)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, bool) []*domain.OrgsDTOWithPreferences); ok {
 		r0 = returnFunc(filter, withPreferences)
 	} else {
@@ -3184,7 +3850,12 @@ func (_mock *GrafanaService) ListOrganizations(filter filters.V2Filter, withPref
 			r0 = ret.Get(0).([]*domain.OrgsDTOWithPreferences)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, bool) error); ok {
+		r1 = returnFunc(filter, withPreferences)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
@@ -3217,18 +3888,135 @@ func (_c *GrafanaService_ListOrganizations_Call) Run(run func(filter filters.V2F
 	return _c
 }
 
-func (_c *GrafanaService_ListOrganizations_Call) Return(orgsDTOWithPreferencess []*domain.OrgsDTOWithPreferences) *GrafanaService_ListOrganizations_Call {
-	_c.Call.Return(orgsDTOWithPreferencess)
+func (_c *GrafanaService_ListOrganizations_Call) Return(orgsDTOWithPreferencess []*domain.OrgsDTOWithPreferences, err error) *GrafanaService_ListOrganizations_Call {
+	_c.Call.Return(orgsDTOWithPreferencess, err)
+	return _c
+}
+
+func (_c *GrafanaService_ListOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, withPreferences bool) ([]*domain.OrgsDTOWithPreferences, error)) *GrafanaService_ListOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoles provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) ListRoles(filter filters.V2Filter) ([]*domain.RoleWithAssignments, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 []*domain.RoleWithAssignments
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.RoleWithAssignments, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.RoleWithAssignments); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.RoleWithAssignments)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type GrafanaService_ListRoles_Call struct {
+	*mock.Call
+}
+
+// ListRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *GrafanaService_Expecter) ListRoles(filter interface{}) *GrafanaService_ListRoles_Call {
+	return &GrafanaService_ListRoles_Call{Call: _e.mock.On("ListRoles", filter)}
+}
+
+func (_c *GrafanaService_ListRoles_Call) Run(run func(filter filters.V2Filter)) *GrafanaService_ListRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_ListRoles_Call) Return(roleWithAssignmentss []*domain.RoleWithAssignments, err error) *GrafanaService_ListRoles_Call {
+	_c.Call.Return(roleWithAssignmentss, err)
+	return _c
+}
+
+func (_c *GrafanaService_ListRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.RoleWithAssignments, error)) *GrafanaService_ListRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSSOSettings provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) ListSSOSettings() ([]*models.ListAllProvidersSettingsOKBodyItems, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSSOSettings")
+	}
+
+	var r0 []*models.ListAllProvidersSettingsOKBodyItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*models.ListAllProvidersSettingsOKBodyItems, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*models.ListAllProvidersSettingsOKBodyItems); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ListAllProvidersSettingsOKBodyItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_ListSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSSOSettings'
+type GrafanaService_ListSSOSettings_Call struct {
+	*mock.Call
+}
+
+// ListSSOSettings is a helper method to define mock.On call
+func (_e *GrafanaService_Expecter) ListSSOSettings() *GrafanaService_ListSSOSettings_Call {
+	return &GrafanaService_ListSSOSettings_Call{Call: _e.mock.On("ListSSOSettings")}
+}
+
+func (_c *GrafanaService_ListSSOSettings_Call) Run(run func()) *GrafanaService_ListSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GrafanaService_ListSSOSettings_Call) Return(listAllProvidersSettingsOKBodyItemss []*models.ListAllProvidersSettingsOKBodyItems, err error) *GrafanaService_ListSSOSettings_Call {
+	_c.Call.Return(listAllProvidersSettingsOKBodyItemss, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, withPreferences bool) []*domain.OrgsDTOWithPreferences) *GrafanaService_ListOrganizations_Call {
+func (_c *GrafanaService_ListSSOSettings_Call) RunAndReturn(run func() ([]*models.ListAllProvidersSettingsOKBodyItems, error)) *GrafanaService_ListSSOSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListServiceAccounts provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListServiceAccounts() []*domain.ServiceAccountDTOWithTokens {
+func (_mock *GrafanaService) ListServiceAccounts() ([]*domain.ServiceAccountDTOWithTokens, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -3236,6 +4024,10 @@ func (_mock *GrafanaService) ListServiceAccounts() []*domain.ServiceAccountDTOWi
 	}
 
 	var r0 []*domain.ServiceAccountDTOWithTokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*domain.ServiceAccountDTOWithTokens, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() []*domain.ServiceAccountDTOWithTokens); ok {
 		r0 = returnFunc()
 	} else {
@@ -3243,7 +4035,12 @@ func (_mock *GrafanaService) ListServiceAccounts() []*domain.ServiceAccountDTOWi
 			r0 = ret.Get(0).([]*domain.ServiceAccountDTOWithTokens)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServiceAccounts'
@@ -3263,12 +4060,12 @@ func (_c *GrafanaService_ListServiceAccounts_Call) Run(run func()) *GrafanaServi
 	return _c
 }
 
-func (_c *GrafanaService_ListServiceAccounts_Call) Return(serviceAccountDTOWithTokenss []*domain.ServiceAccountDTOWithTokens) *GrafanaService_ListServiceAccounts_Call {
-	_c.Call.Return(serviceAccountDTOWithTokenss)
+func (_c *GrafanaService_ListServiceAccounts_Call) Return(serviceAccountDTOWithTokenss []*domain.ServiceAccountDTOWithTokens, err error) *GrafanaService_ListServiceAccounts_Call {
+	_c.Call.Return(serviceAccountDTOWithTokenss, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListServiceAccounts_Call) RunAndReturn(run func() []*domain.ServiceAccountDTOWithTokens) *GrafanaService_ListServiceAccounts_Call {
+func (_c *GrafanaService_ListServiceAccounts_Call) RunAndReturn(run func() ([]*domain.ServiceAccountDTOWithTokens, error)) *GrafanaService_ListServiceAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3336,7 +4133,7 @@ func (_c *GrafanaService_ListServiceAccountsTokens_Call) RunAndReturn(run func(i
 }
 
 // ListTeams provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (_mock *GrafanaService) ListTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -3344,6 +4141,10 @@ func (_mock *GrafanaService) ListTeams(filter filters.V2Filter) map[*models.Team
 	}
 
 	var r0 map[*models.TeamDTO][]*models.TeamMemberDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -3351,7 +4152,12 @@ func (_mock *GrafanaService) ListTeams(filter filters.V2Filter) map[*models.Team
 			r0 = ret.Get(0).(map[*models.TeamDTO][]*models.TeamMemberDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
@@ -3378,12 +4184,12 @@ func (_c *GrafanaService_ListTeams_Call) Run(run func(filter filters.V2Filter))
 	return _c
 }
 
-func (_c *GrafanaService_ListTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO) *GrafanaService_ListTeams_Call {
-	_c.Call.Return(teamDTOToTeamMemberDTOs)
+func (_c *GrafanaService_ListTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO, err error) *GrafanaService_ListTeams_Call {
+	_c.Call.Return(teamDTOToTeamMemberDTOs, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListTeams_Call) RunAndReturn(run func(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO) *GrafanaService_ListTeams_Call {
+func (_c *GrafanaService_ListTeams_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)) *GrafanaService_ListTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3444,7 +4250,7 @@ func (_c *GrafanaService_ListUserOrganizations_Call) RunAndReturn(run func() ([]
 }
 
 // ListUsers provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) ListUsers(filter filters.V2Filter) []*models.UserSearchHitDTO {
+func (_mock *GrafanaService) ListUsers(filter filters.V2Filter) ([]*models.UserSearchHitDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -3452,6 +4258,10 @@ func (_mock *GrafanaService) ListUsers(filter filters.V2Filter) []*models.UserSe
 	}
 
 	var r0 []*models.UserSearchHitDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*models.UserSearchHitDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*models.UserSearchHitDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -3459,7 +4269,12 @@ func (_mock *GrafanaService) ListUsers(filter filters.V2Filter) []*models.UserSe
 			r0 = ret.Get(0).([]*models.UserSearchHitDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
@@ -3486,26 +4301,37 @@ func (_c *GrafanaService_ListUsers_Call) Run(run func(filter filters.V2Filter))
 	return _c
 }
 
-func (_c *GrafanaService_ListUsers_Call) Return(userSearchHitDTOs []*models.UserSearchHitDTO) *GrafanaService_ListUsers_Call {
-	_c.Call.Return(userSearchHitDTOs)
+func (_c *GrafanaService_ListUsers_Call) Return(userSearchHitDTOs []*models.UserSearchHitDTO, err error) *GrafanaService_ListUsers_Call {
+	_c.Call.Return(userSearchHitDTOs, err)
 	return _c
 }
 
-func (_c *GrafanaService_ListUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []*models.UserSearchHitDTO) *GrafanaService_ListUsers_Call {
+func (_c *GrafanaService_ListUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*models.UserSearchHitDTO, error)) *GrafanaService_ListUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Login provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) Login() {
-	_mock.Called()
-	return
-}
+func (_mock *GrafanaService) Login() error {
+	ret := _mock.Called()
 
-// GrafanaService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type GrafanaService_Login_Call struct {
-	*mock.Call
-}
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// GrafanaService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type GrafanaService_Login_Call struct {
+	*mock.Call
+}
 
 // Login is a helper method to define mock.On call
 func (_e *GrafanaService_Expecter) Login() *GrafanaService_Login_Call {
@@ -3519,13 +4345,13 @@ func (_c *GrafanaService_Login_Call) Run(run func()) *GrafanaService_Login_Call
 	return _c
 }
 
-func (_c *GrafanaService_Login_Call) Return() *GrafanaService_Login_Call {
-	_c.Call.Return()
+func (_c *GrafanaService_Login_Call) Return(err error) *GrafanaService_Login_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *GrafanaService_Login_Call) RunAndReturn(run func()) *GrafanaService_Login_Call {
-	_c.Run(run)
+func (_c *GrafanaService_Login_Call) RunAndReturn(run func() error) *GrafanaService_Login_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
@@ -3589,6 +4415,136 @@ func (_c *GrafanaService_PromoteUser_Call) RunAndReturn(run func(userLogin strin
 	return _c
 }
 
+// PruneSnapshots provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) PruneSnapshots(policy storage.RetentionPolicy, dryRun bool) ([]storage.SnapshotResult, error) {
+	ret := _mock.Called(policy, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneSnapshots")
+	}
+
+	var r0 []storage.SnapshotResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(storage.RetentionPolicy, bool) ([]storage.SnapshotResult, error)); ok {
+		return returnFunc(policy, dryRun)
+	}
+	if returnFunc, ok := ret.Get(0).(func(storage.RetentionPolicy, bool) []storage.SnapshotResult); ok {
+		r0 = returnFunc(policy, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]storage.SnapshotResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(storage.RetentionPolicy, bool) error); ok {
+		r1 = returnFunc(policy, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_PruneSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneSnapshots'
+type GrafanaService_PruneSnapshots_Call struct {
+	*mock.Call
+}
+
+// PruneSnapshots is a helper method to define mock.On call
+//   - policy storage.RetentionPolicy
+//   - dryRun bool
+func (_e *GrafanaService_Expecter) PruneSnapshots(policy interface{}, dryRun interface{}) *GrafanaService_PruneSnapshots_Call {
+	return &GrafanaService_PruneSnapshots_Call{Call: _e.mock.On("PruneSnapshots", policy, dryRun)}
+}
+
+func (_c *GrafanaService_PruneSnapshots_Call) Run(run func(policy storage.RetentionPolicy, dryRun bool)) *GrafanaService_PruneSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 storage.RetentionPolicy
+		if args[0] != nil {
+			arg0 = args[0].(storage.RetentionPolicy)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_PruneSnapshots_Call) Return(snapshotResults []storage.SnapshotResult, err error) *GrafanaService_PruneSnapshots_Call {
+	_c.Call.Return(snapshotResults, err)
+	return _c
+}
+
+func (_c *GrafanaService_PruneSnapshots_Call) RunAndReturn(run func(policy storage.RetentionPolicy, dryRun bool) ([]storage.SnapshotResult, error)) *GrafanaService_PruneSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReEncryptStorage provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) ReEncryptStorage(previous map[string]string) ([]string, error) {
+	ret := _mock.Called(previous)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReEncryptStorage")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(map[string]string) ([]string, error)); ok {
+		return returnFunc(previous)
+	}
+	if returnFunc, ok := ret.Get(0).(func(map[string]string) []string); ok {
+		r0 = returnFunc(previous)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(map[string]string) error); ok {
+		r1 = returnFunc(previous)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_ReEncryptStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReEncryptStorage'
+type GrafanaService_ReEncryptStorage_Call struct {
+	*mock.Call
+}
+
+// ReEncryptStorage is a helper method to define mock.On call
+//   - previous map[string]string
+func (_e *GrafanaService_Expecter) ReEncryptStorage(previous interface{}) *GrafanaService_ReEncryptStorage_Call {
+	return &GrafanaService_ReEncryptStorage_Call{Call: _e.mock.On("ReEncryptStorage", previous)}
+}
+
+func (_c *GrafanaService_ReEncryptStorage_Call) Run(run func(previous map[string]string)) *GrafanaService_ReEncryptStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 map[string]string
+		if args[0] != nil {
+			arg0 = args[0].(map[string]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_ReEncryptStorage_Call) Return(strings []string, err error) *GrafanaService_ReEncryptStorage_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *GrafanaService_ReEncryptStorage_Call) RunAndReturn(run func(previous map[string]string) ([]string, error)) *GrafanaService_ReEncryptStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetOrganizationByName provides a mock function for the type GrafanaService
 func (_mock *GrafanaService) SetOrganizationByName(name string, useSlug bool) error {
 	ret := _mock.Called(name, useSlug)
@@ -3697,6 +4653,68 @@ func (_c *GrafanaService_SetUserOrganizations_Call) RunAndReturn(run func(id int
 	return _c
 }
 
+// SyncContexts provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) SyncContexts(opts service.SyncOptions) ([]service.SyncResult, error) {
+	ret := _mock.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncContexts")
+	}
+
+	var r0 []service.SyncResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(service.SyncOptions) ([]service.SyncResult, error)); ok {
+		return returnFunc(opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(service.SyncOptions) []service.SyncResult); ok {
+		r0 = returnFunc(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.SyncResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(service.SyncOptions) error); ok {
+		r1 = returnFunc(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_SyncContexts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncContexts'
+type GrafanaService_SyncContexts_Call struct {
+	*mock.Call
+}
+
+// SyncContexts is a helper method to define mock.On call
+//   - opts service.SyncOptions
+func (_e *GrafanaService_Expecter) SyncContexts(opts interface{}) *GrafanaService_SyncContexts_Call {
+	return &GrafanaService_SyncContexts_Call{Call: _e.mock.On("SyncContexts", opts)}
+}
+
+func (_c *GrafanaService_SyncContexts_Call) Run(run func(opts service.SyncOptions)) *GrafanaService_SyncContexts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 service.SyncOptions
+		if args[0] != nil {
+			arg0 = args[0].(service.SyncOptions)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_SyncContexts_Call) Return(syncResults []service.SyncResult, err error) *GrafanaService_SyncContexts_Call {
+	_c.Call.Return(syncResults, err)
+	return _c
+}
+
+func (_c *GrafanaService_SyncContexts_Call) RunAndReturn(run func(opts service.SyncOptions) ([]service.SyncResult, error)) *GrafanaService_SyncContexts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUserInOrg provides a mock function for the type GrafanaService
 func (_mock *GrafanaService) UpdateUserInOrg(role string, orgSlug string, userId int64) error {
 	ret := _mock.Called(role, orgSlug, userId)
@@ -3977,7 +4995,7 @@ func (_c *GrafanaService_UploadAlertTimings_Call) RunAndReturn(run func() ([]str
 }
 
 // UploadConnectionPermissions provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadConnectionPermissions(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) UploadConnectionPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -3985,6 +5003,10 @@ func (_mock *GrafanaService) UploadConnectionPermissions(filter filters.V2Filter
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -3992,7 +5014,12 @@ func (_mock *GrafanaService) UploadConnectionPermissions(filter filters.V2Filter
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadConnectionPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadConnectionPermissions'
@@ -4019,18 +5046,18 @@ func (_c *GrafanaService_UploadConnectionPermissions_Call) Run(run func(filter f
 	return _c
 }
 
-func (_c *GrafanaService_UploadConnectionPermissions_Call) Return(strings []string) *GrafanaService_UploadConnectionPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_UploadConnectionPermissions_Call) Return(strings []string, err error) *GrafanaService_UploadConnectionPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_UploadConnectionPermissions_Call {
+func (_c *GrafanaService_UploadConnectionPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_UploadConnectionPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadConnections provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadConnections(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) UploadConnections(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -4038,6 +5065,10 @@ func (_mock *GrafanaService) UploadConnections(filter filters.V2Filter) []string
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -4045,7 +5076,12 @@ func (_mock *GrafanaService) UploadConnections(filter filters.V2Filter) []string
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadConnections'
@@ -4072,12 +5108,12 @@ func (_c *GrafanaService_UploadConnections_Call) Run(run func(filter filters.V2F
 	return _c
 }
 
-func (_c *GrafanaService_UploadConnections_Call) Return(strings []string) *GrafanaService_UploadConnections_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_UploadConnections_Call) Return(strings []string, err error) *GrafanaService_UploadConnections_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_UploadConnections_Call {
+func (_c *GrafanaService_UploadConnections_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_UploadConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4262,7 +5298,7 @@ func (_c *GrafanaService_UploadDashboards_Call) RunAndReturn(run func(filterReq
 }
 
 // UploadFolderPermissions provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadFolderPermissions(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) UploadFolderPermissions(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -4270,6 +5306,10 @@ func (_mock *GrafanaService) UploadFolderPermissions(filter filters.V2Filter) []
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -4277,7 +5317,12 @@ func (_mock *GrafanaService) UploadFolderPermissions(filter filters.V2Filter) []
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadFolderPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFolderPermissions'
@@ -4304,18 +5349,18 @@ func (_c *GrafanaService_UploadFolderPermissions_Call) Run(run func(filter filte
 	return _c
 }
 
-func (_c *GrafanaService_UploadFolderPermissions_Call) Return(strings []string) *GrafanaService_UploadFolderPermissions_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_UploadFolderPermissions_Call) Return(strings []string, err error) *GrafanaService_UploadFolderPermissions_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_UploadFolderPermissions_Call {
+func (_c *GrafanaService_UploadFolderPermissions_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_UploadFolderPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadFolders provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadFolders(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) UploadFolders(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -4323,6 +5368,10 @@ func (_mock *GrafanaService) UploadFolders(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -4330,7 +5379,12 @@ func (_mock *GrafanaService) UploadFolders(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadFolders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFolders'
@@ -4357,18 +5411,18 @@ func (_c *GrafanaService_UploadFolders_Call) Run(run func(filter filters.V2Filte
 	return _c
 }
 
-func (_c *GrafanaService_UploadFolders_Call) Return(strings []string) *GrafanaService_UploadFolders_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_UploadFolders_Call) Return(strings []string, err error) *GrafanaService_UploadFolders_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_UploadFolders_Call {
+func (_c *GrafanaService_UploadFolders_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_UploadFolders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadLibraryElements provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadLibraryElements(filter filters.V2Filter) []string {
+func (_mock *GrafanaService) UploadLibraryElements(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -4376,6 +5430,10 @@ func (_mock *GrafanaService) UploadLibraryElements(filter filters.V2Filter) []st
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -4383,7 +5441,12 @@ func (_mock *GrafanaService) UploadLibraryElements(filter filters.V2Filter) []st
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadLibraryElements'
@@ -4410,12 +5473,12 @@ func (_c *GrafanaService_UploadLibraryElements_Call) Run(run func(filter filters
 	return _c
 }
 
-func (_c *GrafanaService_UploadLibraryElements_Call) Return(strings []string) *GrafanaService_UploadLibraryElements_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_UploadLibraryElements_Call) Return(strings []string, err error) *GrafanaService_UploadLibraryElements_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_UploadLibraryElements_Call {
+func (_c *GrafanaService_UploadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_UploadLibraryElements_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4478,22 +5541,31 @@ func (_c *GrafanaService_UploadOrgPreferences_Call) RunAndReturn(run func(orgNam
 }
 
 // UploadOrganizations provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadOrganizations(filter filters.V2Filter) []string {
-	ret := _mock.Called(filter)
+func (_mock *GrafanaService) UploadOrganizations(filter filters.V2Filter, renames map[string]string) ([]string, error) {
+	ret := _mock.Called(filter, renames)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UploadOrganizations")
 	}
 
 	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
-		r0 = returnFunc(filter)
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, map[string]string) ([]string, error)); ok {
+		return returnFunc(filter, renames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, map[string]string) []string); ok {
+		r0 = returnFunc(filter, renames)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, map[string]string) error); ok {
+		r1 = returnFunc(filter, renames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadOrganizations'
@@ -4503,11 +5575,79 @@ type GrafanaService_UploadOrganizations_Call struct {
 
 // UploadOrganizations is a helper method to define mock.On call
 //   - filter filters.V2Filter
-func (_e *GrafanaService_Expecter) UploadOrganizations(filter interface{}) *GrafanaService_UploadOrganizations_Call {
-	return &GrafanaService_UploadOrganizations_Call{Call: _e.mock.On("UploadOrganizations", filter)}
+//   - renames map[string]string
+func (_e *GrafanaService_Expecter) UploadOrganizations(filter interface{}, renames interface{}) *GrafanaService_UploadOrganizations_Call {
+	return &GrafanaService_UploadOrganizations_Call{Call: _e.mock.On("UploadOrganizations", filter, renames)}
+}
+
+func (_c *GrafanaService_UploadOrganizations_Call) Run(run func(filter filters.V2Filter, renames map[string]string)) *GrafanaService_UploadOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		var arg1 map[string]string
+		if args[1] != nil {
+			arg1 = args[1].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_UploadOrganizations_Call) Return(strings []string, err error) *GrafanaService_UploadOrganizations_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *GrafanaService_UploadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, renames map[string]string) ([]string, error)) *GrafanaService_UploadOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadRoles provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) UploadRoles(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_UploadRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadRoles'
+type GrafanaService_UploadRoles_Call struct {
+	*mock.Call
+}
+
+// UploadRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *GrafanaService_Expecter) UploadRoles(filter interface{}) *GrafanaService_UploadRoles_Call {
+	return &GrafanaService_UploadRoles_Call{Call: _e.mock.On("UploadRoles", filter)}
 }
 
-func (_c *GrafanaService_UploadOrganizations_Call) Run(run func(filter filters.V2Filter)) *GrafanaService_UploadOrganizations_Call {
+func (_c *GrafanaService_UploadRoles_Call) Run(run func(filter filters.V2Filter)) *GrafanaService_UploadRoles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 filters.V2Filter
 		if args[0] != nil {
@@ -4520,18 +5660,73 @@ func (_c *GrafanaService_UploadOrganizations_Call) Run(run func(filter filters.V
 	return _c
 }
 
-func (_c *GrafanaService_UploadOrganizations_Call) Return(strings []string) *GrafanaService_UploadOrganizations_Call {
-	_c.Call.Return(strings)
+func (_c *GrafanaService_UploadRoles_Call) Return(strings []string, err error) *GrafanaService_UploadRoles_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *GrafanaService_UploadRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *GrafanaService_UploadRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadSSOSettings provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) UploadSSOSettings() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadSSOSettings")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_UploadSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadSSOSettings'
+type GrafanaService_UploadSSOSettings_Call struct {
+	*mock.Call
+}
+
+// UploadSSOSettings is a helper method to define mock.On call
+func (_e *GrafanaService_Expecter) UploadSSOSettings() *GrafanaService_UploadSSOSettings_Call {
+	return &GrafanaService_UploadSSOSettings_Call{Call: _e.mock.On("UploadSSOSettings")}
+}
+
+func (_c *GrafanaService_UploadSSOSettings_Call) Run(run func()) *GrafanaService_UploadSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GrafanaService_UploadSSOSettings_Call) Return(strings []string, err error) *GrafanaService_UploadSSOSettings_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *GrafanaService_UploadOrganizations_Call {
+func (_c *GrafanaService_UploadSSOSettings_Call) RunAndReturn(run func() ([]string, error)) *GrafanaService_UploadSSOSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadTeams provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (_mock *GrafanaService) UploadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -4539,6 +5734,10 @@ func (_mock *GrafanaService) UploadTeams(filter filters.V2Filter) map[*models.Te
 	}
 
 	var r0 map[*models.TeamDTO][]*models.TeamMemberDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -4546,7 +5745,12 @@ func (_mock *GrafanaService) UploadTeams(filter filters.V2Filter) map[*models.Te
 			r0 = ret.Get(0).(map[*models.TeamDTO][]*models.TeamMemberDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadTeams'
@@ -4573,18 +5777,18 @@ func (_c *GrafanaService_UploadTeams_Call) Run(run func(filter filters.V2Filter)
 	return _c
 }
 
-func (_c *GrafanaService_UploadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO) *GrafanaService_UploadTeams_Call {
-	_c.Call.Return(teamDTOToTeamMemberDTOs)
+func (_c *GrafanaService_UploadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO, err error) *GrafanaService_UploadTeams_Call {
+	_c.Call.Return(teamDTOToTeamMemberDTOs, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO) *GrafanaService_UploadTeams_Call {
+func (_c *GrafanaService_UploadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)) *GrafanaService_UploadTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadUsers provides a mock function for the type GrafanaService
-func (_mock *GrafanaService) UploadUsers(filter filters.V2Filter) []domain.UserProfileWithAuth {
+func (_mock *GrafanaService) UploadUsers(filter filters.V2Filter) ([]domain.UserProfileWithAuth, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -4592,6 +5796,10 @@ func (_mock *GrafanaService) UploadUsers(filter filters.V2Filter) []domain.UserP
 	}
 
 	var r0 []domain.UserProfileWithAuth
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]domain.UserProfileWithAuth, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []domain.UserProfileWithAuth); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -4599,7 +5807,12 @@ func (_mock *GrafanaService) UploadUsers(filter filters.V2Filter) []domain.UserP
 			r0 = ret.Get(0).([]domain.UserProfileWithAuth)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // GrafanaService_UploadUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadUsers'
@@ -4626,12 +5839,129 @@ func (_c *GrafanaService_UploadUsers_Call) Run(run func(filter filters.V2Filter)
 	return _c
 }
 
-func (_c *GrafanaService_UploadUsers_Call) Return(userProfileWithAuths []domain.UserProfileWithAuth) *GrafanaService_UploadUsers_Call {
-	_c.Call.Return(userProfileWithAuths)
+func (_c *GrafanaService_UploadUsers_Call) Return(userProfileWithAuths []domain.UserProfileWithAuth, err error) *GrafanaService_UploadUsers_Call {
+	_c.Call.Return(userProfileWithAuths, err)
+	return _c
+}
+
+func (_c *GrafanaService_UploadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]domain.UserProfileWithAuth, error)) *GrafanaService_UploadUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyBackup provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) VerifyBackup() ([]service.VerifyIssue, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyBackup")
+	}
+
+	var r0 []service.VerifyIssue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]service.VerifyIssue, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []service.VerifyIssue); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.VerifyIssue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_VerifyBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyBackup'
+type GrafanaService_VerifyBackup_Call struct {
+	*mock.Call
+}
+
+// VerifyBackup is a helper method to define mock.On call
+func (_e *GrafanaService_Expecter) VerifyBackup() *GrafanaService_VerifyBackup_Call {
+	return &GrafanaService_VerifyBackup_Call{Call: _e.mock.On("VerifyBackup")}
+}
+
+func (_c *GrafanaService_VerifyBackup_Call) Run(run func()) *GrafanaService_VerifyBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GrafanaService_VerifyBackup_Call) Return(verifyIssues []service.VerifyIssue, err error) *GrafanaService_VerifyBackup_Call {
+	_c.Call.Return(verifyIssues, err)
+	return _c
+}
+
+func (_c *GrafanaService_VerifyBackup_Call) RunAndReturn(run func() ([]service.VerifyIssue, error)) *GrafanaService_VerifyBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithOrganization provides a mock function for the type GrafanaService
+func (_mock *GrafanaService) WithOrganization(name string) (service.GrafanaService, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithOrganization")
+	}
+
+	var r0 service.GrafanaService
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (service.GrafanaService, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) service.GrafanaService); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.GrafanaService)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GrafanaService_WithOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrganization'
+type GrafanaService_WithOrganization_Call struct {
+	*mock.Call
+}
+
+// WithOrganization is a helper method to define mock.On call
+//   - name string
+func (_e *GrafanaService_Expecter) WithOrganization(name interface{}) *GrafanaService_WithOrganization_Call {
+	return &GrafanaService_WithOrganization_Call{Call: _e.mock.On("WithOrganization", name)}
+}
+
+func (_c *GrafanaService_WithOrganization_Call) Run(run func(name string)) *GrafanaService_WithOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *GrafanaService_WithOrganization_Call) Return(grafanaService service.GrafanaService, err error) *GrafanaService_WithOrganization_Call {
+	_c.Call.Return(grafanaService, err)
 	return _c
 }
 
-func (_c *GrafanaService_UploadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []domain.UserProfileWithAuth) *GrafanaService_UploadUsers_Call {
+func (_c *GrafanaService_WithOrganization_Call) RunAndReturn(run func(name string) (service.GrafanaService, error)) *GrafanaService_WithOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
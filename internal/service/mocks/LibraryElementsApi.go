@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,7 +39,7 @@ func (_m *LibraryElementsApi) EXPECT() *LibraryElementsApi_Expecter {
 }
 
 // DeleteAllLibraryElements provides a mock function for the type LibraryElementsApi
-func (_mock *LibraryElementsApi) DeleteAllLibraryElements(filter filters.V2Filter) []string {
+func (_mock *LibraryElementsApi) DeleteAllLibraryElements(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -47,6 +47,10 @@ func (_mock *LibraryElementsApi) DeleteAllLibraryElements(filter filters.V2Filte
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -54,7 +58,12 @@ func (_mock *LibraryElementsApi) DeleteAllLibraryElements(filter filters.V2Filte
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // LibraryElementsApi_DeleteAllLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllLibraryElements'
@@ -81,18 +90,18 @@ func (_c *LibraryElementsApi_DeleteAllLibraryElements_Call) Run(run func(filter
 	return _c
 }
 
-func (_c *LibraryElementsApi_DeleteAllLibraryElements_Call) Return(strings []string) *LibraryElementsApi_DeleteAllLibraryElements_Call {
-	_c.Call.Return(strings)
+func (_c *LibraryElementsApi_DeleteAllLibraryElements_Call) Return(strings []string, err error) *LibraryElementsApi_DeleteAllLibraryElements_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *LibraryElementsApi_DeleteAllLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *LibraryElementsApi_DeleteAllLibraryElements_Call {
+func (_c *LibraryElementsApi_DeleteAllLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *LibraryElementsApi_DeleteAllLibraryElements_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadLibraryElements provides a mock function for the type LibraryElementsApi
-func (_mock *LibraryElementsApi) DownloadLibraryElements(filter filters.V2Filter) []string {
+func (_mock *LibraryElementsApi) DownloadLibraryElements(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -100,6 +109,10 @@ func (_mock *LibraryElementsApi) DownloadLibraryElements(filter filters.V2Filter
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -107,7 +120,12 @@ func (_mock *LibraryElementsApi) DownloadLibraryElements(filter filters.V2Filter
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // LibraryElementsApi_DownloadLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadLibraryElements'
@@ -134,18 +152,18 @@ func (_c *LibraryElementsApi_DownloadLibraryElements_Call) Run(run func(filter f
 	return _c
 }
 
-func (_c *LibraryElementsApi_DownloadLibraryElements_Call) Return(strings []string) *LibraryElementsApi_DownloadLibraryElements_Call {
-	_c.Call.Return(strings)
+func (_c *LibraryElementsApi_DownloadLibraryElements_Call) Return(strings []string, err error) *LibraryElementsApi_DownloadLibraryElements_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *LibraryElementsApi_DownloadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *LibraryElementsApi_DownloadLibraryElements_Call {
+func (_c *LibraryElementsApi_DownloadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *LibraryElementsApi_DownloadLibraryElements_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListLibraryElements provides a mock function for the type LibraryElementsApi
-func (_mock *LibraryElementsApi) ListLibraryElements(filter filters.V2Filter) []*domain.WithNested[models.LibraryElementDTO] {
+func (_mock *LibraryElementsApi) ListLibraryElements(filter filters.V2Filter) ([]*domain.WithNested[models.LibraryElementDTO], error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -153,6 +171,10 @@ func (_mock *LibraryElementsApi) ListLibraryElements(filter filters.V2Filter) []
 	}
 
 	var r0 []*domain.WithNested[models.LibraryElementDTO]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.WithNested[models.LibraryElementDTO], error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.WithNested[models.LibraryElementDTO]); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -160,7 +182,12 @@ func (_mock *LibraryElementsApi) ListLibraryElements(filter filters.V2Filter) []
 			r0 = ret.Get(0).([]*domain.WithNested[models.LibraryElementDTO])
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // LibraryElementsApi_ListLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLibraryElements'
@@ -187,18 +214,18 @@ func (_c *LibraryElementsApi_ListLibraryElements_Call) Run(run func(filter filte
 	return _c
 }
 
-func (_c *LibraryElementsApi_ListLibraryElements_Call) Return(withNesteds []*domain.WithNested[models.LibraryElementDTO]) *LibraryElementsApi_ListLibraryElements_Call {
-	_c.Call.Return(withNesteds)
+func (_c *LibraryElementsApi_ListLibraryElements_Call) Return(withNesteds []*domain.WithNested[models.LibraryElementDTO], err error) *LibraryElementsApi_ListLibraryElements_Call {
+	_c.Call.Return(withNesteds, err)
 	return _c
 }
 
-func (_c *LibraryElementsApi_ListLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []*domain.WithNested[models.LibraryElementDTO]) *LibraryElementsApi_ListLibraryElements_Call {
+func (_c *LibraryElementsApi_ListLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.WithNested[models.LibraryElementDTO], error)) *LibraryElementsApi_ListLibraryElements_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListLibraryElementsConnections provides a mock function for the type LibraryElementsApi
-func (_mock *LibraryElementsApi) ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) []*models.DashboardFullWithMeta {
+func (_mock *LibraryElementsApi) ListLibraryElementsConnections(filter filters.V2Filter, connectionID string) ([]*models.DashboardFullWithMeta, error) {
 	ret := _mock.Called(filter, connectionID)
 
 	if len(ret) == 0 {
@@ -206,6 +233,10 @@ func (_mock *LibraryElementsApi) ListLibraryElementsConnections(filter filters.V
 	}
 
 	var r0 []*models.DashboardFullWithMeta
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, string) ([]*models.DashboardFullWithMeta, error)); ok {
+		return returnFunc(filter, connectionID)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, string) []*models.DashboardFullWithMeta); ok {
 		r0 = returnFunc(filter, connectionID)
 	} else {
@@ -213,7 +244,12 @@ func (_mock *LibraryElementsApi) ListLibraryElementsConnections(filter filters.V
 			r0 = ret.Get(0).([]*models.DashboardFullWithMeta)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, string) error); ok {
+		r1 = returnFunc(filter, connectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // LibraryElementsApi_ListLibraryElementsConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLibraryElementsConnections'
@@ -246,18 +282,18 @@ func (_c *LibraryElementsApi_ListLibraryElementsConnections_Call) Run(run func(f
 	return _c
 }
 
-func (_c *LibraryElementsApi_ListLibraryElementsConnections_Call) Return(dashboardFullWithMetas []*models.DashboardFullWithMeta) *LibraryElementsApi_ListLibraryElementsConnections_Call {
-	_c.Call.Return(dashboardFullWithMetas)
+func (_c *LibraryElementsApi_ListLibraryElementsConnections_Call) Return(dashboardFullWithMetas []*models.DashboardFullWithMeta, err error) *LibraryElementsApi_ListLibraryElementsConnections_Call {
+	_c.Call.Return(dashboardFullWithMetas, err)
 	return _c
 }
 
-func (_c *LibraryElementsApi_ListLibraryElementsConnections_Call) RunAndReturn(run func(filter filters.V2Filter, connectionID string) []*models.DashboardFullWithMeta) *LibraryElementsApi_ListLibraryElementsConnections_Call {
+func (_c *LibraryElementsApi_ListLibraryElementsConnections_Call) RunAndReturn(run func(filter filters.V2Filter, connectionID string) ([]*models.DashboardFullWithMeta, error)) *LibraryElementsApi_ListLibraryElementsConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadLibraryElements provides a mock function for the type LibraryElementsApi
-func (_mock *LibraryElementsApi) UploadLibraryElements(filter filters.V2Filter) []string {
+func (_mock *LibraryElementsApi) UploadLibraryElements(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -265,6 +301,10 @@ func (_mock *LibraryElementsApi) UploadLibraryElements(filter filters.V2Filter)
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -272,7 +312,12 @@ func (_mock *LibraryElementsApi) UploadLibraryElements(filter filters.V2Filter)
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // LibraryElementsApi_UploadLibraryElements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadLibraryElements'
@@ -299,12 +344,12 @@ func (_c *LibraryElementsApi_UploadLibraryElements_Call) Run(run func(filter fil
 	return _c
 }
 
-func (_c *LibraryElementsApi_UploadLibraryElements_Call) Return(strings []string) *LibraryElementsApi_UploadLibraryElements_Call {
-	_c.Call.Return(strings)
+func (_c *LibraryElementsApi_UploadLibraryElements_Call) Return(strings []string, err error) *LibraryElementsApi_UploadLibraryElements_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *LibraryElementsApi_UploadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *LibraryElementsApi_UploadLibraryElements_Call {
+func (_c *LibraryElementsApi_UploadLibraryElements_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *LibraryElementsApi_UploadLibraryElements_Call {
 	_c.Call.Return(run)
 	return _c
 }
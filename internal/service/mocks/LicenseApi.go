@@ -36,7 +36,7 @@ func (_m *LicenseApi) EXPECT() *LicenseApi_Expecter {
 }
 
 // IsEnterprise provides a mock function for the type LicenseApi
-func (_mock *LicenseApi) IsEnterprise() bool {
+func (_mock *LicenseApi) IsEnterprise() (bool, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -44,12 +44,21 @@ func (_mock *LicenseApi) IsEnterprise() bool {
 	}
 
 	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (bool, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() bool); ok {
 		r0 = returnFunc()
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // LicenseApi_IsEnterprise_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEnterprise'
@@ -69,12 +78,12 @@ func (_c *LicenseApi_IsEnterprise_Call) Run(run func()) *LicenseApi_IsEnterprise
 	return _c
 }
 
-func (_c *LicenseApi_IsEnterprise_Call) Return(b bool) *LicenseApi_IsEnterprise_Call {
-	_c.Call.Return(b)
+func (_c *LicenseApi_IsEnterprise_Call) Return(b bool, err error) *LicenseApi_IsEnterprise_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *LicenseApi_IsEnterprise_Call) RunAndReturn(run func() bool) *LicenseApi_IsEnterprise_Call {
+func (_c *LicenseApi_IsEnterprise_Call) RunAndReturn(run func() (bool, error)) *LicenseApi_IsEnterprise_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,148 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewManifestRecorder creates a new instance of ManifestRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewManifestRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ManifestRecorder {
+	mock := &ManifestRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// ManifestRecorder is an autogenerated mock type for the ManifestRecorder type
+type ManifestRecorder struct {
+	mock.Mock
+}
+
+type ManifestRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ManifestRecorder) EXPECT() *ManifestRecorder_Expecter {
+	return &ManifestRecorder_Expecter{mock: &_m.Mock}
+}
+
+// VerifyManifest provides a mock function for the type ManifestRecorder
+func (_mock *ManifestRecorder) VerifyManifest() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyManifest")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ManifestRecorder_VerifyManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyManifest'
+type ManifestRecorder_VerifyManifest_Call struct {
+	*mock.Call
+}
+
+// VerifyManifest is a helper method to define mock.On call
+func (_e *ManifestRecorder_Expecter) VerifyManifest() *ManifestRecorder_VerifyManifest_Call {
+	return &ManifestRecorder_VerifyManifest_Call{Call: _e.mock.On("VerifyManifest")}
+}
+
+func (_c *ManifestRecorder_VerifyManifest_Call) Run(run func()) *ManifestRecorder_VerifyManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ManifestRecorder_VerifyManifest_Call) Return(strings []string, err error) *ManifestRecorder_VerifyManifest_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *ManifestRecorder_VerifyManifest_Call) RunAndReturn(run func() ([]string, error)) *ManifestRecorder_VerifyManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteManifest provides a mock function for the type ManifestRecorder
+func (_mock *ManifestRecorder) WriteManifest(command string, filters map[string]string) error {
+	ret := _mock.Called(command, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteManifest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, map[string]string) error); ok {
+		r0 = returnFunc(command, filters)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ManifestRecorder_WriteManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteManifest'
+type ManifestRecorder_WriteManifest_Call struct {
+	*mock.Call
+}
+
+// WriteManifest is a helper method to define mock.On call
+//   - command string
+//   - filters map[string]string
+func (_e *ManifestRecorder_Expecter) WriteManifest(command interface{}, filters interface{}) *ManifestRecorder_WriteManifest_Call {
+	return &ManifestRecorder_WriteManifest_Call{Call: _e.mock.On("WriteManifest", command, filters)}
+}
+
+func (_c *ManifestRecorder_WriteManifest_Call) Run(run func(command string, filters map[string]string)) *ManifestRecorder_WriteManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 map[string]string
+		if args[1] != nil {
+			arg1 = args[1].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ManifestRecorder_WriteManifest_Call) Return(err error) *ManifestRecorder_WriteManifest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ManifestRecorder_WriteManifest_Call) RunAndReturn(run func(command string, filters map[string]string) error) *ManifestRecorder_WriteManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
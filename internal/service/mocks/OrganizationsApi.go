@@ -5,8 +5,9 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/internal/service"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -101,6 +102,68 @@ func (_c *OrganizationsApi_AddUserToOrg_Call) RunAndReturn(run func(role string,
 	return _c
 }
 
+// DeleteAllOrganizations provides a mock function for the type OrganizationsApi
+func (_mock *OrganizationsApi) DeleteAllOrganizations(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllOrganizations")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OrganizationsApi_DeleteAllOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllOrganizations'
+type OrganizationsApi_DeleteAllOrganizations_Call struct {
+	*mock.Call
+}
+
+// DeleteAllOrganizations is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *OrganizationsApi_Expecter) DeleteAllOrganizations(filter interface{}) *OrganizationsApi_DeleteAllOrganizations_Call {
+	return &OrganizationsApi_DeleteAllOrganizations_Call{Call: _e.mock.On("DeleteAllOrganizations", filter)}
+}
+
+func (_c *OrganizationsApi_DeleteAllOrganizations_Call) Run(run func(filter filters.V2Filter)) *OrganizationsApi_DeleteAllOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteAllOrganizations_Call) Return(strings []string, err error) *OrganizationsApi_DeleteAllOrganizations_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *OrganizationsApi_DeleteAllOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *OrganizationsApi_DeleteAllOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserFromOrg provides a mock function for the type OrganizationsApi
 func (_mock *OrganizationsApi) DeleteUserFromOrg(orgId string, userId int64) error {
 	ret := _mock.Called(orgId, userId)
@@ -159,7 +222,7 @@ func (_c *OrganizationsApi_DeleteUserFromOrg_Call) RunAndReturn(run func(orgId s
 }
 
 // DownloadOrganizations provides a mock function for the type OrganizationsApi
-func (_mock *OrganizationsApi) DownloadOrganizations(filter filters.V2Filter) []string {
+func (_mock *OrganizationsApi) DownloadOrganizations(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -167,6 +230,10 @@ func (_mock *OrganizationsApi) DownloadOrganizations(filter filters.V2Filter) []
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -174,7 +241,12 @@ func (_mock *OrganizationsApi) DownloadOrganizations(filter filters.V2Filter) []
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // OrganizationsApi_DownloadOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadOrganizations'
@@ -201,12 +273,12 @@ func (_c *OrganizationsApi_DownloadOrganizations_Call) Run(run func(filter filte
 	return _c
 }
 
-func (_c *OrganizationsApi_DownloadOrganizations_Call) Return(strings []string) *OrganizationsApi_DownloadOrganizations_Call {
-	_c.Call.Return(strings)
+func (_c *OrganizationsApi_DownloadOrganizations_Call) Return(strings []string, err error) *OrganizationsApi_DownloadOrganizations_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *OrganizationsApi_DownloadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *OrganizationsApi_DownloadOrganizations_Call {
+func (_c *OrganizationsApi_DownloadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *OrganizationsApi_DownloadOrganizations_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -274,7 +346,7 @@ func (_c *OrganizationsApi_GetOrgPreferences_Call) RunAndReturn(run func(orgName
 }
 
 // GetTokenOrganization provides a mock function for the type OrganizationsApi
-func (_mock *OrganizationsApi) GetTokenOrganization() *models.OrgDetailsDTO {
+func (_mock *OrganizationsApi) GetTokenOrganization() (*models.OrgDetailsDTO, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -282,6 +354,10 @@ func (_mock *OrganizationsApi) GetTokenOrganization() *models.OrgDetailsDTO {
 	}
 
 	var r0 *models.OrgDetailsDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*models.OrgDetailsDTO, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() *models.OrgDetailsDTO); ok {
 		r0 = returnFunc()
 	} else {
@@ -289,7 +365,12 @@ func (_mock *OrganizationsApi) GetTokenOrganization() *models.OrgDetailsDTO {
 			r0 = ret.Get(0).(*models.OrgDetailsDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // OrganizationsApi_GetTokenOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenOrganization'
@@ -309,18 +390,18 @@ func (_c *OrganizationsApi_GetTokenOrganization_Call) Run(run func()) *Organizat
 	return _c
 }
 
-func (_c *OrganizationsApi_GetTokenOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO) *OrganizationsApi_GetTokenOrganization_Call {
-	_c.Call.Return(orgDetailsDTO)
+func (_c *OrganizationsApi_GetTokenOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO, err error) *OrganizationsApi_GetTokenOrganization_Call {
+	_c.Call.Return(orgDetailsDTO, err)
 	return _c
 }
 
-func (_c *OrganizationsApi_GetTokenOrganization_Call) RunAndReturn(run func() *models.OrgDetailsDTO) *OrganizationsApi_GetTokenOrganization_Call {
+func (_c *OrganizationsApi_GetTokenOrganization_Call) RunAndReturn(run func() (*models.OrgDetailsDTO, error)) *OrganizationsApi_GetTokenOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetUserOrganization provides a mock function for the type OrganizationsApi
-func (_mock *OrganizationsApi) GetUserOrganization() *models.OrgDetailsDTO {
+func (_mock *OrganizationsApi) GetUserOrganization() (*models.OrgDetailsDTO, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -328,6 +409,10 @@ func (_mock *OrganizationsApi) GetUserOrganization() *models.OrgDetailsDTO {
 	}
 
 	var r0 *models.OrgDetailsDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*models.OrgDetailsDTO, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() *models.OrgDetailsDTO); ok {
 		r0 = returnFunc()
 	} else {
@@ -335,7 +420,12 @@ func (_mock *OrganizationsApi) GetUserOrganization() *models.OrgDetailsDTO {
 			r0 = ret.Get(0).(*models.OrgDetailsDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // OrganizationsApi_GetUserOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserOrganization'
@@ -355,20 +445,31 @@ func (_c *OrganizationsApi_GetUserOrganization_Call) Run(run func()) *Organizati
 	return _c
 }
 
-func (_c *OrganizationsApi_GetUserOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO) *OrganizationsApi_GetUserOrganization_Call {
-	_c.Call.Return(orgDetailsDTO)
+func (_c *OrganizationsApi_GetUserOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO, err error) *OrganizationsApi_GetUserOrganization_Call {
+	_c.Call.Return(orgDetailsDTO, err)
 	return _c
 }
 
-func (_c *OrganizationsApi_GetUserOrganization_Call) RunAndReturn(run func() *models.OrgDetailsDTO) *OrganizationsApi_GetUserOrganization_Call {
+func (_c *OrganizationsApi_GetUserOrganization_Call) RunAndReturn(run func() (*models.OrgDetailsDTO, error)) *OrganizationsApi_GetUserOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // InitOrganizations provides a mock function for the type OrganizationsApi
-func (_mock *OrganizationsApi) InitOrganizations() {
-	_mock.Called()
-	return
+func (_mock *OrganizationsApi) InitOrganizations() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitOrganizations")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
 // OrganizationsApi_InitOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitOrganizations'
@@ -388,18 +489,18 @@ func (_c *OrganizationsApi_InitOrganizations_Call) Run(run func()) *Organization
 	return _c
 }
 
-func (_c *OrganizationsApi_InitOrganizations_Call) Return() *OrganizationsApi_InitOrganizations_Call {
-	_c.Call.Return()
+func (_c *OrganizationsApi_InitOrganizations_Call) Return(err error) *OrganizationsApi_InitOrganizations_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *OrganizationsApi_InitOrganizations_Call) RunAndReturn(run func()) *OrganizationsApi_InitOrganizations_Call {
-	_c.Run(run)
+func (_c *OrganizationsApi_InitOrganizations_Call) RunAndReturn(run func() error) *OrganizationsApi_InitOrganizations_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
 // ListOrgUsers provides a mock function for the type OrganizationsApi
-func (_mock *OrganizationsApi) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
+func (_mock *OrganizationsApi) ListOrgUsers(orgId int64) ([]*models.OrgUserDTO, error) {
 	ret := _mock.Called(orgId)
 
 	if len(ret) == 0 {
@@ -407,6 +508,10 @@ func (_mock *OrganizationsApi) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
 	}
 
 	var r0 []*models.OrgUserDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]*models.OrgUserDTO, error)); ok {
+		return returnFunc(orgId)
+	}
 	if returnFunc, ok := ret.Get(0).(func(int64) []*models.OrgUserDTO); ok {
 		r0 = returnFunc(orgId)
 	} else {
@@ -414,7 +519,12 @@ func (_mock *OrganizationsApi) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
 			r0 = ret.Get(0).([]*models.OrgUserDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // OrganizationsApi_ListOrgUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrgUsers'
@@ -441,18 +551,18 @@ func (_c *OrganizationsApi_ListOrgUsers_Call) Run(run func(orgId int64)) *Organi
 	return _c
 }
 
-func (_c *OrganizationsApi_ListOrgUsers_Call) Return(orgUserDTOs []*models.OrgUserDTO) *OrganizationsApi_ListOrgUsers_Call {
-	_c.Call.Return(orgUserDTOs)
+func (_c *OrganizationsApi_ListOrgUsers_Call) Return(orgUserDTOs []*models.OrgUserDTO, err error) *OrganizationsApi_ListOrgUsers_Call {
+	_c.Call.Return(orgUserDTOs, err)
 	return _c
 }
 
-func (_c *OrganizationsApi_ListOrgUsers_Call) RunAndReturn(run func(orgId int64) []*models.OrgUserDTO) *OrganizationsApi_ListOrgUsers_Call {
+func (_c *OrganizationsApi_ListOrgUsers_Call) RunAndReturn(run func(orgId int64) ([]*models.OrgUserDTO, error)) *OrganizationsApi_ListOrgUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListOrganizations provides a mock function for the type OrganizationsApi
-func (_mock *OrganizationsApi) ListOrganizations(filter filters.V2Filter, withPreferences bool) []*domain.OrgsDTOWithPreferences {
+func (_mock *OrganizationsApi) ListOrganizations(filter filters.V2Filter, withPreferences bool) ([]*domain.OrgsDTOWithPreferences, error) {
 	ret := _mock.Called(filter, withPreferences)
 
 	if len(ret) == 0 {
@@ -460,6 +570,10 @@ func (_mock *OrganizationsApi) ListOrganizations(filter filters.V2Filter, withPr
 	}
 
 	var r0 []*domain.OrgsDTOWithPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, bool) ([]*domain.OrgsDTOWithPreferences, error)); ok {
+		return returnFunc(filter, withPreferences)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, bool) []*domain.OrgsDTOWithPreferences); ok {
 		r0 = returnFunc(filter, withPreferences)
 	} else {
@@ -467,7 +581,12 @@ func (_mock *OrganizationsApi) ListOrganizations(filter filters.V2Filter, withPr
 			r0 = ret.Get(0).([]*domain.OrgsDTOWithPreferences)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, bool) error); ok {
+		r1 = returnFunc(filter, withPreferences)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // OrganizationsApi_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
@@ -500,12 +619,12 @@ func (_c *OrganizationsApi_ListOrganizations_Call) Run(run func(filter filters.V
 	return _c
 }
 
-func (_c *OrganizationsApi_ListOrganizations_Call) Return(orgsDTOWithPreferencess []*domain.OrgsDTOWithPreferences) *OrganizationsApi_ListOrganizations_Call {
-	_c.Call.Return(orgsDTOWithPreferencess)
+func (_c *OrganizationsApi_ListOrganizations_Call) Return(orgsDTOWithPreferencess []*domain.OrgsDTOWithPreferences, err error) *OrganizationsApi_ListOrganizations_Call {
+	_c.Call.Return(orgsDTOWithPreferencess, err)
 	return _c
 }
 
-func (_c *OrganizationsApi_ListOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, withPreferences bool) []*domain.OrgsDTOWithPreferences) *OrganizationsApi_ListOrganizations_Call {
+func (_c *OrganizationsApi_ListOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, withPreferences bool) ([]*domain.OrgsDTOWithPreferences, error)) *OrganizationsApi_ListOrganizations_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -794,22 +913,31 @@ func (_c *OrganizationsApi_UploadOrgPreferences_Call) RunAndReturn(run func(orgN
 }
 
 // UploadOrganizations provides a mock function for the type OrganizationsApi
-func (_mock *OrganizationsApi) UploadOrganizations(filter filters.V2Filter) []string {
-	ret := _mock.Called(filter)
+func (_mock *OrganizationsApi) UploadOrganizations(filter filters.V2Filter, renames map[string]string) ([]string, error) {
+	ret := _mock.Called(filter, renames)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UploadOrganizations")
 	}
 
 	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
-		r0 = returnFunc(filter)
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, map[string]string) ([]string, error)); ok {
+		return returnFunc(filter, renames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, map[string]string) []string); ok {
+		r0 = returnFunc(filter, renames)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, map[string]string) error); ok {
+		r1 = returnFunc(filter, renames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // OrganizationsApi_UploadOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadOrganizations'
@@ -819,16 +947,84 @@ type OrganizationsApi_UploadOrganizations_Call struct {
 
 // UploadOrganizations is a helper method to define mock.On call
 //   - filter filters.V2Filter
-func (_e *OrganizationsApi_Expecter) UploadOrganizations(filter interface{}) *OrganizationsApi_UploadOrganizations_Call {
-	return &OrganizationsApi_UploadOrganizations_Call{Call: _e.mock.On("UploadOrganizations", filter)}
+//   - renames map[string]string
+func (_e *OrganizationsApi_Expecter) UploadOrganizations(filter interface{}, renames interface{}) *OrganizationsApi_UploadOrganizations_Call {
+	return &OrganizationsApi_UploadOrganizations_Call{Call: _e.mock.On("UploadOrganizations", filter, renames)}
 }
 
-func (_c *OrganizationsApi_UploadOrganizations_Call) Run(run func(filter filters.V2Filter)) *OrganizationsApi_UploadOrganizations_Call {
+func (_c *OrganizationsApi_UploadOrganizations_Call) Run(run func(filter filters.V2Filter, renames map[string]string)) *OrganizationsApi_UploadOrganizations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 filters.V2Filter
 		if args[0] != nil {
 			arg0 = args[0].(filters.V2Filter)
 		}
+		var arg1 map[string]string
+		if args[1] != nil {
+			arg1 = args[1].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OrganizationsApi_UploadOrganizations_Call) Return(strings []string, err error) *OrganizationsApi_UploadOrganizations_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *OrganizationsApi_UploadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, renames map[string]string) ([]string, error)) *OrganizationsApi_UploadOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithOrganization provides a mock function for the type OrganizationsApi
+func (_mock *OrganizationsApi) WithOrganization(name string) (service.GrafanaService, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithOrganization")
+	}
+
+	var r0 service.GrafanaService
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (service.GrafanaService, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) service.GrafanaService); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.GrafanaService)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OrganizationsApi_WithOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrganization'
+type OrganizationsApi_WithOrganization_Call struct {
+	*mock.Call
+}
+
+// WithOrganization is a helper method to define mock.On call
+//   - name string
+func (_e *OrganizationsApi_Expecter) WithOrganization(name interface{}) *OrganizationsApi_WithOrganization_Call {
+	return &OrganizationsApi_WithOrganization_Call{Call: _e.mock.On("WithOrganization", name)}
+}
+
+func (_c *OrganizationsApi_WithOrganization_Call) Run(run func(name string)) *OrganizationsApi_WithOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
 		run(
 			arg0,
 		)
@@ -836,12 +1032,12 @@ func (_c *OrganizationsApi_UploadOrganizations_Call) Run(run func(filter filters
 	return _c
 }
 
-func (_c *OrganizationsApi_UploadOrganizations_Call) Return(strings []string) *OrganizationsApi_UploadOrganizations_Call {
-	_c.Call.Return(strings)
+func (_c *OrganizationsApi_WithOrganization_Call) Return(grafanaService service.GrafanaService, err error) *OrganizationsApi_WithOrganization_Call {
+	_c.Call.Return(grafanaService, err)
 	return _c
 }
 
-func (_c *OrganizationsApi_UploadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *OrganizationsApi_UploadOrganizations_Call {
+func (_c *OrganizationsApi_WithOrganization_Call) RunAndReturn(run func(name string) (service.GrafanaService, error)) *OrganizationsApi_WithOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
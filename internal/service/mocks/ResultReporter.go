@@ -0,0 +1,116 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/esnet/gdg/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewResultReporter creates a new instance of ResultReporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResultReporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResultReporter {
+	mock := &ResultReporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// ResultReporter is an autogenerated mock type for the ResultReporter type
+type ResultReporter struct {
+	mock.Mock
+}
+
+type ResultReporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResultReporter) EXPECT() *ResultReporter_Expecter {
+	return &ResultReporter_Expecter{mock: &_m.Mock}
+}
+
+// ResetResults provides a mock function for the type ResultReporter
+func (_mock *ResultReporter) ResetResults() {
+	_mock.Called()
+	return
+}
+
+// ResultReporter_ResetResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetResults'
+type ResultReporter_ResetResults_Call struct {
+	*mock.Call
+}
+
+// ResetResults is a helper method to define mock.On call
+func (_e *ResultReporter_Expecter) ResetResults() *ResultReporter_ResetResults_Call {
+	return &ResultReporter_ResetResults_Call{Call: _e.mock.On("ResetResults")}
+}
+
+func (_c *ResultReporter_ResetResults_Call) Run(run func()) *ResultReporter_ResetResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ResultReporter_ResetResults_Call) Return() *ResultReporter_ResetResults_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResultReporter_ResetResults_Call) RunAndReturn(run func()) *ResultReporter_ResetResults_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Results provides a mock function for the type ResultReporter
+func (_mock *ResultReporter) Results() []service.EntityResult {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Results")
+	}
+
+	var r0 []service.EntityResult
+	if returnFunc, ok := ret.Get(0).(func() []service.EntityResult); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.EntityResult)
+		}
+	}
+	return r0
+}
+
+// ResultReporter_Results_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Results'
+type ResultReporter_Results_Call struct {
+	*mock.Call
+}
+
+// Results is a helper method to define mock.On call
+func (_e *ResultReporter_Expecter) Results() *ResultReporter_Results_Call {
+	return &ResultReporter_Results_Call{Call: _e.mock.On("Results")}
+}
+
+func (_c *ResultReporter_Results_Call) Run(run func()) *ResultReporter_Results_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ResultReporter_Results_Call) Return(vs []service.EntityResult) *ResultReporter_Results_Call {
+	_c.Call.Return(vs)
+	return _c
+}
+
+func (_c *ResultReporter_Results_Call) RunAndReturn(run func() []service.EntityResult) *ResultReporter_Results_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,286 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewRolesApi creates a new instance of RolesApi. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRolesApi(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RolesApi {
+	mock := &RolesApi{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// RolesApi is an autogenerated mock type for the RolesApi type
+type RolesApi struct {
+	mock.Mock
+}
+
+type RolesApi_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RolesApi) EXPECT() *RolesApi_Expecter {
+	return &RolesApi_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAllRoles provides a mock function for the type RolesApi
+func (_mock *RolesApi) DeleteAllRoles(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RolesApi_DeleteAllRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllRoles'
+type RolesApi_DeleteAllRoles_Call struct {
+	*mock.Call
+}
+
+// DeleteAllRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *RolesApi_Expecter) DeleteAllRoles(filter interface{}) *RolesApi_DeleteAllRoles_Call {
+	return &RolesApi_DeleteAllRoles_Call{Call: _e.mock.On("DeleteAllRoles", filter)}
+}
+
+func (_c *RolesApi_DeleteAllRoles_Call) Run(run func(filter filters.V2Filter)) *RolesApi_DeleteAllRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RolesApi_DeleteAllRoles_Call) Return(strings []string, err error) *RolesApi_DeleteAllRoles_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *RolesApi_DeleteAllRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *RolesApi_DeleteAllRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadRoles provides a mock function for the type RolesApi
+func (_mock *RolesApi) DownloadRoles(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RolesApi_DownloadRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadRoles'
+type RolesApi_DownloadRoles_Call struct {
+	*mock.Call
+}
+
+// DownloadRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *RolesApi_Expecter) DownloadRoles(filter interface{}) *RolesApi_DownloadRoles_Call {
+	return &RolesApi_DownloadRoles_Call{Call: _e.mock.On("DownloadRoles", filter)}
+}
+
+func (_c *RolesApi_DownloadRoles_Call) Run(run func(filter filters.V2Filter)) *RolesApi_DownloadRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RolesApi_DownloadRoles_Call) Return(strings []string, err error) *RolesApi_DownloadRoles_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *RolesApi_DownloadRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *RolesApi_DownloadRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoles provides a mock function for the type RolesApi
+func (_mock *RolesApi) ListRoles(filter filters.V2Filter) ([]*domain.RoleWithAssignments, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 []*domain.RoleWithAssignments
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*domain.RoleWithAssignments, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*domain.RoleWithAssignments); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.RoleWithAssignments)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RolesApi_ListRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoles'
+type RolesApi_ListRoles_Call struct {
+	*mock.Call
+}
+
+// ListRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *RolesApi_Expecter) ListRoles(filter interface{}) *RolesApi_ListRoles_Call {
+	return &RolesApi_ListRoles_Call{Call: _e.mock.On("ListRoles", filter)}
+}
+
+func (_c *RolesApi_ListRoles_Call) Run(run func(filter filters.V2Filter)) *RolesApi_ListRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RolesApi_ListRoles_Call) Return(roleWithAssignmentss []*domain.RoleWithAssignments, err error) *RolesApi_ListRoles_Call {
+	_c.Call.Return(roleWithAssignmentss, err)
+	return _c
+}
+
+func (_c *RolesApi_ListRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*domain.RoleWithAssignments, error)) *RolesApi_ListRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadRoles provides a mock function for the type RolesApi
+func (_mock *RolesApi) UploadRoles(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RolesApi_UploadRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadRoles'
+type RolesApi_UploadRoles_Call struct {
+	*mock.Call
+}
+
+// UploadRoles is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *RolesApi_Expecter) UploadRoles(filter interface{}) *RolesApi_UploadRoles_Call {
+	return &RolesApi_UploadRoles_Call{Call: _e.mock.On("UploadRoles", filter)}
+}
+
+func (_c *RolesApi_UploadRoles_Call) Run(run func(filter filters.V2Filter)) *RolesApi_UploadRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RolesApi_UploadRoles_Call) Return(strings []string, err error) *RolesApi_UploadRoles_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *RolesApi_UploadRoles_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *RolesApi_UploadRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
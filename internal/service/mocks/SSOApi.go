@@ -0,0 +1,258 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewSSOApi creates a new instance of SSOApi. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSSOApi(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SSOApi {
+	mock := &SSOApi{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// SSOApi is an autogenerated mock type for the SSOApi type
+type SSOApi struct {
+	mock.Mock
+}
+
+type SSOApi_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SSOApi) EXPECT() *SSOApi_Expecter {
+	return &SSOApi_Expecter{mock: &_m.Mock}
+}
+
+// DiffSSOSettings provides a mock function for the type SSOApi
+func (_mock *SSOApi) DiffSSOSettings() ([]domain.SSOSettingsDiff, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffSSOSettings")
+	}
+
+	var r0 []domain.SSOSettingsDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]domain.SSOSettingsDiff, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []domain.SSOSettingsDiff); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SSOSettingsDiff)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSOApi_DiffSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffSSOSettings'
+type SSOApi_DiffSSOSettings_Call struct {
+	*mock.Call
+}
+
+// DiffSSOSettings is a helper method to define mock.On call
+func (_e *SSOApi_Expecter) DiffSSOSettings() *SSOApi_DiffSSOSettings_Call {
+	return &SSOApi_DiffSSOSettings_Call{Call: _e.mock.On("DiffSSOSettings")}
+}
+
+func (_c *SSOApi_DiffSSOSettings_Call) Run(run func()) *SSOApi_DiffSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SSOApi_DiffSSOSettings_Call) Return(sSOSettingsDiffs []domain.SSOSettingsDiff, err error) *SSOApi_DiffSSOSettings_Call {
+	_c.Call.Return(sSOSettingsDiffs, err)
+	return _c
+}
+
+func (_c *SSOApi_DiffSSOSettings_Call) RunAndReturn(run func() ([]domain.SSOSettingsDiff, error)) *SSOApi_DiffSSOSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadSSOSettings provides a mock function for the type SSOApi
+func (_mock *SSOApi) DownloadSSOSettings() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadSSOSettings")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSOApi_DownloadSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadSSOSettings'
+type SSOApi_DownloadSSOSettings_Call struct {
+	*mock.Call
+}
+
+// DownloadSSOSettings is a helper method to define mock.On call
+func (_e *SSOApi_Expecter) DownloadSSOSettings() *SSOApi_DownloadSSOSettings_Call {
+	return &SSOApi_DownloadSSOSettings_Call{Call: _e.mock.On("DownloadSSOSettings")}
+}
+
+func (_c *SSOApi_DownloadSSOSettings_Call) Run(run func()) *SSOApi_DownloadSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SSOApi_DownloadSSOSettings_Call) Return(strings []string, err error) *SSOApi_DownloadSSOSettings_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *SSOApi_DownloadSSOSettings_Call) RunAndReturn(run func() ([]string, error)) *SSOApi_DownloadSSOSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSSOSettings provides a mock function for the type SSOApi
+func (_mock *SSOApi) ListSSOSettings() ([]*models.ListAllProvidersSettingsOKBodyItems, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSSOSettings")
+	}
+
+	var r0 []*models.ListAllProvidersSettingsOKBodyItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*models.ListAllProvidersSettingsOKBodyItems, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*models.ListAllProvidersSettingsOKBodyItems); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ListAllProvidersSettingsOKBodyItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSOApi_ListSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSSOSettings'
+type SSOApi_ListSSOSettings_Call struct {
+	*mock.Call
+}
+
+// ListSSOSettings is a helper method to define mock.On call
+func (_e *SSOApi_Expecter) ListSSOSettings() *SSOApi_ListSSOSettings_Call {
+	return &SSOApi_ListSSOSettings_Call{Call: _e.mock.On("ListSSOSettings")}
+}
+
+func (_c *SSOApi_ListSSOSettings_Call) Run(run func()) *SSOApi_ListSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SSOApi_ListSSOSettings_Call) Return(listAllProvidersSettingsOKBodyItemss []*models.ListAllProvidersSettingsOKBodyItems, err error) *SSOApi_ListSSOSettings_Call {
+	_c.Call.Return(listAllProvidersSettingsOKBodyItemss, err)
+	return _c
+}
+
+func (_c *SSOApi_ListSSOSettings_Call) RunAndReturn(run func() ([]*models.ListAllProvidersSettingsOKBodyItems, error)) *SSOApi_ListSSOSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadSSOSettings provides a mock function for the type SSOApi
+func (_mock *SSOApi) UploadSSOSettings() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadSSOSettings")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSOApi_UploadSSOSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadSSOSettings'
+type SSOApi_UploadSSOSettings_Call struct {
+	*mock.Call
+}
+
+// UploadSSOSettings is a helper method to define mock.On call
+func (_e *SSOApi_Expecter) UploadSSOSettings() *SSOApi_UploadSSOSettings_Call {
+	return &SSOApi_UploadSSOSettings_Call{Call: _e.mock.On("UploadSSOSettings")}
+}
+
+func (_c *SSOApi_UploadSSOSettings_Call) Run(run func()) *SSOApi_UploadSSOSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SSOApi_UploadSSOSettings_Call) Return(strings []string, err error) *SSOApi_UploadSSOSettings_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *SSOApi_UploadSSOSettings_Call) RunAndReturn(run func() ([]string, error)) *SSOApi_UploadSSOSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -36,7 +36,7 @@ func (_m *ServerInfoApi) EXPECT() *ServerInfoApi_Expecter {
 }
 
 // GetServerInfo provides a mock function for the type ServerInfoApi
-func (_mock *ServerInfoApi) GetServerInfo() map[string]any {
+func (_mock *ServerInfoApi) GetServerInfo() (map[string]any, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -44,6 +44,10 @@ func (_mock *ServerInfoApi) GetServerInfo() map[string]any {
 	}
 
 	var r0 map[string]any
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (map[string]any, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() map[string]any); ok {
 		r0 = returnFunc()
 	} else {
@@ -51,7 +55,12 @@ func (_mock *ServerInfoApi) GetServerInfo() map[string]any {
 			r0 = ret.Get(0).(map[string]any)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ServerInfoApi_GetServerInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServerInfo'
@@ -71,12 +80,12 @@ func (_c *ServerInfoApi_GetServerInfo_Call) Run(run func()) *ServerInfoApi_GetSe
 	return _c
 }
 
-func (_c *ServerInfoApi_GetServerInfo_Call) Return(stringToV map[string]any) *ServerInfoApi_GetServerInfo_Call {
-	_c.Call.Return(stringToV)
+func (_c *ServerInfoApi_GetServerInfo_Call) Return(stringToV map[string]any, err error) *ServerInfoApi_GetServerInfo_Call {
+	_c.Call.Return(stringToV, err)
 	return _c
 }
 
-func (_c *ServerInfoApi_GetServerInfo_Call) RunAndReturn(run func() map[string]any) *ServerInfoApi_GetServerInfo_Call {
+func (_c *ServerInfoApi_GetServerInfo_Call) RunAndReturn(run func() (map[string]any, error)) *ServerInfoApi_GetServerInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,7 +5,7 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -186,7 +186,7 @@ func (_c *ServiceAccountApi_CreateServiceAccountToken_Call) RunAndReturn(run fun
 }
 
 // DeleteAllServiceAccounts provides a mock function for the type ServiceAccountApi
-func (_mock *ServiceAccountApi) DeleteAllServiceAccounts() []string {
+func (_mock *ServiceAccountApi) DeleteAllServiceAccounts() ([]string, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -194,6 +194,10 @@ func (_mock *ServiceAccountApi) DeleteAllServiceAccounts() []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() []string); ok {
 		r0 = returnFunc()
 	} else {
@@ -201,7 +205,12 @@ func (_mock *ServiceAccountApi) DeleteAllServiceAccounts() []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ServiceAccountApi_DeleteAllServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllServiceAccounts'
@@ -221,12 +230,12 @@ func (_c *ServiceAccountApi_DeleteAllServiceAccounts_Call) Run(run func()) *Serv
 	return _c
 }
 
-func (_c *ServiceAccountApi_DeleteAllServiceAccounts_Call) Return(strings []string) *ServiceAccountApi_DeleteAllServiceAccounts_Call {
-	_c.Call.Return(strings)
+func (_c *ServiceAccountApi_DeleteAllServiceAccounts_Call) Return(strings []string, err error) *ServiceAccountApi_DeleteAllServiceAccounts_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ServiceAccountApi_DeleteAllServiceAccounts_Call) RunAndReturn(run func() []string) *ServiceAccountApi_DeleteAllServiceAccounts_Call {
+func (_c *ServiceAccountApi_DeleteAllServiceAccounts_Call) RunAndReturn(run func() ([]string, error)) *ServiceAccountApi_DeleteAllServiceAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -283,7 +292,7 @@ func (_c *ServiceAccountApi_DeleteServiceAccount_Call) RunAndReturn(run func(acc
 }
 
 // DeleteServiceAccountTokens provides a mock function for the type ServiceAccountApi
-func (_mock *ServiceAccountApi) DeleteServiceAccountTokens(serviceId int64) []string {
+func (_mock *ServiceAccountApi) DeleteServiceAccountTokens(serviceId int64) ([]string, error) {
 	ret := _mock.Called(serviceId)
 
 	if len(ret) == 0 {
@@ -291,6 +300,10 @@ func (_mock *ServiceAccountApi) DeleteServiceAccountTokens(serviceId int64) []st
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]string, error)); ok {
+		return returnFunc(serviceId)
+	}
 	if returnFunc, ok := ret.Get(0).(func(int64) []string); ok {
 		r0 = returnFunc(serviceId)
 	} else {
@@ -298,7 +311,12 @@ func (_mock *ServiceAccountApi) DeleteServiceAccountTokens(serviceId int64) []st
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(serviceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ServiceAccountApi_DeleteServiceAccountTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccountTokens'
@@ -325,18 +343,18 @@ func (_c *ServiceAccountApi_DeleteServiceAccountTokens_Call) Run(run func(servic
 	return _c
 }
 
-func (_c *ServiceAccountApi_DeleteServiceAccountTokens_Call) Return(strings []string) *ServiceAccountApi_DeleteServiceAccountTokens_Call {
-	_c.Call.Return(strings)
+func (_c *ServiceAccountApi_DeleteServiceAccountTokens_Call) Return(strings []string, err error) *ServiceAccountApi_DeleteServiceAccountTokens_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *ServiceAccountApi_DeleteServiceAccountTokens_Call) RunAndReturn(run func(serviceId int64) []string) *ServiceAccountApi_DeleteServiceAccountTokens_Call {
+func (_c *ServiceAccountApi_DeleteServiceAccountTokens_Call) RunAndReturn(run func(serviceId int64) ([]string, error)) *ServiceAccountApi_DeleteServiceAccountTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListServiceAccounts provides a mock function for the type ServiceAccountApi
-func (_mock *ServiceAccountApi) ListServiceAccounts() []*domain.ServiceAccountDTOWithTokens {
+func (_mock *ServiceAccountApi) ListServiceAccounts() ([]*domain.ServiceAccountDTOWithTokens, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -344,6 +362,10 @@ func (_mock *ServiceAccountApi) ListServiceAccounts() []*domain.ServiceAccountDT
 	}
 
 	var r0 []*domain.ServiceAccountDTOWithTokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*domain.ServiceAccountDTOWithTokens, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() []*domain.ServiceAccountDTOWithTokens); ok {
 		r0 = returnFunc()
 	} else {
@@ -351,7 +373,12 @@ func (_mock *ServiceAccountApi) ListServiceAccounts() []*domain.ServiceAccountDT
 			r0 = ret.Get(0).([]*domain.ServiceAccountDTOWithTokens)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // ServiceAccountApi_ListServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServiceAccounts'
@@ -371,12 +398,12 @@ func (_c *ServiceAccountApi_ListServiceAccounts_Call) Run(run func()) *ServiceAc
 	return _c
 }
 
-func (_c *ServiceAccountApi_ListServiceAccounts_Call) Return(serviceAccountDTOWithTokenss []*domain.ServiceAccountDTOWithTokens) *ServiceAccountApi_ListServiceAccounts_Call {
-	_c.Call.Return(serviceAccountDTOWithTokenss)
+func (_c *ServiceAccountApi_ListServiceAccounts_Call) Return(serviceAccountDTOWithTokenss []*domain.ServiceAccountDTOWithTokens, err error) *ServiceAccountApi_ListServiceAccounts_Call {
+	_c.Call.Return(serviceAccountDTOWithTokenss, err)
 	return _c
 }
 
-func (_c *ServiceAccountApi_ListServiceAccounts_Call) RunAndReturn(run func() []*domain.ServiceAccountDTOWithTokens) *ServiceAccountApi_ListServiceAccounts_Call {
+func (_c *ServiceAccountApi_ListServiceAccounts_Call) RunAndReturn(run func() ([]*domain.ServiceAccountDTOWithTokens, error)) *ServiceAccountApi_ListServiceAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
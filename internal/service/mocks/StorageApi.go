@@ -0,0 +1,297 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/storage"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewStorageApi creates a new instance of StorageApi. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageApi(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageApi {
+	mock := &StorageApi{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// StorageApi is an autogenerated mock type for the StorageApi type
+type StorageApi struct {
+	mock.Mock
+}
+
+type StorageApi_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageApi) EXPECT() *StorageApi_Expecter {
+	return &StorageApi_Expecter{mock: &_m.Mock}
+}
+
+// CopyStorage provides a mock function for the type StorageApi
+func (_mock *StorageApi) CopyStorage(from string, to string, resume bool) ([]storage.CopyResult, error) {
+	ret := _mock.Called(from, to, resume)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyStorage")
+	}
+
+	var r0 []storage.CopyResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) ([]storage.CopyResult, error)); ok {
+		return returnFunc(from, to, resume)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) []storage.CopyResult); ok {
+		r0 = returnFunc(from, to, resume)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]storage.CopyResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = returnFunc(from, to, resume)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// StorageApi_CopyStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyStorage'
+type StorageApi_CopyStorage_Call struct {
+	*mock.Call
+}
+
+// CopyStorage is a helper method to define mock.On call
+//   - from string
+//   - to string
+//   - resume bool
+func (_e *StorageApi_Expecter) CopyStorage(from interface{}, to interface{}, resume interface{}) *StorageApi_CopyStorage_Call {
+	return &StorageApi_CopyStorage_Call{Call: _e.mock.On("CopyStorage", from, to, resume)}
+}
+
+func (_c *StorageApi_CopyStorage_Call) Run(run func(from string, to string, resume bool)) *StorageApi_CopyStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *StorageApi_CopyStorage_Call) Return(copyResults []storage.CopyResult, err error) *StorageApi_CopyStorage_Call {
+	_c.Call.Return(copyResults, err)
+	return _c
+}
+
+func (_c *StorageApi_CopyStorage_Call) RunAndReturn(run func(from string, to string, resume bool) ([]storage.CopyResult, error)) *StorageApi_CopyStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PruneSnapshots provides a mock function for the type StorageApi
+func (_mock *StorageApi) PruneSnapshots(policy storage.RetentionPolicy, dryRun bool) ([]storage.SnapshotResult, error) {
+	ret := _mock.Called(policy, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneSnapshots")
+	}
+
+	var r0 []storage.SnapshotResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(storage.RetentionPolicy, bool) ([]storage.SnapshotResult, error)); ok {
+		return returnFunc(policy, dryRun)
+	}
+	if returnFunc, ok := ret.Get(0).(func(storage.RetentionPolicy, bool) []storage.SnapshotResult); ok {
+		r0 = returnFunc(policy, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]storage.SnapshotResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(storage.RetentionPolicy, bool) error); ok {
+		r1 = returnFunc(policy, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// StorageApi_PruneSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneSnapshots'
+type StorageApi_PruneSnapshots_Call struct {
+	*mock.Call
+}
+
+// PruneSnapshots is a helper method to define mock.On call
+//   - policy storage.RetentionPolicy
+//   - dryRun bool
+func (_e *StorageApi_Expecter) PruneSnapshots(policy interface{}, dryRun interface{}) *StorageApi_PruneSnapshots_Call {
+	return &StorageApi_PruneSnapshots_Call{Call: _e.mock.On("PruneSnapshots", policy, dryRun)}
+}
+
+func (_c *StorageApi_PruneSnapshots_Call) Run(run func(policy storage.RetentionPolicy, dryRun bool)) *StorageApi_PruneSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 storage.RetentionPolicy
+		if args[0] != nil {
+			arg0 = args[0].(storage.RetentionPolicy)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *StorageApi_PruneSnapshots_Call) Return(snapshotResults []storage.SnapshotResult, err error) *StorageApi_PruneSnapshots_Call {
+	_c.Call.Return(snapshotResults, err)
+	return _c
+}
+
+func (_c *StorageApi_PruneSnapshots_Call) RunAndReturn(run func(policy storage.RetentionPolicy, dryRun bool) ([]storage.SnapshotResult, error)) *StorageApi_PruneSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReEncryptStorage provides a mock function for the type StorageApi
+func (_mock *StorageApi) ReEncryptStorage(previous map[string]string) ([]string, error) {
+	ret := _mock.Called(previous)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReEncryptStorage")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(map[string]string) ([]string, error)); ok {
+		return returnFunc(previous)
+	}
+	if returnFunc, ok := ret.Get(0).(func(map[string]string) []string); ok {
+		r0 = returnFunc(previous)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(map[string]string) error); ok {
+		r1 = returnFunc(previous)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// StorageApi_ReEncryptStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReEncryptStorage'
+type StorageApi_ReEncryptStorage_Call struct {
+	*mock.Call
+}
+
+// ReEncryptStorage is a helper method to define mock.On call
+//   - previous map[string]string
+func (_e *StorageApi_Expecter) ReEncryptStorage(previous interface{}) *StorageApi_ReEncryptStorage_Call {
+	return &StorageApi_ReEncryptStorage_Call{Call: _e.mock.On("ReEncryptStorage", previous)}
+}
+
+func (_c *StorageApi_ReEncryptStorage_Call) Run(run func(previous map[string]string)) *StorageApi_ReEncryptStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 map[string]string
+		if args[0] != nil {
+			arg0 = args[0].(map[string]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *StorageApi_ReEncryptStorage_Call) Return(strings []string, err error) *StorageApi_ReEncryptStorage_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *StorageApi_ReEncryptStorage_Call) RunAndReturn(run func(previous map[string]string) ([]string, error)) *StorageApi_ReEncryptStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyBackup provides a mock function for the type StorageApi
+func (_mock *StorageApi) VerifyBackup() ([]service.VerifyIssue, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyBackup")
+	}
+
+	var r0 []service.VerifyIssue
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]service.VerifyIssue, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []service.VerifyIssue); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.VerifyIssue)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// StorageApi_VerifyBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyBackup'
+type StorageApi_VerifyBackup_Call struct {
+	*mock.Call
+}
+
+// VerifyBackup is a helper method to define mock.On call
+func (_e *StorageApi_Expecter) VerifyBackup() *StorageApi_VerifyBackup_Call {
+	return &StorageApi_VerifyBackup_Call{Call: _e.mock.On("VerifyBackup")}
+}
+
+func (_c *StorageApi_VerifyBackup_Call) Run(run func()) *StorageApi_VerifyBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StorageApi_VerifyBackup_Call) Return(verifyIssues []service.VerifyIssue, err error) *StorageApi_VerifyBackup_Call {
+	_c.Call.Return(verifyIssues, err)
+	return _c
+}
+
+func (_c *StorageApi_VerifyBackup_Call) RunAndReturn(run func() ([]service.VerifyIssue, error)) *StorageApi_VerifyBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
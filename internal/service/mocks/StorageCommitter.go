@@ -0,0 +1,87 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewStorageCommitter creates a new instance of StorageCommitter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageCommitter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageCommitter {
+	mock := &StorageCommitter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// StorageCommitter is an autogenerated mock type for the StorageCommitter type
+type StorageCommitter struct {
+	mock.Mock
+}
+
+type StorageCommitter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageCommitter) EXPECT() *StorageCommitter_Expecter {
+	return &StorageCommitter_Expecter{mock: &_m.Mock}
+}
+
+// CommitStorage provides a mock function for the type StorageCommitter
+func (_mock *StorageCommitter) CommitStorage(subject string) error {
+	ret := _mock.Called(subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitStorage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// StorageCommitter_CommitStorage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitStorage'
+type StorageCommitter_CommitStorage_Call struct {
+	*mock.Call
+}
+
+// CommitStorage is a helper method to define mock.On call
+//   - subject string
+func (_e *StorageCommitter_Expecter) CommitStorage(subject interface{}) *StorageCommitter_CommitStorage_Call {
+	return &StorageCommitter_CommitStorage_Call{Call: _e.mock.On("CommitStorage", subject)}
+}
+
+func (_c *StorageCommitter_CommitStorage_Call) Run(run func(subject string)) *StorageCommitter_CommitStorage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *StorageCommitter_CommitStorage_Call) Return(err error) *StorageCommitter_CommitStorage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *StorageCommitter_CommitStorage_Call) RunAndReturn(run func(subject string) error) *StorageCommitter_CommitStorage_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/esnet/gdg/internal/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewSyncApi creates a new instance of SyncApi. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSyncApi(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SyncApi {
+	mock := &SyncApi{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// SyncApi is an autogenerated mock type for the SyncApi type
+type SyncApi struct {
+	mock.Mock
+}
+
+type SyncApi_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SyncApi) EXPECT() *SyncApi_Expecter {
+	return &SyncApi_Expecter{mock: &_m.Mock}
+}
+
+// SyncContexts provides a mock function for the type SyncApi
+func (_mock *SyncApi) SyncContexts(opts service.SyncOptions) ([]service.SyncResult, error) {
+	ret := _mock.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncContexts")
+	}
+
+	var r0 []service.SyncResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(service.SyncOptions) ([]service.SyncResult, error)); ok {
+		return returnFunc(opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(service.SyncOptions) []service.SyncResult); ok {
+		r0 = returnFunc(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.SyncResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(service.SyncOptions) error); ok {
+		r1 = returnFunc(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SyncApi_SyncContexts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncContexts'
+type SyncApi_SyncContexts_Call struct {
+	*mock.Call
+}
+
+// SyncContexts is a helper method to define mock.On call
+//   - opts service.SyncOptions
+func (_e *SyncApi_Expecter) SyncContexts(opts interface{}) *SyncApi_SyncContexts_Call {
+	return &SyncApi_SyncContexts_Call{Call: _e.mock.On("SyncContexts", opts)}
+}
+
+func (_c *SyncApi_SyncContexts_Call) Run(run func(opts service.SyncOptions)) *SyncApi_SyncContexts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 service.SyncOptions
+		if args[0] != nil {
+			arg0 = args[0].(service.SyncOptions)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *SyncApi_SyncContexts_Call) Return(syncResults []service.SyncResult, err error) *SyncApi_SyncContexts_Call {
+	_c.Call.Return(syncResults, err)
+	return _c
+}
+
+func (_c *SyncApi_SyncContexts_Call) RunAndReturn(run func(opts service.SyncOptions) ([]service.SyncResult, error)) *SyncApi_SyncContexts_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -100,7 +100,7 @@ func (_c *TeamsApi_DeleteTeam_Call) RunAndReturn(run func(filter filters.V2Filte
 }
 
 // DownloadTeams provides a mock function for the type TeamsApi
-func (_mock *TeamsApi) DownloadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (_mock *TeamsApi) DownloadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -108,6 +108,10 @@ func (_mock *TeamsApi) DownloadTeams(filter filters.V2Filter) map[*models.TeamDT
 	}
 
 	var r0 map[*models.TeamDTO][]*models.TeamMemberDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -115,7 +119,12 @@ func (_mock *TeamsApi) DownloadTeams(filter filters.V2Filter) map[*models.TeamDT
 			r0 = ret.Get(0).(map[*models.TeamDTO][]*models.TeamMemberDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // TeamsApi_DownloadTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadTeams'
@@ -142,18 +151,18 @@ func (_c *TeamsApi_DownloadTeams_Call) Run(run func(filter filters.V2Filter)) *T
 	return _c
 }
 
-func (_c *TeamsApi_DownloadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO) *TeamsApi_DownloadTeams_Call {
-	_c.Call.Return(teamDTOToTeamMemberDTOs)
+func (_c *TeamsApi_DownloadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO, err error) *TeamsApi_DownloadTeams_Call {
+	_c.Call.Return(teamDTOToTeamMemberDTOs, err)
 	return _c
 }
 
-func (_c *TeamsApi_DownloadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO) *TeamsApi_DownloadTeams_Call {
+func (_c *TeamsApi_DownloadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)) *TeamsApi_DownloadTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListTeams provides a mock function for the type TeamsApi
-func (_mock *TeamsApi) ListTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (_mock *TeamsApi) ListTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -161,6 +170,10 @@ func (_mock *TeamsApi) ListTeams(filter filters.V2Filter) map[*models.TeamDTO][]
 	}
 
 	var r0 map[*models.TeamDTO][]*models.TeamMemberDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -168,7 +181,12 @@ func (_mock *TeamsApi) ListTeams(filter filters.V2Filter) map[*models.TeamDTO][]
 			r0 = ret.Get(0).(map[*models.TeamDTO][]*models.TeamMemberDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // TeamsApi_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
@@ -195,18 +213,18 @@ func (_c *TeamsApi_ListTeams_Call) Run(run func(filter filters.V2Filter)) *Teams
 	return _c
 }
 
-func (_c *TeamsApi_ListTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO) *TeamsApi_ListTeams_Call {
-	_c.Call.Return(teamDTOToTeamMemberDTOs)
+func (_c *TeamsApi_ListTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO, err error) *TeamsApi_ListTeams_Call {
+	_c.Call.Return(teamDTOToTeamMemberDTOs, err)
 	return _c
 }
 
-func (_c *TeamsApi_ListTeams_Call) RunAndReturn(run func(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO) *TeamsApi_ListTeams_Call {
+func (_c *TeamsApi_ListTeams_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)) *TeamsApi_ListTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadTeams provides a mock function for the type TeamsApi
-func (_mock *TeamsApi) UploadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (_mock *TeamsApi) UploadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -214,6 +232,10 @@ func (_mock *TeamsApi) UploadTeams(filter filters.V2Filter) map[*models.TeamDTO]
 	}
 
 	var r0 map[*models.TeamDTO][]*models.TeamMemberDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -221,7 +243,12 @@ func (_mock *TeamsApi) UploadTeams(filter filters.V2Filter) map[*models.TeamDTO]
 			r0 = ret.Get(0).(map[*models.TeamDTO][]*models.TeamMemberDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // TeamsApi_UploadTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadTeams'
@@ -248,12 +275,12 @@ func (_c *TeamsApi_UploadTeams_Call) Run(run func(filter filters.V2Filter)) *Tea
 	return _c
 }
 
-func (_c *TeamsApi_UploadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO) *TeamsApi_UploadTeams_Call {
-	_c.Call.Return(teamDTOToTeamMemberDTOs)
+func (_c *TeamsApi_UploadTeams_Call) Return(teamDTOToTeamMemberDTOs map[*models.TeamDTO][]*models.TeamMemberDTO, err error) *TeamsApi_UploadTeams_Call {
+	_c.Call.Return(teamDTOToTeamMemberDTOs, err)
 	return _c
 }
 
-func (_c *TeamsApi_UploadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO) *TeamsApi_UploadTeams_Call {
+func (_c *TeamsApi_UploadTeams_Call) RunAndReturn(run func(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error)) *TeamsApi_UploadTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -39,7 +39,7 @@ func (_m *UsersApi) EXPECT() *UsersApi_Expecter {
 }
 
 // DeleteAllUsers provides a mock function for the type UsersApi
-func (_mock *UsersApi) DeleteAllUsers(filter filters.V2Filter) []string {
+func (_mock *UsersApi) DeleteAllUsers(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -47,6 +47,10 @@ func (_mock *UsersApi) DeleteAllUsers(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -54,7 +58,12 @@ func (_mock *UsersApi) DeleteAllUsers(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // UsersApi_DeleteAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllUsers'
@@ -81,18 +90,18 @@ func (_c *UsersApi_DeleteAllUsers_Call) Run(run func(filter filters.V2Filter)) *
 	return _c
 }
 
-func (_c *UsersApi_DeleteAllUsers_Call) Return(strings []string) *UsersApi_DeleteAllUsers_Call {
-	_c.Call.Return(strings)
+func (_c *UsersApi_DeleteAllUsers_Call) Return(strings []string, err error) *UsersApi_DeleteAllUsers_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *UsersApi_DeleteAllUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *UsersApi_DeleteAllUsers_Call {
+func (_c *UsersApi_DeleteAllUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *UsersApi_DeleteAllUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownloadUsers provides a mock function for the type UsersApi
-func (_mock *UsersApi) DownloadUsers(filter filters.V2Filter) []string {
+func (_mock *UsersApi) DownloadUsers(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -100,6 +109,10 @@ func (_mock *UsersApi) DownloadUsers(filter filters.V2Filter) []string {
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -107,7 +120,12 @@ func (_mock *UsersApi) DownloadUsers(filter filters.V2Filter) []string {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // UsersApi_DownloadUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadUsers'
@@ -134,12 +152,12 @@ func (_c *UsersApi_DownloadUsers_Call) Run(run func(filter filters.V2Filter)) *U
 	return _c
 }
 
-func (_c *UsersApi_DownloadUsers_Call) Return(strings []string) *UsersApi_DownloadUsers_Call {
-	_c.Call.Return(strings)
+func (_c *UsersApi_DownloadUsers_Call) Return(strings []string, err error) *UsersApi_DownloadUsers_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *UsersApi_DownloadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *UsersApi_DownloadUsers_Call {
+func (_c *UsersApi_DownloadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *UsersApi_DownloadUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -200,7 +218,7 @@ func (_c *UsersApi_GetUserInfo_Call) RunAndReturn(run func() (*models.UserProfil
 }
 
 // ListUsers provides a mock function for the type UsersApi
-func (_mock *UsersApi) ListUsers(filter filters.V2Filter) []*models.UserSearchHitDTO {
+func (_mock *UsersApi) ListUsers(filter filters.V2Filter) ([]*models.UserSearchHitDTO, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -208,6 +226,10 @@ func (_mock *UsersApi) ListUsers(filter filters.V2Filter) []*models.UserSearchHi
 	}
 
 	var r0 []*models.UserSearchHitDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]*models.UserSearchHitDTO, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []*models.UserSearchHitDTO); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -215,7 +237,12 @@ func (_mock *UsersApi) ListUsers(filter filters.V2Filter) []*models.UserSearchHi
 			r0 = ret.Get(0).([]*models.UserSearchHitDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // UsersApi_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
@@ -242,12 +269,12 @@ func (_c *UsersApi_ListUsers_Call) Run(run func(filter filters.V2Filter)) *Users
 	return _c
 }
 
-func (_c *UsersApi_ListUsers_Call) Return(userSearchHitDTOs []*models.UserSearchHitDTO) *UsersApi_ListUsers_Call {
-	_c.Call.Return(userSearchHitDTOs)
+func (_c *UsersApi_ListUsers_Call) Return(userSearchHitDTOs []*models.UserSearchHitDTO, err error) *UsersApi_ListUsers_Call {
+	_c.Call.Return(userSearchHitDTOs, err)
 	return _c
 }
 
-func (_c *UsersApi_ListUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []*models.UserSearchHitDTO) *UsersApi_ListUsers_Call {
+func (_c *UsersApi_ListUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]*models.UserSearchHitDTO, error)) *UsersApi_ListUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -313,7 +340,7 @@ func (_c *UsersApi_PromoteUser_Call) RunAndReturn(run func(userLogin string) (st
 }
 
 // UploadUsers provides a mock function for the type UsersApi
-func (_mock *UsersApi) UploadUsers(filter filters.V2Filter) []domain.UserProfileWithAuth {
+func (_mock *UsersApi) UploadUsers(filter filters.V2Filter) ([]domain.UserProfileWithAuth, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -321,6 +348,10 @@ func (_mock *UsersApi) UploadUsers(filter filters.V2Filter) []domain.UserProfile
 	}
 
 	var r0 []domain.UserProfileWithAuth
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]domain.UserProfileWithAuth, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []domain.UserProfileWithAuth); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -328,7 +359,12 @@ func (_mock *UsersApi) UploadUsers(filter filters.V2Filter) []domain.UserProfile
 			r0 = ret.Get(0).([]domain.UserProfileWithAuth)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // UsersApi_UploadUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadUsers'
@@ -355,12 +391,12 @@ func (_c *UsersApi_UploadUsers_Call) Run(run func(filter filters.V2Filter)) *Use
 	return _c
 }
 
-func (_c *UsersApi_UploadUsers_Call) Return(userProfileWithAuths []domain.UserProfileWithAuth) *UsersApi_UploadUsers_Call {
-	_c.Call.Return(userProfileWithAuths)
+func (_c *UsersApi_UploadUsers_Call) Return(userProfileWithAuths []domain.UserProfileWithAuth, err error) *UsersApi_UploadUsers_Call {
+	_c.Call.Return(userProfileWithAuths, err)
 	return _c
 }
 
-func (_c *UsersApi_UploadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) []domain.UserProfileWithAuth) *UsersApi_UploadUsers_Call {
+func (_c *UsersApi_UploadUsers_Call) RunAndReturn(run func(filter filters.V2Filter) ([]domain.UserProfileWithAuth, error)) *UsersApi_UploadUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,8 @@
 package mocks
 
 import (
-	"github.com/esnet/gdg/internal/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/pkg/service/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,8 +37,70 @@ func (_m *organizationCrudApi) EXPECT() *organizationCrudApi_Expecter {
 	return &organizationCrudApi_Expecter{mock: &_m.Mock}
 }
 
+// DeleteAllOrganizations provides a mock function for the type organizationCrudApi
+func (_mock *organizationCrudApi) DeleteAllOrganizations(filter filters.V2Filter) ([]string, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllOrganizations")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// organizationCrudApi_DeleteAllOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAllOrganizations'
+type organizationCrudApi_DeleteAllOrganizations_Call struct {
+	*mock.Call
+}
+
+// DeleteAllOrganizations is a helper method to define mock.On call
+//   - filter filters.V2Filter
+func (_e *organizationCrudApi_Expecter) DeleteAllOrganizations(filter interface{}) *organizationCrudApi_DeleteAllOrganizations_Call {
+	return &organizationCrudApi_DeleteAllOrganizations_Call{Call: _e.mock.On("DeleteAllOrganizations", filter)}
+}
+
+func (_c *organizationCrudApi_DeleteAllOrganizations_Call) Run(run func(filter filters.V2Filter)) *organizationCrudApi_DeleteAllOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 filters.V2Filter
+		if args[0] != nil {
+			arg0 = args[0].(filters.V2Filter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *organizationCrudApi_DeleteAllOrganizations_Call) Return(strings []string, err error) *organizationCrudApi_DeleteAllOrganizations_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *organizationCrudApi_DeleteAllOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *organizationCrudApi_DeleteAllOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DownloadOrganizations provides a mock function for the type organizationCrudApi
-func (_mock *organizationCrudApi) DownloadOrganizations(filter filters.V2Filter) []string {
+func (_mock *organizationCrudApi) DownloadOrganizations(filter filters.V2Filter) ([]string, error) {
 	ret := _mock.Called(filter)
 
 	if len(ret) == 0 {
@@ -46,6 +108,10 @@ func (_mock *organizationCrudApi) DownloadOrganizations(filter filters.V2Filter)
 	}
 
 	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) ([]string, error)); ok {
+		return returnFunc(filter)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
 		r0 = returnFunc(filter)
 	} else {
@@ -53,7 +119,12 @@ func (_mock *organizationCrudApi) DownloadOrganizations(filter filters.V2Filter)
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // organizationCrudApi_DownloadOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadOrganizations'
@@ -80,18 +151,18 @@ func (_c *organizationCrudApi_DownloadOrganizations_Call) Run(run func(filter fi
 	return _c
 }
 
-func (_c *organizationCrudApi_DownloadOrganizations_Call) Return(strings []string) *organizationCrudApi_DownloadOrganizations_Call {
-	_c.Call.Return(strings)
+func (_c *organizationCrudApi_DownloadOrganizations_Call) Return(strings []string, err error) *organizationCrudApi_DownloadOrganizations_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *organizationCrudApi_DownloadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *organizationCrudApi_DownloadOrganizations_Call {
+func (_c *organizationCrudApi_DownloadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) ([]string, error)) *organizationCrudApi_DownloadOrganizations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListOrganizations provides a mock function for the type organizationCrudApi
-func (_mock *organizationCrudApi) ListOrganizations(filter filters.V2Filter, withPreferences bool) []*domain.OrgsDTOWithPreferences {
+func (_mock *organizationCrudApi) ListOrganizations(filter filters.V2Filter, withPreferences bool) ([]*domain.OrgsDTOWithPreferences, error) {
 	ret := _mock.Called(filter, withPreferences)
 
 	if len(ret) == 0 {
@@ -99,6 +170,10 @@ func (_mock *organizationCrudApi) ListOrganizations(filter filters.V2Filter, wit
 	}
 
 	var r0 []*domain.OrgsDTOWithPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, bool) ([]*domain.OrgsDTOWithPreferences, error)); ok {
+		return returnFunc(filter, withPreferences)
+	}
 	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, bool) []*domain.OrgsDTOWithPreferences); ok {
 		r0 = returnFunc(filter, withPreferences)
 	} else {
@@ -106,7 +181,12 @@ func (_mock *organizationCrudApi) ListOrganizations(filter filters.V2Filter, wit
 			r0 = ret.Get(0).([]*domain.OrgsDTOWithPreferences)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, bool) error); ok {
+		r1 = returnFunc(filter, withPreferences)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // organizationCrudApi_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
@@ -139,33 +219,42 @@ func (_c *organizationCrudApi_ListOrganizations_Call) Run(run func(filter filter
 	return _c
 }
 
-func (_c *organizationCrudApi_ListOrganizations_Call) Return(orgsDTOWithPreferencess []*domain.OrgsDTOWithPreferences) *organizationCrudApi_ListOrganizations_Call {
-	_c.Call.Return(orgsDTOWithPreferencess)
+func (_c *organizationCrudApi_ListOrganizations_Call) Return(orgsDTOWithPreferencess []*domain.OrgsDTOWithPreferences, err error) *organizationCrudApi_ListOrganizations_Call {
+	_c.Call.Return(orgsDTOWithPreferencess, err)
 	return _c
 }
 
-func (_c *organizationCrudApi_ListOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, withPreferences bool) []*domain.OrgsDTOWithPreferences) *organizationCrudApi_ListOrganizations_Call {
+func (_c *organizationCrudApi_ListOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, withPreferences bool) ([]*domain.OrgsDTOWithPreferences, error)) *organizationCrudApi_ListOrganizations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadOrganizations provides a mock function for the type organizationCrudApi
-func (_mock *organizationCrudApi) UploadOrganizations(filter filters.V2Filter) []string {
-	ret := _mock.Called(filter)
+func (_mock *organizationCrudApi) UploadOrganizations(filter filters.V2Filter, renames map[string]string) ([]string, error) {
+	ret := _mock.Called(filter, renames)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UploadOrganizations")
 	}
 
 	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter) []string); ok {
-		r0 = returnFunc(filter)
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, map[string]string) ([]string, error)); ok {
+		return returnFunc(filter, renames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(filters.V2Filter, map[string]string) []string); ok {
+		r0 = returnFunc(filter, renames)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(filters.V2Filter, map[string]string) error); ok {
+		r1 = returnFunc(filter, renames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // organizationCrudApi_UploadOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadOrganizations'
@@ -175,29 +264,35 @@ type organizationCrudApi_UploadOrganizations_Call struct {
 
 // UploadOrganizations is a helper method to define mock.On call
 //   - filter filters.V2Filter
-func (_e *organizationCrudApi_Expecter) UploadOrganizations(filter interface{}) *organizationCrudApi_UploadOrganizations_Call {
-	return &organizationCrudApi_UploadOrganizations_Call{Call: _e.mock.On("UploadOrganizations", filter)}
+//   - renames map[string]string
+func (_e *organizationCrudApi_Expecter) UploadOrganizations(filter interface{}, renames interface{}) *organizationCrudApi_UploadOrganizations_Call {
+	return &organizationCrudApi_UploadOrganizations_Call{Call: _e.mock.On("UploadOrganizations", filter, renames)}
 }
 
-func (_c *organizationCrudApi_UploadOrganizations_Call) Run(run func(filter filters.V2Filter)) *organizationCrudApi_UploadOrganizations_Call {
+func (_c *organizationCrudApi_UploadOrganizations_Call) Run(run func(filter filters.V2Filter, renames map[string]string)) *organizationCrudApi_UploadOrganizations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 filters.V2Filter
 		if args[0] != nil {
 			arg0 = args[0].(filters.V2Filter)
 		}
+		var arg1 map[string]string
+		if args[1] != nil {
+			arg1 = args[1].(map[string]string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *organizationCrudApi_UploadOrganizations_Call) Return(strings []string) *organizationCrudApi_UploadOrganizations_Call {
-	_c.Call.Return(strings)
+func (_c *organizationCrudApi_UploadOrganizations_Call) Return(strings []string, err error) *organizationCrudApi_UploadOrganizations_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *organizationCrudApi_UploadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter) []string) *organizationCrudApi_UploadOrganizations_Call {
+func (_c *organizationCrudApi_UploadOrganizations_Call) RunAndReturn(run func(filter filters.V2Filter, renames map[string]string) ([]string, error)) *organizationCrudApi_UploadOrganizations_Call {
 	_c.Call.Return(run)
 	return _c
 }
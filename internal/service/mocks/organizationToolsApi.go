@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/esnet/gdg/internal/service"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,7 +38,7 @@ func (_m *organizationToolsApi) EXPECT() *organizationToolsApi_Expecter {
 }
 
 // GetTokenOrganization provides a mock function for the type organizationToolsApi
-func (_mock *organizationToolsApi) GetTokenOrganization() *models.OrgDetailsDTO {
+func (_mock *organizationToolsApi) GetTokenOrganization() (*models.OrgDetailsDTO, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -45,6 +46,10 @@ func (_mock *organizationToolsApi) GetTokenOrganization() *models.OrgDetailsDTO
 	}
 
 	var r0 *models.OrgDetailsDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*models.OrgDetailsDTO, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() *models.OrgDetailsDTO); ok {
 		r0 = returnFunc()
 	} else {
@@ -52,7 +57,12 @@ func (_mock *organizationToolsApi) GetTokenOrganization() *models.OrgDetailsDTO
 			r0 = ret.Get(0).(*models.OrgDetailsDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // organizationToolsApi_GetTokenOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenOrganization'
@@ -72,18 +82,18 @@ func (_c *organizationToolsApi_GetTokenOrganization_Call) Run(run func()) *organ
 	return _c
 }
 
-func (_c *organizationToolsApi_GetTokenOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO) *organizationToolsApi_GetTokenOrganization_Call {
-	_c.Call.Return(orgDetailsDTO)
+func (_c *organizationToolsApi_GetTokenOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO, err error) *organizationToolsApi_GetTokenOrganization_Call {
+	_c.Call.Return(orgDetailsDTO, err)
 	return _c
 }
 
-func (_c *organizationToolsApi_GetTokenOrganization_Call) RunAndReturn(run func() *models.OrgDetailsDTO) *organizationToolsApi_GetTokenOrganization_Call {
+func (_c *organizationToolsApi_GetTokenOrganization_Call) RunAndReturn(run func() (*models.OrgDetailsDTO, error)) *organizationToolsApi_GetTokenOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetUserOrganization provides a mock function for the type organizationToolsApi
-func (_mock *organizationToolsApi) GetUserOrganization() *models.OrgDetailsDTO {
+func (_mock *organizationToolsApi) GetUserOrganization() (*models.OrgDetailsDTO, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -91,6 +101,10 @@ func (_mock *organizationToolsApi) GetUserOrganization() *models.OrgDetailsDTO {
 	}
 
 	var r0 *models.OrgDetailsDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*models.OrgDetailsDTO, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() *models.OrgDetailsDTO); ok {
 		r0 = returnFunc()
 	} else {
@@ -98,7 +112,12 @@ func (_mock *organizationToolsApi) GetUserOrganization() *models.OrgDetailsDTO {
 			r0 = ret.Get(0).(*models.OrgDetailsDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // organizationToolsApi_GetUserOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserOrganization'
@@ -118,12 +137,12 @@ func (_c *organizationToolsApi_GetUserOrganization_Call) Run(run func()) *organi
 	return _c
 }
 
-func (_c *organizationToolsApi_GetUserOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO) *organizationToolsApi_GetUserOrganization_Call {
-	_c.Call.Return(orgDetailsDTO)
+func (_c *organizationToolsApi_GetUserOrganization_Call) Return(orgDetailsDTO *models.OrgDetailsDTO, err error) *organizationToolsApi_GetUserOrganization_Call {
+	_c.Call.Return(orgDetailsDTO, err)
 	return _c
 }
 
-func (_c *organizationToolsApi_GetUserOrganization_Call) RunAndReturn(run func() *models.OrgDetailsDTO) *organizationToolsApi_GetUserOrganization_Call {
+func (_c *organizationToolsApi_GetUserOrganization_Call) RunAndReturn(run func() (*models.OrgDetailsDTO, error)) *organizationToolsApi_GetUserOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -290,3 +309,65 @@ func (_c *organizationToolsApi_SetUserOrganizations_Call) RunAndReturn(run func(
 	_c.Call.Return(run)
 	return _c
 }
+
+// WithOrganization provides a mock function for the type organizationToolsApi
+func (_mock *organizationToolsApi) WithOrganization(name string) (service.GrafanaService, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithOrganization")
+	}
+
+	var r0 service.GrafanaService
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (service.GrafanaService, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) service.GrafanaService); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.GrafanaService)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// organizationToolsApi_WithOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrganization'
+type organizationToolsApi_WithOrganization_Call struct {
+	*mock.Call
+}
+
+// WithOrganization is a helper method to define mock.On call
+//   - name string
+func (_e *organizationToolsApi_Expecter) WithOrganization(name interface{}) *organizationToolsApi_WithOrganization_Call {
+	return &organizationToolsApi_WithOrganization_Call{Call: _e.mock.On("WithOrganization", name)}
+}
+
+func (_c *organizationToolsApi_WithOrganization_Call) Run(run func(name string)) *organizationToolsApi_WithOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *organizationToolsApi_WithOrganization_Call) Return(grafanaService service.GrafanaService, err error) *organizationToolsApi_WithOrganization_Call {
+	_c.Call.Return(grafanaService, err)
+	return _c
+}
+
+func (_c *organizationToolsApi_WithOrganization_Call) RunAndReturn(run func(name string) (service.GrafanaService, error)) *organizationToolsApi_WithOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
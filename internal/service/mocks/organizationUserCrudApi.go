@@ -157,7 +157,7 @@ func (_c *organizationUserCrudApi_DeleteUserFromOrg_Call) RunAndReturn(run func(
 }
 
 // ListOrgUsers provides a mock function for the type organizationUserCrudApi
-func (_mock *organizationUserCrudApi) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
+func (_mock *organizationUserCrudApi) ListOrgUsers(orgId int64) ([]*models.OrgUserDTO, error) {
 	ret := _mock.Called(orgId)
 
 	if len(ret) == 0 {
@@ -165,6 +165,10 @@ func (_mock *organizationUserCrudApi) ListOrgUsers(orgId int64) []*models.OrgUse
 	}
 
 	var r0 []*models.OrgUserDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]*models.OrgUserDTO, error)); ok {
+		return returnFunc(orgId)
+	}
 	if returnFunc, ok := ret.Get(0).(func(int64) []*models.OrgUserDTO); ok {
 		r0 = returnFunc(orgId)
 	} else {
@@ -172,7 +176,12 @@ func (_mock *organizationUserCrudApi) ListOrgUsers(orgId int64) []*models.OrgUse
 			r0 = ret.Get(0).([]*models.OrgUserDTO)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // organizationUserCrudApi_ListOrgUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrgUsers'
@@ -199,12 +208,12 @@ func (_c *organizationUserCrudApi_ListOrgUsers_Call) Run(run func(orgId int64))
 	return _c
 }
 
-func (_c *organizationUserCrudApi_ListOrgUsers_Call) Return(orgUserDTOs []*models.OrgUserDTO) *organizationUserCrudApi_ListOrgUsers_Call {
-	_c.Call.Return(orgUserDTOs)
+func (_c *organizationUserCrudApi_ListOrgUsers_Call) Return(orgUserDTOs []*models.OrgUserDTO, err error) *organizationUserCrudApi_ListOrgUsers_Call {
+	_c.Call.Return(orgUserDTOs, err)
 	return _c
 }
 
-func (_c *organizationUserCrudApi_ListOrgUsers_Call) RunAndReturn(run func(orgId int64) []*models.OrgUserDTO) *organizationUserCrudApi_ListOrgUsers_Call {
+func (_c *organizationUserCrudApi_ListOrgUsers_Call) RunAndReturn(run func(orgId int64) ([]*models.OrgUserDTO, error)) *organizationUserCrudApi_ListOrgUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/models"
 )
 
@@ -13,7 +14,11 @@ func (s *DashNGoImpl) GetOrgPreferences(orgName string) (*models.PreferencesSpec
 	if !s.grafanaConf.IsGrafanaAdmin() {
 		return nil, errors.New("no valid Grafana Admin configured, cannot retrieve Organizations Preferences")
 	}
-	orgPreferences, err := s.GetBasicClientWithOpts(GetOrgNameClientOpts(s.gdgConfig)).Org.GetOrgPreferences()
+	opts, err := s.getOrgClientOpts(orgName)
+	if err != nil {
+		return nil, err
+	}
+	orgPreferences, err := s.GetBasicClientWithOpts(opts).Org.GetOrgPreferences()
 	if err != nil {
 		return nil, err
 	}
@@ -37,10 +42,34 @@ func (s *DashNGoImpl) UploadOrgPreferences(orgName string, preferenceRequest *mo
 	update.Theme = preferenceRequest.Theme
 	update.WeekStart = preferenceRequest.WeekStart
 
-	_, err := s.GetBasicClientWithOpts(GetOrgNameClientOpts(s.gdgConfig)).Org.UpdateOrgPreferences(update)
+	opts, err := s.getOrgClientOpts(orgName)
+	if err != nil {
+		return err
+	}
+	_, err = s.GetBasicClientWithOpts(opts).Org.UpdateOrgPreferences(update)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// getOrgClientOpts returns the client options targeting the given organization, falls back on the configured org
+// when no name is given.
+func (s *DashNGoImpl) getOrgClientOpts(orgName string) (NewClientOpts, error) {
+	if orgName == "" {
+		return s.orgNameClientOpts(), nil
+	}
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	org, err := adminClient.Orgs.GetOrgByName(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find organization '%s': %w", orgName, err)
+	}
+	orgID := org.GetPayload().ID
+	return func(transportConfig *client.TransportConfig) {
+		transportConfig.OrgID = orgID
+	}, nil
+}
@@ -4,9 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
-	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -14,20 +12,22 @@ import (
 	configDomain "github.com/esnet/gdg/internal/config/domain"
 	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/service/filters/v2"
 
 	"github.com/esnet/gdg/internal/service/filters"
 	"github.com/esnet/gdg/internal/tools"
+	"github.com/esnet/gdg/internal/tools/ptr"
 	"github.com/gosimple/slug"
 	"github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/orgs"
+	"github.com/grafana/grafana-openapi-client-go/client/quota"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/tidwall/gjson"
 )
 
-func setupOrgReaders(filterObj filters.V2Filter) {
+func setupOrgReaders(filterObj filters.V2Filter) error {
 	obj := models.OrgDTO{}
 	err := filterObj.RegisterReader(reflect.TypeOf(obj), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(models.OrgDTO)
@@ -43,7 +43,7 @@ func setupOrgReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Org Filter, aborting.")
+		return fmt.Errorf("unable to create a valid org filter: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.([]byte)
@@ -66,15 +66,18 @@ func setupOrgReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Org Filter, aborting.")
+		return fmt.Errorf("unable to create a valid org filter: %w", err)
 	}
+	return nil
 }
 
-func NewOrganizationFilter(args ...string) filters.V2Filter {
+func NewOrganizationFilter(args ...string) (filters.V2Filter, error) {
 	filterObj := v2.NewBaseFilter()
-	setupOrgReaders(filterObj)
+	if err := setupOrgReaders(filterObj); err != nil {
+		return nil, err
+	}
 	if len(args) == 0 || args[0] == "" {
-		return filterObj
+		return filterObj, nil
 	}
 
 	filterObj.AddValidation(filters.OrgFilter, func(value any, expected any) error {
@@ -91,25 +94,32 @@ func NewOrganizationFilter(args ...string) filters.V2Filter {
 		return nil
 	}, args[0])
 
-	return filterObj
+	return filterObj, nil
 }
 
-func (s *DashNGoImpl) sanitizeOrganizationMembership() {
-	allOrgs := s.ListOrganizations(NewOrganizationFilter(), false)
+func (s *DashNGoImpl) sanitizeOrganizationMembership() error {
+	orgFilter, err := NewOrganizationFilter()
+	if err != nil {
+		return err
+	}
+	allOrgs, err := s.ListOrganizations(orgFilter, false)
+	if err != nil {
+		return err
+	}
 	userOrgs, err := s.ListUserOrganizations()
 	if err != nil {
 		slog.Warn("unable to list user organizations, aborting sanitization operation")
-		return
+		return nil
 	}
 	if len(userOrgs) == len(allOrgs) {
 		slog.Debug("Nothing to do, returning")
-		return
+		return nil
 	}
 
 	userInfo, err := s.GetUserInfo()
 	if err != nil {
 		slog.Error("Unable to retrieve current user, skipping Org bootstrap")
-		return
+		return nil
 	}
 	userId := userInfo.ID
 	currentUserOrgs := map[int64]bool{}
@@ -125,11 +135,13 @@ func (s *DashNGoImpl) sanitizeOrganizationMembership() {
 				slog.Info("user is not a member of org", slog.Any("userId", userInfo.ID), slog.String("userName", userInfo.Login), slog.String("Organization", org.Organization.Name))
 			}
 		}
-		os.Exit(1)
+		return errors.New("the configured grafana admin is not a member of every organization")
 	}
 
 	slog.Info("You've configured a grafana admin, but you are not a member of every Org.  The grafanaAdmin needs to be a member of every organization in order for GDG to operate successfully.")
-	tools.GetUserConfirmation("Would you like to continue and add the configured grafana admin to all organizations as an 'admin'? (y/n) ", "", true)
+	if !tools.GetUserConfirmation("Would you like to continue and add the configured grafana admin to all organizations as an 'admin'? (y/n) ", "", false) {
+		return errors.New("the configured grafana admin is not a member of every organization")
+	}
 
 	for _, org := range allOrgs {
 		if _, ok := currentUserOrgs[org.Organization.ID]; !ok {
@@ -143,22 +155,22 @@ func (s *DashNGoImpl) sanitizeOrganizationMembership() {
 
 		}
 	}
+	return nil
 }
 
 // InitOrganizations will context switch to configured organization and invoke a different call depending on the access level.
-func (s *DashNGoImpl) InitOrganizations() {
-	var orgInfo *models.OrgDetailsDTO
+func (s *DashNGoImpl) InitOrganizations() error {
 	var orgEntity models.OrgDetailsDTO
 
 	if s.grafanaConf.IsGrafanaAdmin() || s.grafanaConf.IsBasicAuth() {
-		orgInfo = s.GetUserOrganization()
-		if orgInfo == nil {
-			log.Fatal("Unable to retrieve requested user's org")
+		orgInfo, err := s.GetUserOrganization()
+		if err != nil {
+			return fmt.Errorf("unable to retrieve requested user's org: %w", err)
 		}
 		if orgInfo.Name != s.grafanaConf.GetOrganizationName() {
 			userOrgs, err := s.ListUserOrganizations()
 			if err != nil {
-				log.Fatal("Unable to switch user's Org")
+				return fmt.Errorf("unable to switch user's org: %w", err)
 			}
 			found := false
 			for _, org := range userOrgs {
@@ -170,14 +182,14 @@ func (s *DashNGoImpl) InitOrganizations() {
 				}
 			}
 			if !found {
-				log.Fatalf("User does not have access to org: '%s', Unable to switch user's Org", s.grafanaConf.GetOrganizationName())
+				return fmt.Errorf("user does not have access to org: '%s', unable to switch user's org", s.grafanaConf.GetOrganizationName())
 			}
 
 		}
 		if orgInfo.Name != s.grafanaConf.GetOrganizationName() {
 			err := s.SetUserOrganizations(orgEntity.ID)
 			if err != nil {
-				log.Fatal("Unable to switch user's Org")
+				return fmt.Errorf("unable to switch user's org: %w", err)
 			}
 		}
 
@@ -187,8 +199,9 @@ func (s *DashNGoImpl) InitOrganizations() {
 
 	if s.grafanaConf.IsGrafanaAdmin() {
 		slog.Info("Running Sanity Check of Organization Membership")
-		s.sanitizeOrganizationMembership()
+		return s.sanitizeOrganizationMembership()
 	}
+	return nil
 }
 
 func (s *DashNGoImpl) SetOrganizationByName(name string, useSlug bool) error {
@@ -210,41 +223,78 @@ func (s *DashNGoImpl) SetOrganizationByName(name string, useSlug bool) error {
 			}
 		}
 		if requestOrg == nil {
-			log.Fatalf("unable to set org.  Please ensure you have the correct permissions and the org name is correct")
+			return fmt.Errorf("unable to set org '%s', please ensure you have the correct permissions and the org name is correct: %w", name, ErrNotFound)
 		}
 		s.grafanaConf.OrganizationName = requestOrg.Name
 	} else {
-		tokenOrg := s.GetTokenOrganization()
+		tokenOrg, err := s.GetTokenOrganization()
+		if err != nil {
+			return err
+		}
 		orgName := tokenOrg.Name
 		if useSlug {
 			orgName = slug.Make(orgName)
 		}
 		if orgName != name {
-			log.Fatalf("you have no BasicAuth configured, and token org are non-changeable.  Please configure a different token associated with Org %s, OR configure basic auth.", orgName)
+			return fmt.Errorf("you have no BasicAuth configured, and token org are non-changeable.  Please configure a different token associated with Org %s, OR configure basic auth", orgName)
 		}
 	}
 
 	return s.gdgConfig.SaveToDisk(false)
 }
 
+// WithOrganization returns a copy of the service scoped to the given organization, unlike SetOrganizationByName the
+// configuration is not persisted.  The copy uses the org's watched folders overrides and storage path, its requests
+// target the organization by ID so neither the user's active organization nor the service are changed.
+func (s *DashNGoImpl) WithOrganization(name string) (GrafanaService, error) {
+	return s.forOrganization(name)
+}
+
+// forOrganization returns a copy of the service, and of its configuration, scoped to the given organization
+func (s *DashNGoImpl) forOrganization(name string) (*DashNGoImpl, error) {
+	if !s.grafanaConf.IsGrafanaAdmin() && !s.grafanaConf.IsBasicAuth() {
+		tokenOrg, err := s.GetTokenOrganization()
+		if err != nil {
+			return nil, err
+		}
+		if tokenOrg.Name != name {
+			return nil, fmt.Errorf("tokens are bound to a single organization, cannot switch to org: '%s'", name)
+		}
+		return s, nil
+	}
+	userOrgs, err := s.ListUserOrganizations()
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range userOrgs {
+		if org.Name == name {
+			cfg, err := s.gdgConfig.ForOrganization(org.Name)
+			if err != nil {
+				return nil, err
+			}
+			scoped := s.WithContext(s.requestContext())
+			setupConfigData(cfg, scoped)
+			return scoped, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user does not have access to org: '%s'", name)
+}
+
 // ListOrganizations List all dashboards
-func (s *DashNGoImpl) ListOrganizations(filter filters.V2Filter, withPreferences bool) []*domain.OrgsDTOWithPreferences {
-	if !s.grafanaConf.IsGrafanaAdmin() {
-		slog.Error("No valid Grafana Admin configured, cannot retrieve Organizations List")
-		return nil
+func (s *DashNGoImpl) ListOrganizations(filter filters.V2Filter, withPreferences bool) ([]*domain.OrgsDTOWithPreferences, error) {
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return nil, err
 	}
-	orgList, err := s.GetAdminClient().Orgs.SearchOrgs(orgs.NewSearchOrgsParams())
+	orgList, err := adminClient.Orgs.SearchOrgs(orgs.NewSearchOrgsParams())
 	if err != nil {
-		var swaggerErr *orgs.SearchOrgsForbidden
-		msg := "Cannot retrieve Orgs, you need additional permissions"
-		switch {
-		case errors.As(err, &swaggerErr):
-			var castError *orgs.SearchOrgsForbidden
-			errors.As(err, &castError)
-			log.Fatalf("%s, message:%s", msg, *castError.GetPayload().Message)
-		default:
-			log.Fatalf("%s, err: %v", msg, err)
+		msg := "cannot retrieve orgs, you need additional permissions"
+		var castError *orgs.SearchOrgsForbidden
+		if errors.As(err, &castError) {
+			msg = fmt.Sprintf("%s, message: %s", msg, ptr.ValueOrDefault(castError.GetPayload().Message, ""))
 		}
+		return nil, fmt.Errorf("%s: %w", msg, err)
 	}
 
 	var resultsData []*domain.OrgsDTOWithPreferences
@@ -263,111 +313,186 @@ func (s *DashNGoImpl) ListOrganizations(filter filters.V2Filter, withPreferences
 		}
 	}
 
-	return resultsData
+	return resultsData, nil
 }
 
-// DownloadOrganizations Download organizations
-func (s *DashNGoImpl) DownloadOrganizations(filter filters.V2Filter) []string {
-	if !s.grafanaConf.IsGrafanaAdmin() {
-		slog.Error("No valid Grafana Admin configured, cannot retrieve Organizations")
-		return nil
+// DownloadOrganizations Download organizations along with their preferences and quotas
+func (s *DashNGoImpl) DownloadOrganizations(filter filters.V2Filter) ([]string, error) {
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return nil, err
 	}
 	var (
 		dsPacked  []byte
-		err       error
 		dataFiles []string
 	)
 
-	orgsListing := s.ListOrganizations(filter, true)
+	orgsListing, err := s.ListOrganizations(filter, true)
+	if err != nil {
+		return nil, err
+	}
 	for _, organisation := range orgsListing {
+		quotas, quotaErr := adminClient.Quota.GetOrgQuota(organisation.Organization.ID)
+		if quotaErr != nil {
+			slog.Warn("unable to retrieve org quotas", "organization", organisation.Organization.Name, "err", quotaErr)
+		} else {
+			organisation.Quotas = quotas.GetPayload()
+		}
 		if dsPacked, err = json.MarshalIndent(organisation, "", "	"); err != nil {
-			slog.Error("Unable to serialize organization object", "err", err, "organization", organisation.Organization.Name)
+			s.recordFailure(resourceTypes.OrganizationResource, organisation.Organization.Name, fmt.Errorf("unable to serialize organization object: %w", err))
 			continue
 		}
-		dsPath := buildResourcePath(s.grafanaConf, slug.Make(organisation.Organization.Name), resourceTypes.OrganizationResource, s.isLocal(), s.GetGlobals().ClearOutput)
-		if err = s.storage.WriteFile(dsPath, dsPacked); err != nil {
-			slog.Error("Unable to write file", "err", err.Error(), "organization", slug.Make(organisation.Organization.Name))
+		dsPath := buildResourcePath(s.grafanaConf, slug.Make(organisation.Organization.Name), resourceTypes.OrganizationResource, s.isLocal(), s.clearedFolders())
+		if err = s.writeBackupFile(dsPath, dsPacked); err != nil {
+			s.recordFailure(resourceTypes.OrganizationResource, organisation.Organization.Name, fmt.Errorf("unable to write file: %w", err))
 		} else {
+			s.recordSuccess(resourceTypes.OrganizationResource, organisation.Organization.Name)
 			dataFiles = append(dataFiles, dsPath)
 		}
 	}
 
-	return dataFiles
+	return dataFiles, nil
 }
 
-// UploadOrganizations Upload organizations to Grafana
-func (s *DashNGoImpl) UploadOrganizations(filter filters.V2Filter) []string {
-	if !s.grafanaConf.IsGrafanaAdmin() {
-		slog.Error("No valid Grafana Admin configured, cannot upload Organizations")
-		return nil
+// UploadOrganizations Upload organizations to Grafana.  Existing organizations are matched by name and reconciled,
+// meaning their preferences and quotas are updated.  renames optionally maps the org name found in the backup to the
+// name it should have in grafana, an existing org with the old name is renamed.  Returns the created or renamed orgs.
+func (s *DashNGoImpl) UploadOrganizations(filter filters.V2Filter, renames map[string]string) ([]string, error) {
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return nil, err
 	}
 	var (
-		result    []string
-		rawFolder []byte
+		result  []string
+		rawData []byte
 	)
 	orgName := s.grafanaConf.GetOrganizationName()
-	filesInDir, err := s.storage.FindAllFiles(s.grafanaConf.GetPath(resourceTypes.OrganizationResource, orgName), false)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), s.grafanaConf.GetPath(resourceTypes.OrganizationResource, orgName), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read organization imports: %w", err)
+	}
+	orgFilter, err := NewOrganizationFilter()
 	if err != nil {
-		log.Fatalf("Failed to read folders imports, err: %v", err)
+		return nil, err
 	}
-	orgListing := s.ListOrganizations(filter, false)
-	orgMap := map[string]bool{}
-	for _, entry := range orgListing {
-		orgMap[entry.Organization.Name] = true
+	currentOrgs, err := s.ListOrganizations(orgFilter, false)
+	if err != nil {
+		return nil, err
+	}
+	orgMap := map[string]*models.OrgDTO{}
+	for _, entry := range currentOrgs {
+		orgMap[entry.Organization.Name] = entry.Organization
 	}
 
 	for _, file := range filesInDir {
+		if !strings.HasSuffix(file, ".json") {
+			continue
+		}
 		fileLocation := filepath.Join(s.grafanaConf.GetPath(resourceTypes.OrganizationResource, orgName), file)
-		if strings.HasSuffix(file, ".json") {
-			if rawFolder, err = s.storage.ReadFile(fileLocation); err != nil {
-				slog.Error("failed to read file", "filename", fileLocation, "err", err)
-				continue
-			}
+		if rawData, err = s.readBackupFile(fileLocation); err != nil {
+			s.recordFailure(resourceTypes.OrganizationResource, fileLocation, fmt.Errorf("failed to read file: %w", err))
+			continue
 		}
 		var jsonOrg domain.OrgsDTOWithPreferences
-		var newOrg models.CreateOrgCommand
-		if err = json.Unmarshal(rawFolder, &jsonOrg); err != nil {
-			slog.Warn("failed to unmarshall folder", "err", err)
+		if err = json.Unmarshal(rawData, &jsonOrg); err != nil {
+			s.recordFailure(resourceTypes.OrganizationResource, fileLocation, fmt.Errorf("failed to unmarshall organization: %w", err))
 			continue
 		}
 		if jsonOrg.Organization == nil {
-			slog.Warn("unable to retrieve Org info from file", slog.String("file", file))
+			s.recordFailure(resourceTypes.OrganizationResource, fileLocation, errors.New("unable to retrieve org info from file"))
 			continue
 		}
-		newOrg.Name = jsonOrg.Organization.Name
-		if !filter.ValidateAll(rawFolder) {
+		if !filter.ValidateAll(rawData) {
 			slog.Debug("Skipping org, failing filter check", "file", file)
 			continue
 		}
-		updateProperties := func(org *domain.OrgsDTOWithPreferences) error {
-			if org.Preferences == nil || org.Organization == nil {
-				slog.Warn("Properties or Organization is nil, ignore update request")
-				return nil
+		sourceName := jsonOrg.Organization.Name
+		targetName := sourceName
+		if renamed, ok := renames[sourceName]; ok && renamed != "" {
+			targetName = renamed
+		}
+
+		var orgID int64
+		existing, exists := orgMap[targetName]
+		previous, renameable := orgMap[sourceName]
+		switch {
+		case exists:
+			slog.Info("Organization already exists, updating properties", "organization", targetName)
+			orgID = existing.ID
+		case targetName != sourceName && renameable:
+			if _, err = adminClient.Orgs.UpdateOrg(previous.ID, &models.UpdateOrgForm{Name: targetName}); err != nil {
+				s.recordFailure(resourceTypes.OrganizationResource, sourceName, fmt.Errorf("failed to rename organization to %s: %w", targetName, err))
+				continue
 			}
-			return s.UploadOrgPreferences(org.Organization.Name, org.Preferences)
+			slog.Info("Organization renamed", "organization", sourceName, "newName", targetName)
+			orgID = previous.ID
+			delete(orgMap, sourceName)
+			orgMap[targetName] = &models.OrgDTO{ID: orgID, Name: targetName}
+			result = append(result, targetName)
+		default:
+			resp, createErr := s.GetBasicAuthClient().Orgs.CreateOrg(&models.CreateOrgCommand{Name: targetName})
+			if createErr != nil {
+				s.recordFailure(resourceTypes.OrganizationResource, targetName, fmt.Errorf("failed to create organization: %w", createErr))
+				continue
+			}
+			orgID = *resp.GetPayload().OrgID
+			orgMap[targetName] = &models.OrgDTO{ID: orgID, Name: targetName}
+			result = append(result, targetName)
 		}
-		if _, ok := orgMap[newOrg.Name]; ok {
-			slog.Info("Organization already exists, skipping", "organization", newOrg.Name)
-			err = updateProperties(&jsonOrg)
-			if err != nil {
-				slog.Warn("unable to update Org properties for org.", slog.String("organization", newOrg.Name))
+
+		if jsonOrg.Preferences != nil {
+			if err = s.UploadOrgPreferences(targetName, jsonOrg.Preferences); err != nil {
+				slog.Warn("unable to update Org properties for org.", slog.String("organization", targetName), slog.Any("err", err))
 			}
-			continue
 		}
+		s.applyOrgQuotas(adminClient, orgID, targetName, jsonOrg.Quotas)
+		s.recordSuccess(resourceTypes.OrganizationResource, targetName)
+	}
+	return result, nil
+}
 
-		_, err = s.GetBasicAuthClient().Orgs.CreateOrg(&newOrg)
-		if err != nil {
-			slog.Error("failed to create organization", "organization", newOrg.Name)
+// applyOrgQuotas sets the org quota limits, quotas without a target are ignored
+func (s *DashNGoImpl) applyOrgQuotas(adminClient *client.GrafanaHTTPAPI, orgID int64, orgName string, quotas []*models.QuotaDTO) {
+	for _, entry := range quotas {
+		if entry == nil || entry.Target == "" {
 			continue
 		}
-		result = append(result, newOrg.Name)
-		err = updateProperties(&jsonOrg)
-		if err != nil {
-			slog.Warn("unable to update Org properties for org.", slog.String("organization", newOrg.Name))
+		p := quota.NewUpdateOrgQuotaParams()
+		p.OrgID = orgID
+		p.QuotaTarget = entry.Target
+		p.Body = &models.UpdateQuotaCmd{Target: entry.Target, Limit: entry.Limit}
+		if _, err := adminClient.Quota.UpdateOrgQuota(p); err != nil {
+			slog.Warn("unable to update org quota", "organization", orgName, "target", entry.Target, "err", err)
 		}
+	}
+}
 
+// DeleteAllOrganizations removes all organizations matching the filter.  The default organization and the one
+// configured for the current context are never deleted.
+func (s *DashNGoImpl) DeleteAllOrganizations(filter filters.V2Filter) ([]string, error) {
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	currentOrgs, err := s.ListOrganizations(filter, false)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, entry := range currentOrgs {
+		org := entry.Organization
+		if org.ID == configDomain.DefaultOrganizationId || org.Name == s.grafanaConf.GetOrganizationName() {
+			s.recordSkipped(resourceTypes.OrganizationResource, org.Name, "protected organization")
+			continue
+		}
+		if _, err = adminClient.Orgs.DeleteOrgByID(org.ID); err != nil {
+			s.recordFailure(resourceTypes.OrganizationResource, org.Name, fmt.Errorf("failed to delete organization: %w", err))
+			continue
+		}
+		s.recordSuccess(resourceTypes.OrganizationResource, org.Name)
+		result = append(result, org.Name)
 	}
-	return result
+	return result, nil
 }
 
 // SwitchOrganizationByName switch organization context
@@ -405,35 +530,34 @@ func (s *DashNGoImpl) SwitchOrganizationByName(orgName string) error {
 
 	// We retrieved all the orgs successfully and none of them matched the requested ID
 	if !valid {
-		log.Fatalf("The Specified OrgId does not match any existing organization.  Please check your configuration and try again.")
+		return fmt.Errorf("organization '%s' does not match any existing organization, please check your configuration and try again: %w", orgName, ErrNotFound)
 	}
 
-	status, err := s.GetBasicAuthClient().SignedInUser.UserSetUsingOrg(orgId)
+	_, err := s.GetBasicAuthClient().SignedInUser.UserSetUsingOrg(orgId)
 	if err != nil {
-		log.Fatalf("%s for %v\n", err, status)
-		return err
+		return fmt.Errorf("unable to switch to organization '%s': %w", orgName, err)
 	}
 
 	return nil
 }
 
 // GetUserOrganization returns the organizations the user is a member of.
-func (s *DashNGoImpl) GetUserOrganization() *models.OrgDetailsDTO {
+func (s *DashNGoImpl) GetUserOrganization() (*models.OrgDetailsDTO, error) {
 	return s.getAssociatedActiveOrg(s.GetBasicAuthClient())
 }
 
 // GetTokenOrganization returns the organizations associated with the given token. (This property is immutable)
-func (s *DashNGoImpl) GetTokenOrganization() *models.OrgDetailsDTO {
+func (s *DashNGoImpl) GetTokenOrganization() (*models.OrgDetailsDTO, error) {
 	return s.getAssociatedActiveOrg(s.GetClient())
 }
 
 // getAssociatedActiveOrg returns the Org associated with the given authentication mechanism.
-func (s *DashNGoImpl) getAssociatedActiveOrg(apiClient *client.GrafanaHTTPAPI) *models.OrgDetailsDTO {
+func (s *DashNGoImpl) getAssociatedActiveOrg(apiClient *client.GrafanaHTTPAPI) (*models.OrgDetailsDTO, error) {
 	payload, err := apiClient.Org.GetCurrentOrg()
 	if err != nil {
-		log.Fatalf("Unable to retrieve current organization, err: %v", err)
+		return nil, fmt.Errorf("unable to retrieve current organization: %w", err)
 	}
-	return payload.GetPayload()
+	return payload.GetPayload(), nil
 }
 
 func (s *DashNGoImpl) ListUserOrganizations() ([]*models.UserOrgDTO, error) {
@@ -459,14 +583,16 @@ func (s *DashNGoImpl) UpdateCurrentOrganization(name string) error {
 	return err
 }
 
-func (s *DashNGoImpl) ListOrgUsers(orgId int64) []*models.OrgUserDTO {
-	p := orgs.NewGetOrgUsersParams()
-	p.OrgID = orgId
-	resp, err := s.GetAdminClient().Orgs.GetOrgUsers(orgId)
+func (s *DashNGoImpl) ListOrgUsers(orgId int64) ([]*models.OrgUserDTO, error) {
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adminClient.Orgs.GetOrgUsers(orgId)
 	if err != nil {
-		log.Fatalf("failed to get org users, err: %v", err)
+		return nil, fmt.Errorf("failed to get org users: %w", err)
 	}
-	return resp.GetPayload()
+	return resp.GetPayload(), nil
 }
 
 func (s *DashNGoImpl) AddUserToOrg(role, orgSlug string, userId int64) error {
@@ -484,7 +610,11 @@ func (s *DashNGoImpl) AddUserToOrg(role, orgSlug string, userId int64) error {
 		return fmt.Errorf("unable to find a valid org with slug value of %s", orgSlug)
 	}
 
-	_, err = s.GetAdminClient().Orgs.AddOrgUser(orgEntity.OrgID, request)
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return err
+	}
+	_, err = adminClient.Orgs.AddOrgUser(orgEntity.OrgID, request)
 	return err
 }
 
@@ -493,7 +623,11 @@ func (s *DashNGoImpl) DeleteUserFromOrg(orgSlugName string, userId int64) error
 	if err != nil {
 		return err
 	}
-	_, err = s.GetAdminClient().Orgs.RemoveOrgUser(userId, orgEntity.OrgID)
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return err
+	}
+	_, err = adminClient.Orgs.RemoveOrgUser(userId, orgEntity.OrgID)
 	return err
 }
 
@@ -503,7 +637,14 @@ func (s *DashNGoImpl) getOrgIdFromSlug(slugName string, useAdminListing bool) (*
 	var orgEntity *models.UserOrgDTO
 
 	if s.grafanaConf.IsGrafanaAdmin() && useAdminListing {
-		organizations := s.ListOrganizations(NewOrganizationFilter(), false)
+		orgFilter, err := NewOrganizationFilter()
+		if err != nil {
+			return nil, err
+		}
+		organizations, err := s.ListOrganizations(orgFilter, false)
+		if err != nil {
+			return nil, err
+		}
 		for _, org := range organizations {
 			if org.Organization != nil && slug.Make(org.Organization.Name) == slugName {
 				orgId = org.Organization.ID
@@ -547,6 +688,10 @@ func (s *DashNGoImpl) UpdateUserInOrg(role, orgSlug string, userId int64) error
 		Role: role,
 	}
 
-	_, err = s.GetAdminClient().Orgs.UpdateOrgUser(p)
+	adminClient, err := s.GetAdminClient()
+	if err != nil {
+		return err
+	}
+	_, err = adminClient.Orgs.UpdateOrgUser(p)
 	return err
 }
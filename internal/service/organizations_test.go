@@ -0,0 +1,60 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	configDomain "github.com/esnet/gdg/internal/config/domain"
+	"github.com/grafana/grafana-openapi-client-go/client/folders"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithOrganization(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		switched  bool
+		orgHeader []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/user/orgs":
+			_, _ = w.Write([]byte(`[{"orgId":1,"name":"Main Org."},{"orgId":2,"name":"testing"}]`))
+		case strings.HasPrefix(r.URL.Path, "/api/user/using/"):
+			switched = true
+		case r.URL.Path == "/api/folders":
+			orgHeader = append(orgHeader, r.Header.Get("X-Grafana-Org-Id"))
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	s := newContextTestService(server.URL, &configDomain.AppGlobals{})
+	s.grafanaConf.UserName = "admin"
+	s.grafanaConf.SetSecureAuth(configDomain.SecureModel{Password: "secret"})
+	s.grafanaConf.OrganizationName = "Main Org."
+
+	scoped, err := s.WithOrganization("testing")
+	require.NoError(t, err)
+	_, err = scoped.(*DashNGoImpl).GetClient().Folders.GetFolders(folders.NewGetFoldersParams())
+	require.NoError(t, err)
+	_, err = s.GetClient().Folders.GetFolders(folders.NewGetFoldersParams())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2", "1"}, orgHeader, "requests target the organization of each service")
+	assert.False(t, switched, "the user's active organization is left as is")
+	assert.Equal(t, "Main Org.", s.grafanaConf.OrganizationName)
+	assert.Equal(t, "Main Org.", s.gdgConfig.GetDefaultGrafanaConfig().OrganizationName)
+	assert.Equal(t, "testing", scoped.(*DashNGoImpl).gdgConfig.GetDefaultGrafanaConfig().OrganizationName)
+
+	_, err = s.WithOrganization("missing")
+	assert.ErrorContains(t, err, "user does not have access to org: 'missing'")
+}
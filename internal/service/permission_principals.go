@@ -0,0 +1,229 @@
+package service
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/esnet/gdg/internal/tools/ptr"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/grafana/grafana-openapi-client-go/client/org"
+	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
+	"github.com/grafana/grafana-openapi-client-go/client/teams"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/samber/lo"
+)
+
+// principalResolver maps the portable identities recorded in backups (user login/email, service account login and
+// team name) onto the numeric IDs used by the grafana instance being restored to, and back again when downloading.
+// Entries that predate portable identities only carry IDs and are passed through untouched.
+type principalResolver struct {
+	users               map[string]int64
+	serviceAccounts     map[string]int64
+	teams               map[string]int64
+	userNames           map[int64]string
+	serviceAccountNames map[int64]string
+	teamNames           map[int64]string
+	unresolved          []string
+}
+
+// newPrincipalResolver builds a lookup of all users, service accounts and teams in the current organization
+func (s *DashNGoImpl) newPrincipalResolver() *principalResolver {
+	r := &principalResolver{
+		users:               make(map[string]int64),
+		serviceAccounts:     make(map[string]int64),
+		teams:               make(map[string]int64),
+		userNames:           make(map[int64]string),
+		serviceAccountNames: make(map[int64]string),
+		teamNames:           make(map[int64]string),
+	}
+	userParams := org.NewGetOrgUsersForCurrentOrgParams()
+	userParams.Limit = ptr.Of[int64](99999)
+	orgUsers, err := s.GetClient().Org.GetOrgUsersForCurrentOrg(userParams)
+	if err != nil {
+		slog.Warn("unable to list organization users, user permissions will be applied by ID", "err", err)
+	} else {
+		for _, user := range orgUsers.GetPayload() {
+			if user.Login != "" {
+				r.users[strings.ToLower(user.Login)] = user.UserID
+				r.userNames[user.UserID] = user.Login
+			}
+			if user.Email != "" {
+				r.users[strings.ToLower(user.Email)] = user.UserID
+			}
+		}
+	}
+
+	// service accounts are granted permissions as users, keyed by their generated login
+	saParams := service_accounts.NewSearchOrgServiceAccountsWithPagingParams()
+	saParams.Perpage = ptr.Of[int64](5000)
+	serviceAccounts, err := s.GetClient().ServiceAccounts.SearchOrgServiceAccountsWithPaging(saParams)
+	if err != nil {
+		slog.Debug("unable to list service accounts", "err", err)
+	} else {
+		for _, sa := range serviceAccounts.GetPayload().ServiceAccounts {
+			if sa.Login != "" {
+				r.serviceAccounts[strings.ToLower(sa.Login)] = sa.ID
+				r.serviceAccountNames[sa.ID] = sa.Login
+			}
+		}
+	}
+
+	teamParams := teams.NewSearchTeamsParams()
+	teamParams.Perpage = ptr.Of[int64](99999)
+	teamList, err := s.GetClient().Teams.SearchTeams(teamParams)
+	if err != nil {
+		slog.Warn("unable to list teams, team permissions will be applied by ID", "err", err)
+	} else {
+		for _, team := range teamList.GetPayload().Teams {
+			if team.Name != nil && team.ID != nil {
+				r.teams[*team.Name] = *team.ID
+				r.teamNames[*team.ID] = *team.Name
+			}
+		}
+	}
+
+	return r
+}
+
+// userID returns the ID of the user identified by login or email.  When neither is known the fallback ID is returned.
+func (r *principalResolver) userID(login, email string, fallback int64) (int64, bool) {
+	if login == "" && email == "" {
+		return fallback, true
+	}
+	for _, key := range []string{login, email} {
+		if key == "" {
+			continue
+		}
+		if id, ok := r.users[strings.ToLower(key)]; ok {
+			return id, true
+		}
+		if id, ok := r.serviceAccounts[strings.ToLower(key)]; ok {
+			return id, true
+		}
+	}
+	r.unresolved = append(r.unresolved, fmt.Sprintf("user:%s", lo.CoalesceOrEmpty(login, email)))
+	return 0, false
+}
+
+// serviceAccountID returns the ID of the service account identified by login
+func (r *principalResolver) serviceAccountID(login string) (int64, bool) {
+	if id, ok := r.serviceAccounts[strings.ToLower(login)]; ok {
+		return id, true
+	}
+	r.unresolved = append(r.unresolved, fmt.Sprintf("serviceAccount:%s", login))
+	return 0, false
+}
+
+// teamID returns the ID of the team with the given name.  When the name is unknown the fallback ID is returned.
+func (r *principalResolver) teamID(name string, fallback int64) (int64, bool) {
+	if name == "" {
+		return fallback, true
+	}
+	if id, ok := r.teams[name]; ok {
+		return id, true
+	}
+	r.unresolved = append(r.unresolved, fmt.Sprintf("team:%s", name))
+	return 0, false
+}
+
+// resolveACL rewrites a dashboard/folder ACL entry to reference the target's user and team IDs
+func (r *principalResolver) resolveACL(entry *models.DashboardACLInfoDTO) (*models.DashboardACLUpdateItem, bool) {
+	item := &models.DashboardACLUpdateItem{
+		Permission: entry.Permission,
+		Role:       entry.Role,
+	}
+	var ok bool
+	switch {
+	case entry.UserID != 0 || entry.UserLogin != "" || entry.UserEmail != "":
+		item.UserID, ok = r.userID(entry.UserLogin, entry.UserEmail, entry.UserID)
+	case entry.TeamID != 0 || entry.Team != "":
+		item.TeamID, ok = r.teamID(entry.Team, entry.TeamID)
+	default:
+		ok = entry.Role != ""
+		if !ok {
+			r.unresolved = append(r.unresolved, "unknown principal")
+		}
+	}
+
+	return item, ok
+}
+
+// resolveResource rewrites a resource permission entry to reference the target's user and team IDs
+func (r *principalResolver) resolveResource(entry *models.ResourcePermissionDTO) bool {
+	var ok bool
+	switch getPermissionType(*entry) {
+	case ConnectionUserPermission:
+		entry.UserID, ok = r.userID(entry.UserLogin, "", entry.UserID)
+	case ConnectionTeamPermission:
+		entry.TeamID, ok = r.teamID(entry.Team, entry.TeamID)
+	default:
+		ok = true
+	}
+	return ok
+}
+
+// report logs and returns all principals that could not be resolved since the last call
+func (r *principalResolver) report(resource string) []string {
+	unresolved := r.unresolved
+	r.unresolved = nil
+	for _, principal := range unresolved {
+		slog.Warn("unable to resolve permission principal on destination, entry skipped", "resource", resource, "principal", principal)
+	}
+	return unresolved
+}
+
+// unresolvedError logs the principals that could not be resolved since the last call and returns an error listing them,
+// nil when every principal was resolved.
+func (r *principalResolver) unresolvedError(resource string) error {
+	unresolved := r.report(resource)
+	if len(unresolved) == 0 {
+		return nil
+	}
+	return fmt.Errorf("entries skipped, unable to resolve principals on destination: %s", strings.Join(unresolved, ", "))
+}
+
+// assignmentNames converts the IDs of a role assignment into portable user logins, team names and service account logins
+func (r *principalResolver) assignmentNames(assignments *models.RoleAssignmentsDTO) domain.RoleAssignments {
+	result := domain.RoleAssignments{Users: []string{}, Teams: []string{}, ServiceAccounts: []string{}}
+	if assignments == nil {
+		return result
+	}
+	lookup := func(ids []int64, names map[int64]string, kind string) []string {
+		var values []string
+		for _, id := range ids {
+			if name, ok := names[id]; ok {
+				values = append(values, name)
+			} else {
+				slog.Warn("unable to determine name of role assignee, entry skipped", "type", kind, "id", id)
+			}
+		}
+		return lo.Ternary(values == nil, []string{}, values)
+	}
+	result.Users = lookup(assignments.Users, r.userNames, "user")
+	result.Teams = lookup(assignments.Teams, r.teamNames, "team")
+	result.ServiceAccounts = lookup(assignments.ServiceAccounts, r.serviceAccountNames, "serviceAccount")
+
+	return result
+}
+
+// assignmentIDs converts portable role assignments into the IDs used by the current instance
+func (r *principalResolver) assignmentIDs(assignments domain.RoleAssignments) *models.SetRoleAssignmentsCommand {
+	cmd := &models.SetRoleAssignmentsCommand{Users: []int64{}, Teams: []int64{}, ServiceAccounts: []int64{}}
+	for _, login := range assignments.Users {
+		if id, ok := r.userID(login, "", 0); ok {
+			cmd.Users = append(cmd.Users, id)
+		}
+	}
+	for _, name := range assignments.Teams {
+		if id, ok := r.teamID(name, 0); ok {
+			cmd.Teams = append(cmd.Teams, id)
+		}
+	}
+	for _, login := range assignments.ServiceAccounts {
+		if id, ok := r.serviceAccountID(login); ok {
+			cmd.ServiceAccounts = append(cmd.ServiceAccounts, id)
+		}
+	}
+	return cmd
+}
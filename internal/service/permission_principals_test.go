@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipalResolver(t *testing.T) {
+	r := &principalResolver{
+		users: map[string]int64{"bob": 12, "bob@example.com": 12},
+		teams: map[string]int64{"engineers": 4},
+	}
+	item, ok := r.resolveACL(&models.DashboardACLInfoDTO{UserID: 3, UserLogin: "Bob", Permission: 2})
+	assert.True(t, ok)
+	assert.Equal(t, int64(12), item.UserID)
+	item, ok = r.resolveACL(&models.DashboardACLInfoDTO{TeamID: 9, Team: "engineers", Permission: 1})
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), item.TeamID)
+	// legacy entries with no identity fall back on the recorded ID
+	item, ok = r.resolveACL(&models.DashboardACLInfoDTO{UserID: 7, Permission: 1})
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), item.UserID)
+	item, ok = r.resolveACL(&models.DashboardACLInfoDTO{Role: "Viewer", Permission: 1})
+	assert.True(t, ok)
+	assert.Equal(t, "Viewer", item.Role)
+
+	_, ok = r.resolveACL(&models.DashboardACLInfoDTO{UserID: 3, UserLogin: "alice"})
+	assert.False(t, ok)
+	perm := &models.ResourcePermissionDTO{TeamID: 1, Team: "ghosts"}
+	assert.False(t, r.resolveResource(perm))
+	assert.Equal(t, []string{"user:alice", "team:ghosts"}, r.report("test"))
+	assert.Empty(t, r.unresolved)
+
+	assert.NoError(t, r.unresolvedError("test"))
+	_, ok = r.teamID("ghosts", 1)
+	assert.False(t, ok)
+	assert.EqualError(t, r.unresolvedError("test"), "entries skipped, unable to resolve principals on destination: team:ghosts")
+}
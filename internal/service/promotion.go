@@ -0,0 +1,207 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// PromotionRules transformations applied to the entities uploaded to a context, allowing a single backup to be
+// promoted across environments.
+type PromotionRules struct {
+	// Folders renames folder paths, nested folders of a renamed folder are moved along with it
+	Folders map[string]string `yaml:"folders"`
+	// Connections renames connections, references to them are updated as well
+	Connections map[string]string `yaml:"connections"`
+	// Organizations maps the organization a backup was taken from to the organization it is uploaded to
+	Organizations map[string]string  `yaml:"organizations"`
+	Dashboards    DashboardPromotion `yaml:"dashboards"`
+}
+
+// DashboardPromotion transformations applied to dashboards only
+type DashboardPromotion struct {
+	// Tags renames tags, tags renamed to an empty value are removed
+	Tags   map[string]string `yaml:"tags"`
+	Titles []RewriteRule     `yaml:"titles"`
+	// Variables overrides the default value of template variables
+	Variables map[string]string `yaml:"variables"`
+}
+
+// RewriteRule replaces every match of the regular expression, the replacement may refer to capture groups, ie. $1
+type RewriteRule struct {
+	Pattern     string `yaml:"pattern"`
+	Replacement string `yaml:"replacement"`
+	regex       *regexp.Regexp
+}
+
+// LoadPromotionRules reads and validates the promotion rules file
+func LoadPromotionRules(file string) (*PromotionRules, error) {
+	raw, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read promotion rules: %w", err)
+	}
+	rules := new(PromotionRules)
+	if err = yaml.Unmarshal(raw, rules); err != nil {
+		return nil, fmt.Errorf("invalid promotion rules %s: %w", file, err)
+	}
+	for ndx, rule := range rules.Dashboards.Titles {
+		if rules.Dashboards.Titles[ndx].regex, err = regexp.Compile(rule.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid title pattern '%s': %w", rule.Pattern, err)
+		}
+	}
+	return rules, nil
+}
+
+// promotionRules returns the promotion rules of the current context, nil when none are configured
+func (s *DashNGoImpl) promotionRules() (*PromotionRules, error) {
+	if s.grafanaConf.PromotionFile == "" {
+		return nil, nil
+	}
+	return LoadPromotionRules(s.grafanaConf.PromotionFile)
+}
+
+// sourceOrganization returns the organization whose backup is uploaded to the given organization
+func (p *PromotionRules) sourceOrganization(orgName string) string {
+	if p == nil {
+		return orgName
+	}
+	for source, target := range p.Organizations {
+		if target == orgName {
+			return source
+		}
+	}
+	return orgName
+}
+
+// folder returns the path the folder is promoted to, the longest matching rule wins
+func (p *PromotionRules) folder(name string) string {
+	if p == nil {
+		return name
+	}
+	match := ""
+	for source := range p.Folders {
+		if (name == source || strings.HasPrefix(name, source+"/")) && len(source) > len(match) {
+			match = source
+		}
+	}
+	if match == "" {
+		return name
+	}
+	return strings.Trim(p.Folders[match]+strings.TrimPrefix(name, match), "/")
+}
+
+// connection returns the name the connection is promoted to
+func (p *PromotionRules) connection(name string) string {
+	if p == nil {
+		return name
+	}
+	if val, ok := p.Connections[name]; ok {
+		return val
+	}
+	return name
+}
+
+// promoteDashboard applies the tag, title and variable rules to the dashboard
+func (p *PromotionRules) promoteDashboard(board map[string]any) {
+	if p == nil {
+		return
+	}
+	rules := p.Dashboards
+	if title, ok := board["title"].(string); ok {
+		for _, rule := range rules.Titles {
+			title = rule.regex.ReplaceAllString(title, rule.Replacement)
+		}
+		board["title"] = title
+	}
+	if tags, ok := board["tags"].([]any); ok && len(rules.Tags) > 0 {
+		promoted := make([]any, 0, len(tags))
+		for _, tag := range tags {
+			if name, isString := tag.(string); isString {
+				if val, found := rules.Tags[name]; found {
+					tag = val
+				}
+			}
+			if tag == "" || slices.Contains(promoted, tag) {
+				continue
+			}
+			promoted = append(promoted, tag)
+		}
+		board["tags"] = promoted
+	}
+	templating, _ := board["templating"].(map[string]any)
+	variables, _ := templating["list"].([]any)
+	for _, item := range variables {
+		variable, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := variable["name"].(string)
+		value, found := rules.Variables[name]
+		if !found {
+			continue
+		}
+		variable["current"] = map[string]any{"selected": true, "text": value, "value": value}
+		if options, hasOptions := variable["options"].([]any); hasOptions {
+			for _, opt := range options {
+				if option, isMap := opt.(map[string]any); isMap {
+					option["selected"] = option["value"] == value
+				}
+			}
+		}
+		if kind, _ := variable["type"].(string); kind == "constant" || kind == "textbox" {
+			variable["query"] = value
+		}
+	}
+}
+
+// promotionConnections maps the connections of the backup onto the connections they are renamed to, nil when no
+// connection is renamed.  References by uid are only remapped when the backup of the connection is available.
+func (s *DashNGoImpl) promotionConnections(p *PromotionRules, orgName string) (*connectionMapping, error) {
+	if p == nil || len(p.Connections) == 0 {
+		return nil, nil
+	}
+	m := &connectionMapping{uids: make(map[string]string), names: p.Connections}
+	filter, err := NewConnectionFilter("")
+	if err != nil {
+		return nil, err
+	}
+	targetConnections, err := s.ListConnections(filter)
+	if err != nil {
+		return nil, err
+	}
+	targetUIDs := make(map[string]string)
+	for _, item := range targetConnections {
+		targetUIDs[item.Name] = item.UID
+	}
+	connectionsPath := s.grafanaConf.GetPath(resourceTypes.ConnectionResource, orgName)
+	files, err := s.storage.FindAllFiles(s.requestContext(), connectionsPath, true)
+	if err != nil {
+		slog.Warn("Unable to list connections, connection references are only remapped by name", "err", err)
+		return m, nil
+	}
+	for _, file := range files {
+		raw, readErr := s.readBackupFile(file)
+		if readErr != nil {
+			continue
+		}
+		var connection struct {
+			Name string `json:"name"`
+			UID  string `json:"uid"`
+		}
+		if json.Unmarshal(raw, &connection) != nil {
+			continue
+		}
+		if uid, ok := targetUIDs[p.connection(connection.Name)]; ok && connection.Name != p.connection(connection.Name) {
+			m.uids[connection.UID] = uid
+		}
+	}
+	return m, nil
+}
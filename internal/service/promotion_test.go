@@ -0,0 +1,92 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPromotionRules = `
+folders:
+  Team/Dev: Team
+  Team/Dev/Legacy: Archive
+connections:
+  Prometheus Dev: Prometheus
+organizations:
+  Dev Org: Main Org.
+dashboards:
+  tags:
+    dev: prod
+    wip: ""
+  titles:
+    - pattern: '^\[DEV\] (.*)$'
+      replacement: '$1'
+  variables:
+    env: prod
+`
+
+func writePromotionRules(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "promotion.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	return file
+}
+
+func TestLoadPromotionRules(t *testing.T) {
+	rules, err := LoadPromotionRules(writePromotionRules(t, testPromotionRules))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Team", rules.folder("Team/Dev"))
+	assert.Equal(t, "Team/Alerts", rules.folder("Team/Dev/Alerts"))
+	assert.Equal(t, "Archive/Old", rules.folder("Team/Dev/Legacy/Old"))
+	assert.Equal(t, "Team/Development", rules.folder("Team/Development"))
+	assert.Equal(t, "Prometheus", rules.connection("Prometheus Dev"))
+	assert.Equal(t, "Loki", rules.connection("Loki"))
+	assert.Equal(t, "Dev Org", rules.sourceOrganization("Main Org."))
+	assert.Equal(t, "Other Org", rules.sourceOrganization("Other Org"))
+
+	var nilRules *PromotionRules
+	assert.Equal(t, "Team/Dev", nilRules.folder("Team/Dev"))
+	assert.Equal(t, "Main Org.", nilRules.sourceOrganization("Main Org."))
+
+	_, err = LoadPromotionRules(writePromotionRules(t, "dashboards:\n  titles:\n    - pattern: '('\n"))
+	assert.ErrorContains(t, err, "invalid title pattern")
+	_, err = LoadPromotionRules(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "unable to read promotion rules")
+}
+
+func TestPromoteDashboard(t *testing.T) {
+	rules, err := LoadPromotionRules(writePromotionRules(t, testPromotionRules))
+	require.NoError(t, err)
+	board := map[string]any{
+		"title": "[DEV] Latency",
+		"tags":  []any{"dev", "wip", "prod", "api"},
+		"templating": map[string]any{
+			"list": []any{
+				map[string]any{
+					"name":    "env",
+					"type":    "custom",
+					"current": map[string]any{"text": "dev", "value": "dev"},
+					"options": []any{
+						map[string]any{"value": "dev", "selected": true},
+						map[string]any{"value": "prod", "selected": false},
+					},
+				},
+				map[string]any{"name": "region", "type": "constant", "query": "eu"},
+			},
+		},
+	}
+
+	rules.promoteDashboard(board)
+	assert.Equal(t, "Latency", board["title"])
+	assert.Equal(t, []any{"prod", "api"}, board["tags"])
+	variables := board["templating"].(map[string]any)["list"].([]any)
+	env := variables[0].(map[string]any)
+	assert.Equal(t, "prod", env["current"].(map[string]any)["value"])
+	options := env["options"].([]any)
+	assert.Equal(t, false, options[0].(map[string]any)["selected"])
+	assert.Equal(t, true, options[1].(map[string]any)["selected"])
+	assert.Equal(t, "eu", variables[1].(map[string]any)["query"])
+}
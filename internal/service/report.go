@@ -0,0 +1,90 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
+	customModels "github.com/esnet/gdg/pkg/service/domain"
+)
+
+// Outcomes of processing a single entity
+const (
+	ResultSucceeded = customModels.ResultSucceeded
+	ResultSkipped   = customModels.ResultSkipped
+	ResultFailed    = customModels.ResultFailed
+)
+
+// EntityResult the outcome of processing a single entity, reason explains why it was skipped or failed
+type EntityResult = customModels.EntityResult
+
+// ResultReporter is implemented by services recording the outcome of every entity processed by a command
+type ResultReporter interface {
+	Results() []EntityResult
+	ResetResults()
+}
+
+// Results returns the outcome of every entity processed since the results were last reset
+func (s *DashNGoImpl) Results() []EntityResult {
+	state := s.shared()
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return append([]EntityResult(nil), state.results...)
+}
+
+// ResetResults discards the recorded results
+func (s *DashNGoImpl) ResetResults() {
+	state := s.shared()
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.results = nil
+}
+
+func (s *DashNGoImpl) recordResult(result EntityResult) {
+	state := s.shared()
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.results = append(state.results, result)
+}
+
+// recordSuccess records an entity that was processed successfully
+func (s *DashNGoImpl) recordSuccess(resource resourceTypes.ResourceType, name string) {
+	s.recordResult(EntityResult{Resource: resource.String(), Name: name, Status: ResultSucceeded})
+}
+
+// recordSkipped records an entity that was deliberately not processed
+func (s *DashNGoImpl) recordSkipped(resource resourceTypes.ResourceType, name, reason string) {
+	slog.Debug("Skipping entity", "resource", resource.String(), "name", name, "reason", reason)
+	s.recordResult(EntityResult{Resource: resource.String(), Name: name, Status: ResultSkipped, Reason: reason})
+}
+
+// recordFailure records an entity that failed to be processed, the failure is logged as well
+func (s *DashNGoImpl) recordFailure(resource resourceTypes.ResourceType, name string, err error) {
+	slog.Error("Failed to process entity", "resource", resource.String(), "name", name, "err", err)
+	s.recordResult(EntityResult{Resource: resource.String(), Name: name, Status: ResultFailed, Reason: err.Error()})
+}
+
+// skipError explains why an entity was deliberately not processed
+type skipError struct {
+	reason string
+}
+
+func (e skipError) Error() string {
+	return e.reason
+}
+
+// skipReason returns an error recorded as a skipped entity rather than a failure
+func skipReason(format string, args ...any) error {
+	return skipError{reason: fmt.Sprintf(format, args...)}
+}
+
+// recordError records the entity as skipped when the error is a skip reason, as failed otherwise
+func (s *DashNGoImpl) recordError(resource resourceTypes.ResourceType, name string, err error) {
+	var skip skipError
+	if errors.As(err, &skip) {
+		s.recordSkipped(resource, name, skip.reason)
+		return
+	}
+	s.recordFailure(resource, name, err)
+}
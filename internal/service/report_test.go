@@ -0,0 +1,25 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordResults(t *testing.T) {
+	s := &DashNGoImpl{}
+	s.recordSuccess(resourceTypes.DashboardResource, "a")
+	s.recordError(resourceTypes.DashboardResource, "b", skipReason("folder %s is not monitored", "Other"))
+	s.recordError(resourceTypes.DashboardResource, "c", errors.New("boom"))
+
+	assert.Equal(t, []EntityResult{
+		{Resource: "dashboards", Name: "a", Status: ResultSucceeded},
+		{Resource: "dashboards", Name: "b", Status: ResultSkipped, Reason: "folder Other is not monitored"},
+		{Resource: "dashboards", Name: "c", Status: ResultFailed, Reason: "boom"},
+	}, s.Results())
+
+	s.ResetResults()
+	assert.Empty(t, s.Results())
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/esnet/gdg/internal/tools"
+	"github.com/grafana/grafana-openapi-client-go/client/access_control"
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+const (
+	dashboardResourceType = "dashboards"
+	folderResourceType    = "folders"
+	// resourcePermissionsMinVersion is the first Grafana version where RBAC can no longer be disabled, which
+	// guarantees dashboards and folders are managed through the access-control resource permissions API.
+	resourcePermissionsMinVersion = "v10.0.0"
+)
+
+// legacy ACL permission levels and their access-control equivalent
+var aclPermissionNames = map[models.PermissionType]string{
+	1: "View",
+	2: "Edit",
+	4: "Admin",
+}
+
+// supportsResourcePermissions returns true if dashboard and folder permissions should be managed via the
+// access-control resource permissions API rather than the legacy ACL endpoints.
+func (s *DashNGoImpl) supportsResourcePermissions() bool {
+	return tools.ValidateMinimumVersion(resourcePermissionsMinVersion, s)
+}
+
+// getResourcePermissionsAsACL retrieves the access-control permissions of a dashboard or folder and converts them
+// to the legacy ACL model, keeping the backup file format unchanged.
+func (s *DashNGoImpl) getResourcePermissionsAsACL(resource, uid string) ([]*models.DashboardACLInfoDTO, error) {
+	resp, err := s.GetClient().AccessControl.GetResourcePermissions(uid, resource)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.DashboardACLInfoDTO, 0, len(resp.GetPayload()))
+	for _, perm := range resp.GetPayload() {
+		result = append(result, resourcePermissionToACL(resource, uid, perm))
+	}
+	return result, nil
+}
+
+// resourcePermissionToACL converts an access-control permission entry into a legacy ACL entry
+func resourcePermissionToACL(resource, uid string, perm *models.ResourcePermissionDTO) *models.DashboardACLInfoDTO {
+	entry := &models.DashboardACLInfoDTO{
+		UID:            uid,
+		IsFolder:       resource == folderResourceType,
+		Inherited:      perm.IsInherited,
+		PermissionName: perm.Permission,
+		Role:           perm.BuiltInRole,
+		Team:           perm.Team,
+		TeamID:         perm.TeamID,
+		TeamUID:        perm.TeamUID,
+		UserID:         perm.UserID,
+		UserLogin:      perm.UserLogin,
+		UserUID:        perm.UserUID,
+	}
+	for level, name := range aclPermissionNames {
+		if name == perm.Permission {
+			entry.Permission = level
+		}
+	}
+	if resource == folderResourceType {
+		entry.FolderUID = uid
+	}
+
+	return entry
+}
+
+// setResourcePermissionsFromACL replaces all managed permissions of a dashboard or folder with the given legacy ACL items.
+// Inherited permissions are left untouched as they belong to the parent folder.
+func (s *DashNGoImpl) setResourcePermissionsFromACL(resource, uid string, items []*models.DashboardACLUpdateItem) error {
+	current, err := s.GetClient().AccessControl.GetResourcePermissions(uid, resource)
+	if err != nil {
+		return err
+	}
+	principalKey := func(userID, teamID int64, role string) string {
+		switch {
+		case userID != 0:
+			return fmt.Sprintf("user:%d", userID)
+		case teamID != 0:
+			return fmt.Sprintf("team:%d", teamID)
+		default:
+			return fmt.Sprintf("role:%s", role)
+		}
+	}
+
+	var keys []string
+	desired := make(map[string]*models.SetResourcePermissionCommand)
+	add := func(key string, cmd *models.SetResourcePermissionCommand) {
+		if _, ok := desired[key]; !ok {
+			keys = append(keys, key)
+		}
+		desired[key] = cmd
+	}
+	// Remove existing permissions first
+	for _, perm := range current.GetPayload() {
+		if perm.IsInherited || !perm.IsManaged {
+			continue
+		}
+		cmd := &models.SetResourcePermissionCommand{UserID: perm.UserID, TeamID: perm.TeamID}
+		if perm.UserID == 0 && perm.TeamID == 0 {
+			cmd.BuiltInRole = perm.BuiltInRole
+		}
+		add(principalKey(cmd.UserID, cmd.TeamID, cmd.BuiltInRole), cmd)
+	}
+	for _, item := range items {
+		permission, ok := aclPermissionNames[item.Permission]
+		if !ok {
+			slog.Warn("unsupported permission level, skipping entry", "resource", resource, "uid", uid, "permission", item.Permission)
+			continue
+		}
+		cmd := &models.SetResourcePermissionCommand{UserID: item.UserID, TeamID: item.TeamID, Permission: permission}
+		if item.UserID == 0 && item.TeamID == 0 {
+			cmd.BuiltInRole = item.Role
+		}
+		add(principalKey(cmd.UserID, cmd.TeamID, cmd.BuiltInRole), cmd)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	p := access_control.NewSetResourcePermissionsParams()
+	p.Resource = resource
+	p.ResourceID = uid
+	p.Body = &models.SetPermissionsCommand{Permissions: make([]*models.SetResourcePermissionCommand, 0, len(keys))}
+	for _, key := range keys {
+		p.Body.Permissions = append(p.Body.Permissions, desired[key])
+	}
+	_, err = s.GetClient().AccessControl.SetResourcePermissions(p)
+	return err
+}
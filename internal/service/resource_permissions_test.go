@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcePermissionToACL(t *testing.T) {
+	entry := resourcePermissionToACL(folderResourceType, "abc", &models.ResourcePermissionDTO{
+		UserID: 5, UserLogin: "sa-1-deploy", IsServiceAccount: true, Permission: "Edit", IsManaged: true,
+	})
+	assert.Equal(t, "abc", entry.UID)
+	assert.Equal(t, "abc", entry.FolderUID)
+	assert.True(t, entry.IsFolder)
+	assert.Equal(t, models.PermissionType(2), entry.Permission)
+	assert.Equal(t, "Edit", entry.PermissionName)
+	assert.Equal(t, "sa-1-deploy", entry.UserLogin)
+
+	entry = resourcePermissionToACL(dashboardResourceType, "xyz", &models.ResourcePermissionDTO{
+		BuiltInRole: "Viewer", Permission: "View", IsInherited: true,
+	})
+	assert.False(t, entry.IsFolder)
+	assert.True(t, entry.Inherited)
+	assert.Equal(t, "Viewer", entry.Role)
+	assert.Equal(t, models.PermissionType(1), entry.Permission)
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+
+	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/internal/service/filters/v2"
+	"github.com/esnet/gdg/internal/tools/ptr"
+	configDomain "github.com/esnet/gdg/pkg/config/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/grafana/grafana-openapi-client-go/client/access_control"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+)
+
+// systemRolePrefixes are role name prefixes owned by grafana which can't be created or modified.
+var systemRolePrefixes = []string{"fixed:", "basic:", "managed:", "plugins:"}
+
+func setupRoleReader(filterObj filters.V2Filter) error {
+	err := filterObj.RegisterReader(reflect.TypeOf(models.RoleDTO{}), func(filterType filters.FilterType, a any) (any, error) {
+		val, ok := a.(models.RoleDTO)
+		if !ok {
+			return nil, fmt.Errorf("unsupported data type")
+		}
+		switch filterType {
+		case filters.Name:
+			return ptr.ValueOrDefault(val.Name, ""), nil
+		default:
+			return nil, fmt.Errorf("unsupported data type")
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create a valid role filter, obj entity reader failed: %w", err)
+	}
+	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
+		val, ok := a.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("unsupported data type")
+		}
+		switch filterType {
+		case filters.Name:
+			r := gjson.GetBytes(val, "role.name")
+			if !r.Exists() || r.IsArray() {
+				return nil, fmt.Errorf("no valid role name found")
+			}
+			return r.String(), nil
+		default:
+			return nil, fmt.Errorf("unsupported data type")
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create a valid role filter, json reader failed: %w", err)
+	}
+	return nil
+}
+
+// NewRoleFilter returns a filter matching custom roles by name.  An empty name matches all roles.
+func NewRoleFilter(name string) (filters.V2Filter, error) {
+	filterObj := v2.NewBaseFilter()
+	if err := setupRoleReader(filterObj); err != nil {
+		return nil, err
+	}
+	filterObj.AddValidation(filters.Name, func(value any, expected any) error {
+		val, expectedValue, convErr := v2.GetParams[string](value, expected, filters.Name)
+		if convErr != nil {
+			return convErr
+		}
+		if expectedValue == "" || val == expectedValue {
+			return nil
+		}
+		return fmt.Errorf("failed Role Name filter, expected %v, got %v", expectedValue, val)
+	}, name)
+
+	return filterObj, nil
+}
+
+// isCustomRole returns true if the role was created by a user rather than provided by grafana
+func isCustomRole(role *models.RoleDTO) bool {
+	name := ptr.ValueOrDefault(role.Name, "")
+	return !lo.SomeBy(systemRolePrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// ListRoles returns all custom roles along with their user, team and service account assignments
+func (s *DashNGoImpl) ListRoles(filter filters.V2Filter) ([]*domain.RoleWithAssignments, error) {
+	if err := s.requireEnterprise(); err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		var err error
+		if filter, err = NewRoleFilter(""); err != nil {
+			return nil, err
+		}
+	}
+	p := access_control.NewListRolesParams()
+	p.IncludeHidden = ptr.Of(true)
+	resp, err := s.GetClient().AccessControl.ListRoles(p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list roles: %w", err)
+	}
+	resolver := s.newPrincipalResolver()
+	var result []*domain.RoleWithAssignments
+	for _, role := range resp.GetPayload() {
+		if !isCustomRole(role) || !filter.ValidateAll(*role) {
+			continue
+		}
+		entry := &domain.RoleWithAssignments{Role: role}
+		assignments, assignErr := s.GetClient().AccessControl.GetRoleAssignments(ptr.ValueOrDefault(role.UID, ""))
+		if assignErr != nil {
+			slog.Warn("unable to retrieve role assignments", "role", ptr.ValueOrDefault(role.Name, ""), "err", assignErr)
+		} else {
+			entry.Assignments = resolver.assignmentNames(assignments.GetPayload())
+		}
+		result = append(result, entry)
+	}
+
+	return result, nil
+}
+
+// DownloadRoles saves all custom roles and their assignments
+func (s *DashNGoImpl) DownloadRoles(filter filters.V2Filter) ([]string, error) {
+	roles, err := s.ListRoles(filter)
+	if err != nil {
+		return nil, err
+	}
+	var dataFiles []string
+	for _, role := range roles {
+		name := ptr.ValueOrDefault(role.Role.Name, "")
+		data, marshalErr := json.MarshalIndent(role, "", "\t")
+		if marshalErr != nil {
+			s.recordFailure(configDomain.RoleResource, name, fmt.Errorf("unable to marshall role: %w", marshalErr))
+			continue
+		}
+		rolePath := buildResourcePath(s.grafanaConf, GetSlug(name), configDomain.RoleResource, s.isLocal(), s.clearedFolders())
+		if err = s.writeBackupFile(rolePath, data); err != nil {
+			s.recordFailure(configDomain.RoleResource, name, fmt.Errorf("unable to write file: %w", err))
+			continue
+		}
+		s.recordSuccess(configDomain.RoleResource, name)
+		dataFiles = append(dataFiles, rolePath)
+	}
+
+	return dataFiles, nil
+}
+
+// UploadRoles creates or updates all custom roles found in storage and re-applies their assignments.  Users, teams
+// and service accounts are matched by login/name, unknown principals are skipped and reported.
+func (s *DashNGoImpl) UploadRoles(filter filters.V2Filter) ([]string, error) {
+	if err := s.requireEnterprise(); err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		var err error
+		if filter, err = NewRoleFilter(""); err != nil {
+			return nil, err
+		}
+	}
+	path := s.grafanaConf.GetPath(configDomain.RoleResource, s.grafanaConf.GetOrganizationName())
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), path, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read role imports: %w", err)
+	}
+	var existing []*models.RoleDTO
+	p := access_control.NewListRolesParams()
+	p.IncludeHidden = ptr.Of(true)
+	if resp, listErr := s.GetClient().AccessControl.ListRoles(p); listErr == nil {
+		existing = resp.GetPayload()
+	}
+	resolver := s.newPrincipalResolver()
+
+	var dataFiles []string
+	for _, file := range filesInDir {
+		if !strings.HasSuffix(file, ".json") {
+			continue
+		}
+		raw, readErr := s.readBackupFile(file)
+		if readErr != nil {
+			s.recordFailure(configDomain.RoleResource, file, fmt.Errorf("failed to read file: %w", readErr))
+			continue
+		}
+		if !filter.ValidateAll(raw) {
+			slog.Debug("Skipping file, failed Role filter", "file", file)
+			continue
+		}
+		var entry domain.RoleWithAssignments
+		if err = json.Unmarshal(raw, &entry); err != nil || entry.Role == nil {
+			s.recordFailure(configDomain.RoleResource, file, fmt.Errorf("failed to unmarshal role: %v", err))
+			continue
+		}
+		name := ptr.ValueOrDefault(entry.Role.Name, "")
+		uid, upsertErr := s.upsertRole(entry.Role, existing)
+		if upsertErr != nil {
+			s.recordFailure(configDomain.RoleResource, name, fmt.Errorf("failed to upload role: %w", upsertErr))
+			continue
+		}
+		cmd := resolver.assignmentIDs(entry.Assignments)
+		unresolvedErr := resolver.unresolvedError(file)
+		if _, err = s.GetClient().AccessControl.SetRoleAssignments(uid, cmd); err != nil {
+			s.recordFailure(configDomain.RoleResource, name, fmt.Errorf("failed to set role assignments: %w", err))
+			continue
+		}
+		if unresolvedErr != nil {
+			s.recordFailure(configDomain.RoleResource, name, unresolvedErr)
+			continue
+		}
+		s.recordSuccess(configDomain.RoleResource, name)
+		dataFiles = append(dataFiles, file)
+	}
+
+	return dataFiles, nil
+}
+
+// findExistingRole returns the role matching the UID of the given one, or its name when restoring onto an instance
+// where the role was created with another UID.
+func findExistingRole(role *models.RoleDTO, existing []*models.RoleDTO) (*models.RoleDTO, bool) {
+	uid := ptr.ValueOrDefault(role.UID, "")
+	if current, ok := lo.Find(existing, func(item *models.RoleDTO) bool {
+		return uid != "" && ptr.ValueOrDefault(item.UID, "") == uid
+	}); ok {
+		return current, true
+	}
+	name := ptr.ValueOrDefault(role.Name, "")
+	return lo.Find(existing, func(item *models.RoleDTO) bool {
+		return name != "" && ptr.ValueOrDefault(item.Name, "") == name
+	})
+}
+
+// upsertRole updates the role if one with the same UID, or failing that the same name, exists, otherwise creates it.
+// Returns the role UID on the target instance.
+func (s *DashNGoImpl) upsertRole(role *models.RoleDTO, existing []*models.RoleDTO) (string, error) {
+	uid := ptr.ValueOrDefault(role.UID, "")
+	if current, ok := findExistingRole(role, existing); ok {
+		uid = ptr.ValueOrDefault(current.UID, uid)
+		cmd := &models.UpdateRoleCommand{
+			Description: role.Description,
+			DisplayName: role.DisplayName,
+			Global:      role.Global,
+			Group:       role.Group,
+			Hidden:      role.Hidden,
+			Name:        ptr.ValueOrDefault(role.Name, ""),
+			Permissions: role.Permissions,
+			Version:     ptr.ValueOrDefault(current.Version, 0) + 1,
+		}
+		if _, err := s.GetClient().AccessControl.UpdateRole(uid, cmd); err != nil {
+			return "", err
+		}
+		return uid, nil
+	}
+	form := &models.CreateRoleForm{
+		Description: ptr.ValueOrDefault(role.Description, ""),
+		DisplayName: ptr.ValueOrDefault(role.DisplayName, ""),
+		Global:      role.Global,
+		Group:       ptr.ValueOrDefault(role.Group, ""),
+		Hidden:      role.Hidden,
+		Name:        ptr.ValueOrDefault(role.Name, ""),
+		Permissions: role.Permissions,
+		UID:         uid,
+		Version:     1,
+	}
+	resp, err := s.GetClient().AccessControl.CreateRole(form)
+	if err != nil {
+		return "", err
+	}
+	return ptr.ValueOrDefault(resp.GetPayload().UID, uid), nil
+}
+
+// DeleteAllRoles removes all custom roles matching the filter along with their assignments
+func (s *DashNGoImpl) DeleteAllRoles(filter filters.V2Filter) ([]string, error) {
+	roles, err := s.ListRoles(filter)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []string
+	for _, role := range roles {
+		p := access_control.NewDeleteRoleParams()
+		p.RoleUID = ptr.ValueOrDefault(role.Role.UID, "")
+		p.Force = ptr.Of(true)
+		p.Global = ptr.Of(role.Role.Global)
+		if _, err = s.GetClient().AccessControl.DeleteRole(p); err != nil {
+			s.recordFailure(configDomain.RoleResource, ptr.ValueOrDefault(role.Role.Name, ""), fmt.Errorf("failed to delete role: %w", err))
+			continue
+		}
+		s.recordSuccess(configDomain.RoleResource, ptr.ValueOrDefault(role.Role.Name, ""))
+		deleted = append(deleted, ptr.ValueOrDefault(role.Role.Name, ""))
+	}
+
+	return deleted, nil
+}
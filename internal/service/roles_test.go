@@ -0,0 +1,47 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	configDomain "github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/internal/tools/ptr"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindExistingRole(t *testing.T) {
+	existing := []*models.RoleDTO{
+		{UID: ptr.Of("reader-uid"), Name: ptr.Of("custom:reader")},
+		{UID: ptr.Of("writer-uid"), Name: ptr.Of("custom:writer")},
+	}
+	role, ok := findExistingRole(&models.RoleDTO{UID: ptr.Of("writer-uid"), Name: ptr.Of("custom:renamed")}, existing)
+	assert.True(t, ok)
+	assert.Equal(t, "writer-uid", *role.UID, "the UID takes precedence over the name")
+
+	role, ok = findExistingRole(&models.RoleDTO{UID: ptr.Of("other-instance"), Name: ptr.Of("custom:reader")}, existing)
+	assert.True(t, ok)
+	assert.Equal(t, "reader-uid", *role.UID)
+
+	_, ok = findExistingRole(&models.RoleDTO{UID: ptr.Of("new-uid"), Name: ptr.Of("custom:new")}, existing)
+	assert.False(t, ok)
+}
+
+func TestUpsertRoleMatchesByName(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	s := newContextTestService(server.URL, &configDomain.AppGlobals{})
+
+	existing := []*models.RoleDTO{{UID: ptr.Of("target-uid"), Name: ptr.Of("custom:reader"), Version: ptr.Of[int64](2)}}
+	uid, err := s.upsertRole(&models.RoleDTO{UID: ptr.Of("source-uid"), Name: ptr.Of("custom:reader")}, existing)
+	require.NoError(t, err)
+	assert.Equal(t, "target-uid", uid)
+	assert.Equal(t, []string{"PUT /api/access-control/roles/target-uid"}, requests, "the existing role is updated instead of created")
+}
@@ -1,7 +1,7 @@
 package service
 
 import (
-	"log"
+	"fmt"
 )
 
 const (
@@ -12,10 +12,10 @@ const (
 )
 
 // GetServerInfo returns basic Grafana Server info
-func (s *DashNGoImpl) GetServerInfo() map[string]any {
+func (s *DashNGoImpl) GetServerInfo() (map[string]any, error) {
 	response, err := s.GetClient().Health.GetHealth()
 	if err != nil {
-		log.Fatalf("Unable to get server health info, err: %v", err)
+		return nil, fmt.Errorf("unable to get server health info: %w", err)
 	}
 	t := response.GetPayload()
 	result := make(map[string]any)
@@ -24,5 +24,5 @@ func (s *DashNGoImpl) GetServerInfo() map[string]any {
 	result[SrvInfoVersionKey] = t.Version
 	result[SrvInfoEnterpriseCommitKey] = t.EnterpriseCommit
 
-	return result
+	return result, nil
 }
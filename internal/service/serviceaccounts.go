@@ -2,18 +2,24 @@ package service
 
 import (
 	"fmt"
-	"log"
 	"log/slog"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/tools/ptr"
+	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
 
 	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/samber/lo"
 )
 
+// service accounts and their tokens are not backed up, the types only label their results
+const (
+	serviceAccountResource      resourceTypes.ResourceType = "service-accounts"
+	serviceAccountTokenResource resourceTypes.ResourceType = "service-account-tokens"
+)
+
 // TODO: create a method to simply delete a service account.
 func (s *DashNGoImpl) CreateServiceAccount(name, role string, expiration int64) (*models.ServiceAccountDTO, error) {
 	p := service_accounts.NewCreateServiceAccountParams()
@@ -23,7 +29,7 @@ func (s *DashNGoImpl) CreateServiceAccount(name, role string, expiration int64)
 	}
 	data, err := s.GetClient().ServiceAccounts.CreateServiceAccount(p)
 	if err != nil {
-		log.Fatalf("unable to create a service request, serviceName: %s, role: %s", name, role)
+		return nil, fmt.Errorf("unable to create a service account, serviceName: %s, role: %s: %w", name, role, err)
 	}
 
 	return data.GetPayload(), nil
@@ -38,20 +44,20 @@ func (s *DashNGoImpl) CreateServiceAccountToken(serviceAccountId int64, name str
 	p.ServiceAccountID = serviceAccountId
 	token, err := s.GetClient().ServiceAccounts.CreateToken(p)
 	if err != nil {
-		log.Fatalf("unable to create token '%s' for service account ID: %d, err: %v", name, serviceAccountId, err)
+		return nil, fmt.Errorf("unable to create token '%s' for service account ID: %d: %w", name, serviceAccountId, err)
 	}
 
 	return token.GetPayload(), nil
 }
 
-func (s *DashNGoImpl) ListServiceAccounts() []*domain.ServiceAccountDTOWithTokens {
+func (s *DashNGoImpl) ListServiceAccounts() ([]*domain.ServiceAccountDTOWithTokens, error) {
 	p := service_accounts.NewSearchOrgServiceAccountsWithPagingParams()
 	p.Disabled = ptr.Of(false)
 	p.Perpage = ptr.Of(int64(5000))
 
 	resp, err := s.GetClient().ServiceAccounts.SearchOrgServiceAccountsWithPaging(p)
 	if err != nil {
-		log.Fatal("unable to retrieve service accounts")
+		return nil, fmt.Errorf("unable to retrieve service accounts: %w", err)
 	}
 	data := resp.GetPayload()
 	result := lo.Map(data.ServiceAccounts, func(entity *models.ServiceAccountDTO, _ int) *domain.ServiceAccountDTOWithTokens {
@@ -64,18 +70,18 @@ func (s *DashNGoImpl) ListServiceAccounts() []*domain.ServiceAccountDTOWithToken
 		if item.ServiceAccount.Tokens > 0 {
 			item.Tokens, err = s.ListServiceAccountsTokens(item.ServiceAccount.ID)
 			if err != nil {
-				slog.Warn("failed to retrieve tokens for service account", "serviceAccountId", item.ServiceAccount.ID)
+				slog.Warn("failed to retrieve tokens for service account", "serviceAccountId", item.ServiceAccount.ID, "err", err)
 			}
 		}
 	}
 
-	return result
+	return result, nil
 }
 
 func (s *DashNGoImpl) ListServiceAccountsTokens(id int64) ([]*models.TokenDTO, error) {
 	response, err := s.GetClient().ServiceAccounts.ListTokens(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve service account for %d response", id)
+		return nil, fmt.Errorf("failed to retrieve tokens for service account %d: %w", id, err)
 	}
 
 	return response.GetPayload(), nil
@@ -86,37 +92,42 @@ func (s *DashNGoImpl) DeleteServiceAccount(accountId int64) error {
 	return err
 }
 
-func (s *DashNGoImpl) DeleteAllServiceAccounts() []string {
+func (s *DashNGoImpl) DeleteAllServiceAccounts() ([]string, error) {
 	var accountNames []string
-	accounts := s.ListServiceAccounts()
+	accounts, err := s.ListServiceAccounts()
+	if err != nil {
+		return nil, err
+	}
 	for _, account := range accounts {
 		accountId := account.ServiceAccount.ID
-		err := s.DeleteServiceAccount(accountId)
+		err = s.DeleteServiceAccount(accountId)
 		if err != nil {
-			slog.Warn("Failed to delete service account", "ServiceAccountId", accountId)
+			s.recordFailure(serviceAccountResource, account.ServiceAccount.Name, fmt.Errorf("failed to delete service account %d: %w", accountId, err))
 		} else {
+			s.recordSuccess(serviceAccountResource, account.ServiceAccount.Name)
 			accountNames = append(accountNames, fmt.Sprintf("service account %d has been deleted", accountId))
 		}
 	}
 
-	return accountNames
+	return accountNames, nil
 }
 
-func (s *DashNGoImpl) DeleteServiceAccountTokens(serviceId int64) []string {
+func (s *DashNGoImpl) DeleteServiceAccountTokens(serviceId int64) ([]string, error) {
 	var result []string
 	tokens, err := s.ListServiceAccountsTokens(serviceId)
 	if err != nil {
-		log.Fatalf("failed to retrieve tokens for the given service ID: %d", serviceId)
+		return nil, err
 	}
 
 	for _, token := range tokens {
-		_, err := s.GetClient().ServiceAccounts.DeleteToken(token.ID, serviceId)
+		_, err = s.GetClient().ServiceAccounts.DeleteToken(token.ID, serviceId)
 		if err != nil {
-			slog.Error("unable to delete token", "tokenID", token.ID)
+			s.recordFailure(serviceAccountTokenResource, token.Name, fmt.Errorf("unable to delete token %d: %w", token.ID, err))
 			continue
 		}
+		s.recordSuccess(serviceAccountTokenResource, token.Name)
 		result = append(result, token.Name)
 	}
 
-	return result
+	return result, nil
 }
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	configDomain "github.com/esnet/gdg/pkg/config/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+const (
+	// ssoRedactedValue is the placeholder grafana returns instead of secrets.  Sending it back leaves the stored secret unchanged.
+	ssoRedactedValue = "*********"
+	// ssoDatabaseSource identifies providers configured through the UI/API, as opposed to the grafana ini file
+	ssoDatabaseSource = "database"
+
+	SSODiffAdded     = "added"
+	SSODiffRemoved   = "removed"
+	SSODiffChanged   = "changed"
+	SSODiffUnchanged = "unchanged"
+)
+
+// ListSSOSettings returns the settings of all SSO providers configured through the API
+func (s *DashNGoImpl) ListSSOSettings() ([]*models.ListAllProvidersSettingsOKBodyItems, error) {
+	resp, err := s.GetClient().SsoSettings.ListAllProvidersSettings()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list SSO settings: %w", err)
+	}
+	return lo.Filter(resp.GetPayload(), func(item *models.ListAllProvidersSettingsOKBodyItems, _ int) bool {
+		return item.Source == ssoDatabaseSource
+	}), nil
+}
+
+// DownloadSSOSettings saves the settings of every SSO provider.  Grafana never returns the secure fields, they are
+// always written as the redacted placeholder and restored on upload from the secure location.
+func (s *DashNGoImpl) DownloadSSOSettings() ([]string, error) {
+	providers, err := s.ListSSOSettings()
+	if err != nil {
+		return nil, err
+	}
+	var dataFiles []string
+	for _, provider := range providers {
+		data, marshalErr := json.MarshalIndent(provider, "", "\t")
+		if marshalErr != nil {
+			slog.Error("unable to marshall SSO settings", "provider", provider.Provider, "err", marshalErr)
+			continue
+		}
+		if data, err = s.redactSSOSettings(data); err != nil {
+			slog.Error("unable to secure SSO settings, file was not written", "provider", provider.Provider, "err", err)
+			continue
+		}
+		ssoPath := buildResourcePath(s.grafanaConf, GetSlug(provider.Provider), configDomain.SSOResource, s.isLocal(), s.clearedFolders())
+		if err = s.writeBackupFile(ssoPath, data); err != nil {
+			slog.Error("unable to write file", "filename", ssoPath, "err", err)
+			continue
+		}
+		dataFiles = append(dataFiles, ssoPath)
+	}
+
+	return dataFiles, nil
+}
+
+// UploadSSOSettings applies all SSO provider settings found in storage, secrets are read from the secure location
+func (s *DashNGoImpl) UploadSSOSettings() ([]string, error) {
+	local, err := s.readLocalSSOSettings()
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, file := range sortedKeys(local) {
+		provider := local[file]
+		settings, secretErr := s.applySSOSecrets(provider.Provider, provider.Settings)
+		if secretErr != nil {
+			slog.Error("failed to read SSO secrets", "provider", provider.Provider, "err", secretErr)
+			continue
+		}
+		body := &models.UpdateProviderSettingsParamsBody{
+			ID:       provider.ID,
+			Provider: provider.Provider,
+			Settings: settings,
+		}
+		if _, err = s.GetClient().SsoSettings.UpdateProviderSettings(provider.Provider, body); err != nil {
+			slog.Error("failed to update SSO settings", "provider", provider.Provider, "filename", file, "err", err)
+			continue
+		}
+		result = append(result, file)
+	}
+
+	return result, nil
+}
+
+// DiffSSOSettings compares the SSO settings in storage with the ones configured in grafana.  Redacted secrets are ignored.
+func (s *DashNGoImpl) DiffSSOSettings() ([]domain.SSOSettingsDiff, error) {
+	local, err := s.readLocalSSOSettings()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.GetClient().SsoSettings.ListAllProvidersSettings()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list SSO settings: %w", err)
+	}
+	remote := make(map[string]map[string]any)
+	for _, provider := range resp.GetPayload() {
+		if provider.Source == ssoDatabaseSource {
+			remote[provider.Provider] = toSettingsMap(provider.Settings)
+		}
+	}
+
+	var result []domain.SSOSettingsDiff
+	seen := make(map[string]bool)
+	for _, file := range sortedKeys(local) {
+		provider := local[file]
+		seen[provider.Provider] = true
+		current, ok := remote[provider.Provider]
+		if !ok {
+			result = append(result, domain.SSOSettingsDiff{Provider: provider.Provider, Status: SSODiffAdded})
+			continue
+		}
+		fields := diffSettings(toSettingsMap(provider.Settings), current)
+		result = append(result, domain.SSOSettingsDiff{
+			Provider: provider.Provider,
+			Status:   lo.Ternary(len(fields) == 0, SSODiffUnchanged, SSODiffChanged),
+			Fields:   fields,
+		})
+	}
+	for _, name := range sortedKeys(remote) {
+		if !seen[name] {
+			result = append(result, domain.SSOSettingsDiff{Provider: name, Status: SSODiffRemoved})
+		}
+	}
+
+	return result, nil
+}
+
+// readLocalSSOSettings reads all SSO settings in storage keyed by file name
+func (s *DashNGoImpl) readLocalSSOSettings() (map[string]*models.ListAllProvidersSettingsOKBodyItems, error) {
+	path := s.grafanaConf.GetPath(configDomain.SSOResource, s.grafanaConf.GetOrganizationName())
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), path, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSO settings: %w", err)
+	}
+	result := make(map[string]*models.ListAllProvidersSettingsOKBodyItems)
+	for _, file := range filesInDir {
+		if !strings.HasSuffix(file, ".json") {
+			continue
+		}
+		fileLocation := filepath.Join(path, file)
+		raw, readErr := s.readBackupFile(fileLocation)
+		if readErr != nil {
+			slog.Error("failed to read file", "filename", fileLocation, "err", readErr)
+			continue
+		}
+		entry := new(models.ListAllProvidersSettingsOKBodyItems)
+		if err = json.Unmarshal(raw, entry); err != nil || entry.Provider == "" {
+			slog.Error("failed to unmarshal SSO settings", "filename", fileLocation, "err", err)
+			continue
+		}
+		result[fileLocation] = entry
+	}
+	return result, nil
+}
+
+// redactSSOSettings replaces the secure fields by the redacted placeholder, in case a secret was ever returned
+func (s *DashNGoImpl) redactSSOSettings(data []byte) ([]byte, error) {
+	var err error
+	for _, field := range s.gdgConfig.GetSecureEntities()[string(configDomain.SSOResource)] {
+		val := gjson.GetBytes(data, field)
+		if !val.Exists() || val.String() == "" || val.String() == ssoRedactedValue {
+			continue
+		}
+		if data, err = sjson.SetBytes(data, field, ssoRedactedValue); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// applySSOSecrets returns the settings with the secrets of the provider found in the secure location.  Secure fields
+// left redacted keep the value stored in grafana, which is empty on a fresh instance.
+func (s *DashNGoImpl) applySSOSecrets(provider string, settings any) (map[string]any, error) {
+	secrets, err := s.grafanaConf.GetSSOSecrets(provider, s.encoder)
+	if err != nil {
+		return nil, err
+	}
+	result := toSettingsMap(settings)
+	for key, value := range secrets {
+		result[key] = value
+	}
+	for _, field := range s.gdgConfig.GetSecureEntities()[string(configDomain.SSOResource)] {
+		key := strings.TrimPrefix(field, "settings.")
+		if result[key] == ssoRedactedValue {
+			slog.Warn("SSO secret is not backed up and was not found in the secure location, the value stored in grafana is kept",
+				"provider", provider, "field", key, "location", s.grafanaConf.GetSSOSecretsLocation(provider))
+		}
+	}
+	return result, nil
+}
+
+// toSettingsMap converts the untyped settings payload into a map
+func toSettingsMap(settings any) map[string]any {
+	if m, ok := settings.(map[string]any); ok {
+		return m
+	}
+	result := make(map[string]any)
+	if raw, err := json.Marshal(settings); err == nil {
+		_ = json.Unmarshal(raw, &result)
+	}
+	return result
+}
+
+// diffSettings returns the sorted list of keys whose values differ, ignoring redacted secrets
+func diffSettings(local, remote map[string]any) []string {
+	var fields []string
+	for _, key := range lo.Union(lo.Keys(local), lo.Keys(remote)) {
+		l, r := local[key], remote[key]
+		if l == ssoRedactedValue || r == ssoRedactedValue {
+			continue
+		}
+		if !reflect.DeepEqual(l, r) {
+			fields = append(fields, key)
+		}
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := lo.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
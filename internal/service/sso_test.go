@@ -0,0 +1,59 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	configDomain "github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/pkg/plugins/secure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestSSOSettingsRedaction(t *testing.T) {
+	s := &DashNGoImpl{gdgConfig: &configDomain.GDGAppConfiguration{
+		PluginConfig: configDomain.PluginConfig{Disabled: true},
+		SecureConfig: map[string][]string{"sso": {"settings.clientSecret", "settings.privateKey"}},
+	}}
+	data, err := s.redactSSOSettings([]byte(`{"provider":"github","settings":{"clientId":"abc","clientSecret":"plaintext"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, ssoRedactedValue, gjson.GetBytes(data, "settings.clientSecret").String())
+	assert.Equal(t, "abc", gjson.GetBytes(data, "settings.clientId").String())
+	assert.False(t, gjson.GetBytes(data, "settings.privateKey").Exists())
+}
+
+func TestApplySSOSecrets(t *testing.T) {
+	grafanaConf := configDomain.NewGrafanaConfig("test")
+	grafanaConf.SecureLocationOverride = t.TempDir()
+	s := &DashNGoImpl{
+		grafanaConf: grafanaConf,
+		encoder:     secure.NoOpEncoder{},
+		gdgConfig: &configDomain.GDGAppConfiguration{
+			SecureConfig: map[string][]string{"sso": {"settings.clientSecret"}},
+		},
+	}
+	settings := map[string]any{"clientId": "abc", "clientSecret": ssoRedactedValue}
+
+	// without a secrets file the placeholder is sent, grafana keeps its current value
+	result, err := s.applySSOSecrets("github", settings)
+	require.NoError(t, err)
+	assert.Equal(t, ssoRedactedValue, result["clientSecret"])
+
+	require.NoError(t, os.WriteFile(grafanaConf.GetSSOSecretsLocation("github")+".yaml", []byte("clientSecret: s3cr3t\n"), 0o600))
+	result, err = s.applySSOSecrets("github", settings)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", result["clientSecret"])
+	assert.Equal(t, "abc", result["clientId"])
+
+	require.NoError(t, os.WriteFile(grafanaConf.GetSSOSecretsLocation("okta")+".json", []byte("{"), 0o600))
+	_, err = s.applySSOSecrets("okta", settings)
+	assert.ErrorContains(t, err, "unable to parse secrets")
+}
+
+func TestSSOSettingsDiff(t *testing.T) {
+	local := map[string]any{"clientId": "abc", "clientSecret": ssoRedactedValue, "enabled": true, "scopes": "user"}
+	remote := map[string]any{"clientId": "xyz", "clientSecret": ssoRedactedValue, "enabled": true, "authUrl": "http://auth"}
+	assert.Equal(t, []string{"authUrl", "clientId", "scopes"}, diffSettings(local, remote))
+	assert.Empty(t, diffSettings(local, local))
+}
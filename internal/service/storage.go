@@ -0,0 +1,59 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/esnet/gdg/internal/storage"
+)
+
+// ReEncryptStorage rewrites all the backups of the current context using the configured encryption key.  previous
+// holds the encryption settings the files were written with, empty if they are in plaintext or use the current key.
+func (s *DashNGoImpl) ReEncryptStorage(previous map[string]string) ([]string, error) {
+	return storage.ReEncrypt(s.requestContext(), s.storage, previous, s.grafanaConf.OutputPath)
+}
+
+// PruneSnapshots removes the snapshots of the current context that aren't retained by the policy
+func (s *DashNGoImpl) PruneSnapshots(policy storage.RetentionPolicy, dryRun bool) ([]storage.SnapshotResult, error) {
+	snapshots, ok := storage.FindSnapshotStorage(s.storage)
+	if !ok {
+		return nil, errors.New("snapshots are not enabled for the storage engine")
+	}
+	return snapshots.Prune(s.requestContext(), policy, dryRun)
+}
+
+// CopyStorage copies the backups of the current context between two storage engines.  Engines are referenced by their
+// storage_engine label, `local` is the local disk unless a storage engine uses that label.
+func (s *DashNGoImpl) CopyStorage(from, to string, resume bool) ([]storage.CopyResult, error) {
+	if from == to {
+		return nil, errors.New("source and destination storage engines must be different")
+	}
+	src, err := s.getStorageEngine(from)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := s.getStorageEngine(to)
+	if err != nil {
+		return nil, err
+	}
+	ctx := s.requestContext()
+	results, err := storage.Copy(ctx, src, dst, s.grafanaConf.OutputPath, resume)
+	if committer, ok := dst.(storage.Committer); ok {
+		if commitErr := committer.Commit(ctx, fmt.Sprintf("gdg tools storage copy --from %s --to %s", from, to)); commitErr != nil {
+			return results, errors.Join(err, commitErr)
+		}
+	}
+	return results, err
+}
+
+// getStorageEngine configures the storage engine with the given label
+func (s *DashNGoImpl) getStorageEngine(label string) (storage.Storage, error) {
+	if _, ok := s.gdgConfig.StorageEngine[label]; !ok && label != storage.LocalCloudType {
+		return nil, fmt.Errorf("storage engine '%s' is not configured", label)
+	}
+	engine, err := configureStorageEngine(s.gdgConfig, label, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure storage engine '%s': %w", label, err)
+	}
+	return engine, nil
+}
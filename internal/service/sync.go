@@ -0,0 +1,433 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/esnet/gdg/internal/storage"
+	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
+)
+
+const (
+	SyncStatusOK     = "ok"
+	SyncStatusFailed = "failed"
+
+	// folders of the in memory storage holding the files of each side of a sync
+	syncSourceFolder = "source"
+	syncTargetFolder = "target"
+)
+
+// SyncOptions describes what is copied from one context to another
+type SyncOptions struct {
+	From      string
+	To        string
+	Resources []string
+	// Organizations maps source organization names to target organization names, the organizations configured by
+	// each context are used when empty.
+	Organizations map[string]string
+	// Connections maps source connection names to target connection names, connections are matched by name otherwise.
+	Connections map[string]string
+	// Promotion rules file applied when uploading to the target, overrides the rules configured by the target context.
+	Promotion string
+}
+
+// SyncResult summarizes the outcome of syncing a single resource of an organization
+type SyncResult struct {
+	SourceOrganization string `json:"sourceOrganization"`
+	TargetOrganization string `json:"targetOrganization"`
+	Resource           string `json:"resource"`
+	Status             string `json:"status"`
+	Downloaded         int    `json:"downloaded"`
+	Uploaded           int    `json:"uploaded"`
+	Error              string `json:"error,omitempty"`
+}
+
+// syncResource a resource supported by sync, entities referring to connections are remapped onto the connections of
+// the target context.
+type syncResource struct {
+	resourceType resourceTypes.ResourceType
+	remap        bool
+	// filter builds the filter both sides are synced with, no filter is used when nil
+	filter   func(s *DashNGoImpl) (filters.V2Filter, error)
+	download func(s *DashNGoImpl, filter filters.V2Filter) (int, error)
+	upload   func(s *DashNGoImpl, filter filters.V2Filter) (int, error)
+}
+
+// countOf returns the number of entities processed by a download or upload
+func countOf(items []string, err error) (int, error) {
+	return len(items), err
+}
+
+// getSyncResources returns every resource supported by sync in the order they are synced in, entities are always
+// created before the entities referring to them.
+func getSyncResources() []syncResource {
+	return []syncResource{
+		{
+			resourceType: resourceTypes.FolderResource,
+			download: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.DownloadFolders(filter))
+			},
+			upload: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.UploadFolders(filter))
+			},
+		},
+		{
+			resourceType: resourceTypes.ConnectionResource,
+			filter: func(s *DashNGoImpl) (filters.V2Filter, error) {
+				return NewConnectionFilter("")
+			},
+			download: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.DownloadConnections(filter))
+			},
+			upload: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.UploadConnections(filter))
+			},
+		},
+		{
+			resourceType: resourceTypes.LibraryElementResource,
+			remap:        true,
+			filter: func(s *DashNGoImpl) (filters.V2Filter, error) {
+				return NewLibraryElementFilter(s.gdgConfig)
+			},
+			download: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.DownloadLibraryElements(filter))
+			},
+			upload: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.UploadLibraryElements(filter))
+			},
+		},
+		{
+			resourceType: resourceTypes.DashboardResource,
+			remap:        true,
+			filter: func(s *DashNGoImpl) (filters.V2Filter, error) {
+				return NewDashboardFilter(s.gdgConfig, "", "", "")
+			},
+			download: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.DownloadDashboards(filter))
+			},
+			upload: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.UploadDashboards(filter))
+			},
+		},
+		{
+			resourceType: resourceTypes.AlertingRulesResource,
+			remap:        true,
+			filter: func(s *DashNGoImpl) (filters.V2Filter, error) {
+				return NewAlertRuleFilter(s.gdgConfig, s)
+			},
+			download: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				return countOf(s.DownloadAlertRules(filter))
+			},
+			upload: func(s *DashNGoImpl, filter filters.V2Filter) (int, error) {
+				if err := s.UploadAlertRules(filter); err != nil {
+					return 0, err
+				}
+				promotion, err := s.promotionRules()
+				if err != nil {
+					return 0, err
+				}
+				orgName := promotion.sourceOrganization(s.grafanaConf.GetOrganizationName())
+				rulesPath := s.grafanaConf.GetPath(resourceTypes.AlertingRulesResource, orgName)
+				files, err := s.storage.FindAllFiles(s.requestContext(), rulesPath, true)
+				return len(files), err
+			},
+		},
+	}
+}
+
+// SyncResources returns the name of every resource supported by sync in the order they are synced in
+func SyncResources() []string {
+	var names []string
+	for _, r := range getSyncResources() {
+		names = append(names, r.resourceType.String())
+	}
+	return names
+}
+
+// selectSyncResources returns the requested resources in the order they need to be synced in, all of them when none
+// are requested.
+func selectSyncResources(names []string) ([]syncResource, error) {
+	resources := getSyncResources()
+	if len(names) == 0 {
+		return resources, nil
+	}
+	for _, name := range names {
+		if !slices.Contains(SyncResources(), name) {
+			return nil, fmt.Errorf("unsupported resource '%s', valid resources are: %s", name, strings.Join(SyncResources(), ", "))
+		}
+	}
+	return slices.DeleteFunc(resources, func(r syncResource) bool {
+		return !slices.Contains(names, r.resourceType.String())
+	}), nil
+}
+
+// SyncContexts copies entities from one context to another without writing anything to disk.  Entities are downloaded
+// from the source context into memory, moved to the organization they are synced to, have their connection references
+// remapped onto the connections of the target context and are then uploaded to the target context.
+func (s *DashNGoImpl) SyncContexts(opts SyncOptions) ([]SyncResult, error) {
+	resources, err := selectSyncResources(opts.Resources)
+	if err != nil {
+		return nil, err
+	}
+	engine := storage.NewMemoryStorage()
+	source, err := newSyncInstance(s.requestContext(), s.gdgConfig, opts.From, syncSourceFolder, engine)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source context: %w", err)
+	}
+	target, err := newSyncInstance(s.requestContext(), s.gdgConfig, opts.To, syncTargetFolder, engine)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target context: %w", err)
+	}
+	if opts.Promotion != "" {
+		target.grafanaConf.PromotionFile = opts.Promotion
+	}
+	if _, err = target.promotionRules(); err != nil {
+		return nil, err
+	}
+
+	orgMap := opts.Organizations
+	if len(orgMap) == 0 {
+		orgMap = map[string]string{source.grafanaConf.GetOrganizationName(): target.grafanaConf.GetOrganizationName()}
+	}
+	if source.gdgConfig.GetContext() == target.gdgConfig.GetContext() {
+		for srcOrg, dstOrg := range orgMap {
+			if srcOrg == dstOrg {
+				return nil, fmt.Errorf("organization '%s' can't be synced onto itself", srcOrg)
+			}
+		}
+	}
+
+	ctx := s.requestContext()
+	var results []SyncResult
+	for _, srcOrg := range slices.Sorted(maps.Keys(orgMap)) {
+		dstOrg := orgMap[srcOrg]
+		var orgErr error
+		orgSource, orgTarget := source, target
+		if len(opts.Organizations) > 0 {
+			var sourceErr, targetErr error
+			orgSource, sourceErr = source.forOrganization(srcOrg)
+			orgTarget, targetErr = target.forOrganization(dstOrg)
+			orgErr = errors.Join(sourceErr, targetErr)
+		}
+		var connections *connectionMapping
+		for _, r := range resources {
+			result := SyncResult{SourceOrganization: srcOrg, TargetOrganization: dstOrg, Resource: r.resourceType.String(), Status: SyncStatusOK}
+			stepErr := orgErr
+			if stepErr == nil {
+				var mapping *connectionMapping
+				if r.remap {
+					if connections == nil {
+						connections, stepErr = newConnectionMapping(orgSource, orgTarget, opts.Connections)
+					}
+					mapping = connections
+				}
+				if stepErr == nil {
+					result.Downloaded, result.Uploaded, stepErr = r.sync(ctx, orgSource, orgTarget, mapping)
+				}
+			}
+			if stepErr != nil {
+				slog.Error("Unable to sync resource", "resource", result.Resource, "organization", srcOrg, "err", stepErr)
+				result.Status = SyncStatusFailed
+				result.Error = stepErr.Error()
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// sync downloads the resource from the source, moves its files to the folder of the target and uploads them
+func (r syncResource) sync(ctx context.Context, source, target *DashNGoImpl, connections *connectionMapping) (int, int, error) {
+	// the target uploads the files of the organization its promotion rules map onto the current one
+	promotion, err := target.promotionRules()
+	if err != nil {
+		return 0, 0, err
+	}
+	srcDir := source.grafanaConf.GetPath(r.resourceType, source.grafanaConf.GetOrganizationName())
+	dstDir := target.grafanaConf.GetPath(r.resourceType, promotion.sourceOrganization(target.grafanaConf.GetOrganizationName()))
+	// both folders may still hold the files of a previous organization
+	for _, dir := range []string{srcDir, dstDir} {
+		if err := source.storage.Delete(ctx, dir); err != nil {
+			return 0, 0, err
+		}
+	}
+	sourceFilter, targetFilter, err := r.filters(source, target)
+	if err != nil {
+		return 0, 0, err
+	}
+	downloaded, err := r.download(source, sourceFilter)
+	if err != nil {
+		return downloaded, 0, err
+	}
+	if err = transferFiles(ctx, source.storage, srcDir, dstDir, connections); err != nil {
+		return downloaded, 0, err
+	}
+	uploaded, err := r.upload(target, targetFilter)
+	return downloaded, uploaded, err
+}
+
+// filters returns the filter of each side of the sync, both are nil when the resource is synced without a filter
+func (r syncResource) filters(source, target *DashNGoImpl) (filters.V2Filter, filters.V2Filter, error) {
+	if r.filter == nil {
+		return nil, nil, nil
+	}
+	sourceFilter, err := r.filter(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetFilter, err := r.filter(target)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sourceFilter, targetFilter, nil
+}
+
+// newSyncInstance returns a service logged into the Grafana instance of the given context that stores its files in
+// the given folder of the engine shared by both sides of the sync.
+func newSyncInstance(ctx context.Context, cfg *domain.GDGAppConfiguration, contextName, folder string, engine storage.Storage) (*DashNGoImpl, error) {
+	ctxCfg, err := cfg.ForContext(contextName)
+	if err != nil {
+		return nil, err
+	}
+	grafanaConf := ctxCfg.GetDefaultGrafanaConfig()
+	// secrets are still read from the location the context is configured with
+	grafanaConf.SecureLocationOverride = grafanaConf.SecureLocation()
+	grafanaConf.OutputPath = folder
+	obj, err := newGrafanaClient(ctx, ctxCfg)
+	if err != nil {
+		return nil, err
+	}
+	obj.SetStorage(engine)
+	return obj, nil
+}
+
+// transferFiles copies every file found under from to the same location under to, remapping the connections the
+// JSON entities refer to when a mapping is given.
+func transferFiles(ctx context.Context, engine storage.Storage, from, to string, connections *connectionMapping) error {
+	files, err := engine.FindAllFiles(ctx, from, true)
+	if err != nil {
+		return fmt.Errorf("unable to list files in %s: %w", from, err)
+	}
+	for _, file := range files {
+		data, readErr := engine.ReadFile(ctx, file)
+		if readErr != nil {
+			return fmt.Errorf("unable to read %s: %w", file, readErr)
+		}
+		if connections != nil && strings.HasSuffix(file, ".json") {
+			if data, err = connections.remap(data); err != nil {
+				return fmt.Errorf("unable to remap connections of %s: %w", file, err)
+			}
+		}
+		if err = engine.WriteFile(ctx, path.Join(to, backupRelativePath(engine, from, file)), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectionMapping maps the connections of the source context onto the connections of the target context, by uid
+// and by name.
+type connectionMapping struct {
+	uids  map[string]string
+	names map[string]string
+}
+
+func newConnectionMapping(source, target *DashNGoImpl, overrides map[string]string) (*connectionMapping, error) {
+	filter, err := NewConnectionFilter("")
+	if err != nil {
+		return nil, err
+	}
+	targetConnections, err := target.ListConnections(filter)
+	if err != nil {
+		return nil, err
+	}
+	sourceConnections, err := source.ListConnections(filter)
+	if err != nil {
+		return nil, err
+	}
+	targetUIDs := make(map[string]string)
+	for _, item := range targetConnections {
+		targetUIDs[item.Name] = item.UID
+	}
+	m := &connectionMapping{uids: make(map[string]string), names: make(map[string]string)}
+	for _, item := range sourceConnections {
+		name := item.Name
+		if val, ok := overrides[name]; ok {
+			name = val
+		}
+		uid, ok := targetUIDs[name]
+		if !ok {
+			slog.Warn("Connection not found in target context, references to it are left unchanged", "connection", name)
+			continue
+		}
+		m.names[item.Name] = name
+		m.uids[item.UID] = uid
+	}
+	return m, nil
+}
+
+// remap rewrites the connections referenced by the JSON entity, the entity is returned untouched when nothing changed
+func (m *connectionMapping) remap(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var entity any
+	if err := decoder.Decode(&entity); err != nil {
+		return nil, err
+	}
+	if !m.remapValue(entity) {
+		return data, nil
+	}
+	return json.MarshalIndent(entity, "", "    ")
+}
+
+// remapValue walks the entity replacing datasource references, both `"datasource": {"uid": ...}` and the legacy
+// `"datasource": "name"` forms as well as the `datasourceUid` of alert rule queries.
+func (m *connectionMapping) remapValue(value any) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			switch ref := item.(type) {
+			case string:
+				if key == "datasource" {
+					changed = replaceValue(v, key, m.names) || replaceValue(v, key, m.uids) || changed
+				} else if key == "datasourceUid" {
+					changed = replaceValue(v, key, m.uids) || changed
+				}
+			case map[string]any:
+				if key == "datasource" {
+					changed = replaceValue(ref, "uid", m.uids) || changed
+				}
+			}
+			changed = m.remapValue(item) || changed
+		}
+	case []any:
+		for _, item := range v {
+			changed = m.remapValue(item) || changed
+		}
+	}
+	return changed
+}
+
+// replaceValue replaces the string stored under key using the mapping, returns true if the value changed
+func replaceValue(entity map[string]any, key string, mapping map[string]string) bool {
+	current, ok := entity[key].(string)
+	if !ok {
+		return false
+	}
+	if val, found := mapping[current]; found && val != current {
+		entity[key] = val
+		return true
+	}
+	return false
+}
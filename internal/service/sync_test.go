@@ -0,0 +1,89 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectSyncResources(t *testing.T) {
+	resources, err := selectSyncResources(nil)
+	require.NoError(t, err)
+	assert.Len(t, resources, len(SyncResources()))
+
+	resources, err = selectSyncResources([]string{"dashboards", "folders"})
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "folders", resources[0].resourceType.String())
+	assert.Equal(t, "dashboards", resources[1].resourceType.String())
+
+	_, err = selectSyncResources([]string{"users"})
+	assert.ErrorContains(t, err, "unsupported resource 'users'")
+}
+
+func TestConnectionMappingRemap(t *testing.T) {
+	m := &connectionMapping{
+		uids:  map[string]string{"prom-a": "prom-b", "loki-a": "loki-a"},
+		names: map[string]string{"Prometheus": "Prometheus Staging"},
+	}
+	data := []byte(`{
+		"panels": [
+			{"id": 1, "datasource": {"type": "prometheus", "uid": "prom-a"}},
+			{"id": 2, "datasource": "Prometheus", "targets": [{"datasource": {"uid": "loki-a"}}]}
+		],
+		"data": [{"datasourceUid": "prom-a"}],
+		"version": 12345678901234567
+	}`)
+
+	remapped, err := m.remap(data)
+	require.NoError(t, err)
+	var entity map[string]any
+	require.NoError(t, json.Unmarshal(remapped, &entity))
+	panels := entity["panels"].([]any)
+	assert.Equal(t, "prom-b", panels[0].(map[string]any)["datasource"].(map[string]any)["uid"])
+	assert.Equal(t, "Prometheus Staging", panels[1].(map[string]any)["datasource"])
+	assert.Equal(t, "prom-b", entity["data"].([]any)[0].(map[string]any)["datasourceUid"])
+	assert.Contains(t, string(remapped), "12345678901234567")
+
+	unchanged := []byte(`{"datasource": {"uid": "loki-a"}}`)
+	remapped, err = m.remap(unchanged)
+	require.NoError(t, err)
+	assert.Equal(t, unchanged, remapped)
+
+	_, err = m.remap([]byte("{"))
+	assert.Error(t, err)
+}
+
+func TestTransferFiles(t *testing.T) {
+	ctx := t.Context()
+	engine := storage.NewMemoryStorage()
+	files := map[string]string{
+		"source/org_main-org/dashboards/General/a.json":  `{"datasource": {"uid": "prom-a"}}`,
+		"source/org_main-org/dashboards/Other/b.json":    `{"title": "b"}`,
+		"source/org_main-org/connections/prom.json":      `{"uid": "prom-a"}`,
+		"source/org_other-org/dashboards/General/c.json": `{"title": "c"}`,
+	}
+	for name, data := range files {
+		require.NoError(t, engine.WriteFile(ctx, name, []byte(data)))
+	}
+	m := &connectionMapping{uids: map[string]string{"prom-a": "prom-b"}, names: map[string]string{}}
+
+	err := transferFiles(ctx, engine, "source/org_main-org/dashboards", "target/org_staging/dashboards", m)
+	require.NoError(t, err)
+
+	transferred, err := engine.FindAllFiles(ctx, "target", true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"memory/target/org_staging/dashboards/General/a.json",
+		"memory/target/org_staging/dashboards/Other/b.json",
+	}, transferred)
+	data, err := engine.ReadFile(ctx, "target/org_staging/dashboards/General/a.json")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"prom-b"`)
+	data, err = engine.ReadFile(ctx, "target/org_staging/dashboards/Other/b.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"title": "b"}`, string(data))
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"path/filepath"
 	"reflect"
@@ -30,7 +29,7 @@ const (
 	AdminUserPermission = 4
 )
 
-func setupTeamReader(filterObj filters.V2Filter) {
+func setupTeamReader(filterObj filters.V2Filter) error {
 	obj := models.TeamDTO{}
 	err := filterObj.RegisterReader(reflect.TypeOf(obj), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(models.TeamDTO)
@@ -46,7 +45,7 @@ func setupTeamReader(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Team Filter, obj entity reader failed, aborting.")
+		return fmt.Errorf("unable to create a valid team filter, obj entity reader failed: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.([]byte)
@@ -69,13 +68,19 @@ func setupTeamReader(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid Team Filter, json reader failed, aborting.")
+		return fmt.Errorf("unable to create a valid team filter, json reader failed: %w", err)
 	}
+	return nil
 }
 
-func NewTeamFilter(entries ...string) filters.V2Filter {
+func NewTeamFilter(entries ...string) (filters.V2Filter, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("unable to create a valid team filter, expected a team name filter")
+	}
 	filterObj := v2.NewBaseFilter()
-	setupTeamReader(filterObj)
+	if err := setupTeamReader(filterObj); err != nil {
+		return nil, err
+	}
 	filterObj.AddValidation(filters.Name, func(value any, expected any) error {
 		val, expectedValue, convErr := v2.GetParams[string](value, expected, filters.Name)
 		if convErr != nil {
@@ -90,65 +95,75 @@ func NewTeamFilter(entries ...string) filters.V2Filter {
 		return nil
 	}, entries[0])
 
-	return filterObj
+	return filterObj, nil
 }
 
 // DownloadTeams fetches all teams for a given Org
-func (s *DashNGoImpl) DownloadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
-	teamListing := maps.Keys(s.ListTeams(filter))
+func (s *DashNGoImpl) DownloadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
+	currentTeams, err := s.ListTeams(filter)
+	if err != nil {
+		return nil, err
+	}
+	teamListing := maps.Keys(currentTeams)
 	importedTeams := make(map[*models.TeamDTO][]*models.TeamMemberDTO)
-	teamPath := BuildResourceFolder(s.grafanaConf, "", domain.TeamResource, s.isLocal(), s.GetGlobals().ClearOutput)
+	teamPath := BuildResourceFolder(s.grafanaConf, "", domain.TeamResource, s.isLocal(), s.clearedFolders())
 	for ndx, team := range teamListing {
 		// Teams
 		teamFileName := filepath.Join(teamPath, GetSlug(ptr.ValueOrDefault(team.Name, "")), "team.json")
+		teamName := ptr.ValueOrDefault(team.Name, "")
 		teamData, err := json.MarshalIndent(&teamListing[ndx], "", "\t")
 		if err != nil {
-			slog.Error("could not serialize team object for team name", "teamName", team.Name)
+			s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("could not serialize team object: %w", err))
 			continue
 		}
 		// Members
-		memberFileName := filepath.Join(teamPath, GetSlug(ptr.ValueOrDefault(team.Name, "")), "members.json")
+		memberFileName := filepath.Join(teamPath, GetSlug(teamName), "members.json")
 		members, err := s.GetClient().Teams.GetTeamMembers(fmt.Sprintf("%d", ptr.ValueOrDefault(team.ID, 0)))
 		if err != nil {
-			slog.Error("could not get team members object for team name", "teamName", team.Name)
+			s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("could not get team members: %w", err))
 			continue
 		}
 		membersData, err := json.MarshalIndent(members.GetPayload(), "", "\t")
 		if err != nil {
-			slog.Error("could not serialize team members object for team name", "teamName", team.Name)
+			s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("could not serialize team members: %w", err))
 			continue
 		}
 		// Writing Files
-		if err = s.storage.WriteFile(teamFileName, teamData); err != nil {
-			slog.Error("could not write file", "teamName", team.Name, "err", err)
-		} else if err = s.storage.WriteFile(memberFileName, membersData); err != nil {
-			slog.Error("could not write team members file", "teamName", team.Name, "err", err)
+		if err = s.writeBackupFile(teamFileName, teamData); err != nil {
+			s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("could not write file: %w", err))
+		} else if err = s.writeBackupFile(memberFileName, membersData); err != nil {
+			s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("could not write team members file: %w", err))
 		} else {
+			s.recordSuccess(domain.TeamResource, teamName)
 			importedTeams[team] = members.GetPayload()
 		}
 	}
-	return importedTeams
+	return importedTeams, nil
 }
 
 // UploadTeams Export Teams
-func (s *DashNGoImpl) UploadTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (s *DashNGoImpl) UploadTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	orgName := s.grafanaConf.GetOrganizationName()
-	filesInDir, err := s.storage.FindAllFiles(s.grafanaConf.GetPath(domain.TeamResource, orgName), true)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), s.grafanaConf.GetPath(domain.TeamResource, orgName), true)
 	if err != nil {
-		slog.Error("failed to list files in directory for teams", "err", err)
+		return nil, fmt.Errorf("failed to list files in directory for teams: %w", err)
 	}
 	exportedTeams := make(map[*models.TeamDTO][]*models.TeamMemberDTO)
 	// Clear previous data.
 	_, err = s.DeleteTeam(filter)
 	if err != nil {
-		log.Fatalf("Failed to clear previous data, aborting")
+		return nil, fmt.Errorf("failed to clear previous data: %w", err)
+	}
+	users, err := s.listAllUsers()
+	if err != nil {
+		return nil, err
 	}
 	for _, fileLocation := range filesInDir {
 		if strings.HasSuffix(fileLocation, "team.json") {
 			// Export Team
 			var rawTeam []byte
-			if rawTeam, err = s.storage.ReadFile(fileLocation); err != nil {
-				slog.Error("failed to read file", "filename", fileLocation, "err", err)
+			if rawTeam, err = s.readBackupFile(fileLocation); err != nil {
+				s.recordFailure(domain.TeamResource, fileLocation, fmt.Errorf("failed to read file: %w", err))
 				continue
 			}
 			if !filter.ValidateAll(rawTeam) {
@@ -157,16 +172,17 @@ func (s *DashNGoImpl) UploadTeams(filter filters.V2Filter) map[*models.TeamDTO][
 			}
 			var newTeam *models.TeamDTO
 			if err = json.Unmarshal(rawTeam, &newTeam); err != nil {
-				slog.Error("failed to unmarshal file", "filename", fileLocation, "err", err)
+				s.recordFailure(domain.TeamResource, fileLocation, fmt.Errorf("failed to unmarshal file: %w", err))
 				continue
 			}
+			teamName := ptr.ValueOrDefault(newTeam.Name, "")
 			p := &models.CreateTeamCommand{
 				Name:  newTeam.Name,
 				Email: newTeam.Email,
 			}
 			teamCreated, teamCreatedErr := s.GetClient().Teams.CreateTeam(p)
 			if teamCreatedErr != nil {
-				slog.Error("failed to create team for file", "filename", fileLocation, "err", teamCreatedErr)
+				s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("failed to create team: %w", teamCreatedErr))
 				continue
 			}
 
@@ -175,77 +191,93 @@ func (s *DashNGoImpl) UploadTeams(filter filters.V2Filter) map[*models.TeamDTO][
 			var currentMembers []*models.TeamMemberDTO
 			var rawMembers []byte
 
-			teamMemberLocation := filepath.Join(s.grafanaConf.GetPath(domain.TeamResource, orgName), GetSlug(ptr.ValueOrDefault(newTeam.Name, "")), "members.json")
-			if rawMembers, err = s.storage.ReadFile(teamMemberLocation); err != nil {
-				slog.Error("failed to find team members", "filename", fileLocation, "err", err)
+			teamMemberLocation := filepath.Join(s.grafanaConf.GetPath(domain.TeamResource, orgName), GetSlug(teamName), "members.json")
+			if rawMembers, err = s.readBackupFile(teamMemberLocation); err != nil {
+				s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("failed to find team members: %w", err))
 				continue
 			}
 			var newMembers []*models.TeamMemberDTO
 			if err = json.Unmarshal(rawMembers, &newMembers); err != nil {
-				slog.Error("failed to unmarshal file", "filename", fileLocation, "err", err)
+				s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("failed to unmarshal team members: %w", err))
 				continue
 			}
+			var memberErr error
 			for _, member := range newMembers {
 				if s.isAdminUser(member.UserID, member.Name) {
 					slog.Warn("skipping admin user, already added when new team is created")
 					continue
 				}
-				_, addTeamErr := s.addTeamMember(newTeam, member)
+				_, addTeamErr := s.addTeamMember(newTeam, member, users)
 				if addTeamErr != nil {
-					slog.Error("failed to create team member for team", "teamName", newTeam.Name, "MemberID", member.UserID, "err", addTeamErr)
+					slog.Error("failed to create team member for team", "teamName", teamName, "MemberID", member.UserID, "err", addTeamErr)
+					memberErr = addTeamErr
 				} else {
 					currentMembers = append(currentMembers, member)
 				}
 			}
+			if memberErr != nil {
+				s.recordFailure(domain.TeamResource, teamName, memberErr)
+			} else {
+				s.recordSuccess(domain.TeamResource, teamName)
+			}
 			exportedTeams[newTeam] = currentMembers
 		}
 	}
-	return exportedTeams
+	return exportedTeams, nil
 }
 
 // ListTeams List all Teams in a given org
-func (s *DashNGoImpl) ListTeams(filter filters.V2Filter) map[*models.TeamDTO][]*models.TeamMemberDTO {
+func (s *DashNGoImpl) ListTeams(filter filters.V2Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
 	result := make(map[*models.TeamDTO][]*models.TeamMemberDTO)
 	p := teams.NewSearchTeamsParams()
 	p.Perpage = ptr.Of[int64](99999)
 	data, err := s.GetClient().Teams.SearchTeams(p)
 	if err != nil {
-		log.Fatal("unable to list teams")
+		return nil, fmt.Errorf("unable to list teams: %w", err)
 	}
 
-	getTeamMembers := func(team *models.TeamDTO) {
-		if ptr.ValueOrDefault(team.MemberCount, 0) > 0 {
-			result[team] = s.listTeamMembers(ptr.ValueOrDefault(team.ID, 0))
-		} else {
+	getTeamMembers := func(team *models.TeamDTO) error {
+		if ptr.ValueOrDefault(team.MemberCount, 0) == 0 {
 			result[team] = nil
+			return nil
 		}
+		members, membersErr := s.listTeamMembers(ptr.ValueOrDefault(team.ID, 0))
+		if membersErr != nil {
+			return membersErr
+		}
+		result[team] = members
+		return nil
 	}
 
 	for _, team := range data.GetPayload().Teams {
-		if filter != nil {
-			if filter.Validate(filters.Name, *team) {
-				getTeamMembers(team)
-			}
-		} else {
-			getTeamMembers(team)
+		if filter != nil && !filter.Validate(filters.Name, *team) {
+			continue
+		}
+		if err = getTeamMembers(team); err != nil {
+			return nil, err
 		}
 	}
 
-	return result
+	return result, nil
 }
 
 // DeleteTeam removes all Teams
 func (s *DashNGoImpl) DeleteTeam(filter filters.V2Filter) ([]*models.TeamDTO, error) {
-	teamListing := maps.Keys(s.ListTeams(filter))
+	currentTeams, err := s.ListTeams(filter)
+	if err != nil {
+		return nil, err
+	}
+	teamListing := maps.Keys(currentTeams)
 	var result []*models.TeamDTO
 	for _, team := range teamListing {
+		teamName := ptr.ValueOrDefault(team.Name, "")
 		if !filter.ValidateAll(*team) || team.ID == nil {
-			slog.Error("Team failed basic validation, could not delete entry")
+			s.recordSkipped(domain.TeamResource, teamName, "team failed basic validation, could not delete entry")
 			continue
 		}
-		_, err := s.GetClient().Teams.DeleteTeamByID(fmt.Sprintf("%d", *team.ID))
+		_, err = s.GetClient().Teams.DeleteTeamByID(fmt.Sprintf("%d", *team.ID))
 		if err != nil {
-			slog.Error("failed to delete team", "teamName", *team.Name)
+			s.recordFailure(domain.TeamResource, teamName, fmt.Errorf("failed to delete team: %w", err))
 			continue
 		}
 		result = append(result, team)
@@ -255,28 +287,27 @@ func (s *DashNGoImpl) DeleteTeam(filter filters.V2Filter) ([]*models.TeamDTO, er
 }
 
 // List Team Members of specific Team
-func (s *DashNGoImpl) listTeamMembers(teamID int64) []*models.TeamMemberDTO {
+func (s *DashNGoImpl) listTeamMembers(teamID int64) ([]*models.TeamMemberDTO, error) {
 	teamIDStr := fmt.Sprintf("%d", teamID)
 	members, err := s.GetClient().Teams.GetTeamMembers(teamIDStr)
 	if err != nil {
-		log.Fatal(fmt.Errorf("team:  '%d' could not be found", teamID))
+		return nil, fmt.Errorf("team:  '%d' could not be found: %w", teamID, err)
 	}
 
-	return members.GetPayload()
+	return members.GetPayload(), nil
 }
 
-// Add User to a Team
-func (s *DashNGoImpl) addTeamMember(team *models.TeamDTO, userDTO *models.TeamMemberDTO) (string, error) {
+// Add User to a Team, the user is looked up by login in the given listing
+func (s *DashNGoImpl) addTeamMember(team *models.TeamDTO, userDTO *models.TeamMemberDTO, users []*models.UserSearchHitDTO) (string, error) {
 	if team == nil {
-		log.Fatal(fmt.Errorf("team:  '%s' could not be found", ptr.ValueOrDefault(team.Name, "")))
+		return "", fmt.Errorf("team could not be found: %w", ErrNotFound)
 	}
-	users := s.ListUsers(NewUserFilter(""))
 	user, _ := lo.Find(users, func(item *models.UserSearchHitDTO) bool {
 		return item.Login == userDTO.Login
 	})
 
 	if user == nil {
-		log.Fatal(fmt.Errorf("user:  '%s' could not be found", userDTO.Login))
+		return "", fmt.Errorf("user:  '%s' could not be found: %w", userDTO.Login, ErrNotFound)
 	}
 	body := &models.AddTeamMemberCommand{UserID: ptr.Of(user.ID)}
 	msg, err := s.GetClient().Teams.AddTeamMember(fmt.Sprintf("%d", ptr.ValueOrDefault(team.ID, 0)), body)
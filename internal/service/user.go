@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	"path/filepath"
 	"reflect"
@@ -14,7 +13,7 @@ import (
 
 	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/service/filters/v2"
 	"github.com/samber/lo"
@@ -30,7 +29,7 @@ import (
 	"github.com/tidwall/pretty"
 )
 
-func setupUserReaders(filterObj filters.V2Filter) {
+func setupUserReaders(filterObj filters.V2Filter) error {
 	obj := models.UserSearchHitDTO{}
 	err := filterObj.RegisterReader(reflect.TypeOf(obj), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.(models.UserSearchHitDTO)
@@ -46,7 +45,7 @@ func setupUserReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid User Filter, obj reader failed, aborting.")
+		return fmt.Errorf("unable to create a valid user filter, obj reader failed: %w", err)
 	}
 	err = filterObj.RegisterReader(reflect.TypeOf([]byte{}), func(filterType filters.FilterType, a any) (any, error) {
 		val, ok := a.([]byte)
@@ -71,13 +70,16 @@ func setupUserReaders(filterObj filters.V2Filter) {
 		}
 	})
 	if err != nil {
-		log.Fatalf("Unable to create a valid User Filter, json reader failed, aborting.")
+		return fmt.Errorf("unable to create a valid user filter, json reader failed: %w", err)
 	}
+	return nil
 }
 
-func NewUserFilter(label string) filters.V2Filter {
+func NewUserFilter(label string) (filters.V2Filter, error) {
 	filterEntity := v2.NewBaseFilter()
-	setupUserReaders(filterEntity)
+	if err := setupUserReaders(filterEntity); err != nil {
+		return nil, err
+	}
 	var labelArray []string
 	if label != "" {
 		labelArray = []string{label}
@@ -97,7 +99,7 @@ func NewUserFilter(label string) filters.V2Filter {
 		}
 		return fmt.Errorf("failed validation test val:%v  expected: %v", val, expectedList)
 	}, labelArray)
-	return filterEntity
+	return filterEntity, nil
 }
 
 // GetUserInfo get signed-in user info, requires Basic authentication
@@ -109,50 +111,62 @@ func (s *DashNGoImpl) GetUserInfo() (*models.UserProfileDTO, error) {
 	return nil, err
 }
 
-func (s *DashNGoImpl) DownloadUsers(filter filters.V2Filter) []string {
-	var (
-		userData []byte
-		err      error
-	)
+func (s *DashNGoImpl) DownloadUsers(filter filters.V2Filter) ([]string, error) {
+	var userData []byte
 
-	userListing := s.ListUsers(filter)
+	userListing, err := s.ListUsers(filter)
+	if err != nil {
+		return nil, err
+	}
 	var importedUsers []string
 
-	userPath := BuildResourceFolder(s.grafanaConf, "", resourceTypes.UserResource, s.isLocal(), s.GetGlobals().ClearOutput)
+	userPath := BuildResourceFolder(s.grafanaConf, "", resourceTypes.UserResource, s.isLocal(), s.clearedFolders())
+	credentials := s.getPreferencesCredentials()
 	for ndx, user := range userListing {
 		if s.isAdminUser(user.ID, user.Name) {
-			slog.Info("Skipping admin super user")
+			s.recordSkipped(resourceTypes.UserResource, user.Login, "admin super user")
 			continue
 		}
 		fileName := filepath.Join(userPath, fmt.Sprintf("%s.json", GetSlug(user.Login)))
-		userData, err = json.Marshal(&userListing[ndx])
+		entry := domain.UserWithPreferences{UserSearchHitDTO: *userListing[ndx]}
+		if password, ok := credentials[user.Login]; ok {
+			if entry.Preferences, entry.StarredDashboards, err = s.getUserPreferences(user.Login, password); err != nil {
+				slog.Warn("unable to retrieve user preferences, saving user without them", "username", user.Login, "err", err)
+			}
+		}
+		userData, err = json.Marshal(&entry)
 		if err != nil {
-			slog.Error("could not serialize user object for userId", "userID", user.ID)
+			s.recordFailure(resourceTypes.UserResource, user.Login, fmt.Errorf("could not serialize user object: %w", err))
 			continue
 		}
-		if err = s.storage.WriteFile(fileName, pretty.Pretty(userData)); err != nil {
-			slog.Error("Failed to write file", "filename", user.Login, "err", err)
+		if err = s.writeBackupFile(fileName, pretty.Pretty(userData)); err != nil {
+			s.recordFailure(resourceTypes.UserResource, user.Login, fmt.Errorf("failed to write file: %w", err))
 		} else {
+			s.recordSuccess(resourceTypes.UserResource, user.Login)
 			importedUsers = append(importedUsers, fileName)
 		}
 
 	}
-	return importedUsers
+	return importedUsers, nil
 }
 
 func (s *DashNGoImpl) isAdminUser(id int64, name string) bool {
 	return id == 1 || name == "admin"
 }
 
-func (s *DashNGoImpl) UploadUsers(filter filters.V2Filter) []domain.UserProfileWithAuth {
+func (s *DashNGoImpl) UploadUsers(filter filters.V2Filter) ([]domain.UserProfileWithAuth, error) {
 	orgName := s.grafanaConf.GetOrganizationName()
-	filesInDir, err := s.storage.FindAllFiles(s.grafanaConf.GetPath(resourceTypes.UserResource, orgName), false)
+	filesInDir, err := s.storage.FindAllFiles(s.requestContext(), s.grafanaConf.GetPath(resourceTypes.UserResource, orgName), false)
 	if err != nil {
-		slog.Error("failed to list files in directory for userListings", "err", err)
+		return nil, fmt.Errorf("failed to list files in directory for users: %w", err)
 	}
 	var userListings []domain.UserProfileWithAuth
 	var rawUser []byte
-	userList := s.ListUsers(filter)
+	userList, err := s.ListUsers(filter)
+	if err != nil {
+		return nil, err
+	}
+	credentials := s.getPreferencesCredentials()
 	currentUsers := make(map[string]*models.UserSearchHitDTO, 0)
 
 	// Build current User Mapping
@@ -164,16 +178,24 @@ func (s *DashNGoImpl) UploadUsers(filter filters.V2Filter) []domain.UserProfileW
 	for _, file := range filesInDir {
 		fileLocation := filepath.Join(s.grafanaConf.GetPath(resourceTypes.UserResource, orgName), file)
 		if strings.HasSuffix(file, ".json") {
-			if rawUser, err = s.storage.ReadFile(fileLocation); err != nil {
-				slog.Error("failed to read file", "filename", fileLocation, "err", err)
+			if rawUser, err = s.readBackupFile(fileLocation); err != nil {
+				s.recordFailure(resourceTypes.UserResource, fileLocation, fmt.Errorf("failed to read file: %w", err))
 				continue
 			}
 			if !filter.Validate(filters.AuthLabel, rawUser) {
-				slog.Debug("User failed filter on auth label, skipping", "file", fileLocation)
+				s.recordSkipped(resourceTypes.UserResource, fileLocation, "user failed filter on auth label")
+				continue
+			}
+			var userPrefs domain.UserWithPreferences
+			if err = json.Unmarshal(rawUser, &userPrefs); err != nil {
+				s.recordFailure(resourceTypes.UserResource, fileLocation, fmt.Errorf("failed to unmarshall file: %w", err))
 				continue
 			}
 			if val, ok := currentUsers[filepath.Base(file)]; ok {
-				slog.Warn("User already exist, skipping", "username", val.Login)
+				s.recordSkipped(resourceTypes.UserResource, val.Login, "user already exists")
+				if password, ok := credentials[val.Login]; ok {
+					s.uploadUserPreferences(val.Login, password, &userPrefs)
+				}
 				continue
 			}
 			var newUser models.AdminCreateUserForm
@@ -183,7 +205,7 @@ func (s *DashNGoImpl) UploadUsers(filter filters.V2Filter) []domain.UserProfileW
 
 			data := make(map[string]any)
 			if err = json.Unmarshal(rawUser, &data); err != nil {
-				slog.Error("failed to unmarshall file", "filename", fileLocation, "err", err)
+				s.recordFailure(resourceTypes.UserResource, fileLocation, fmt.Errorf("failed to unmarshall file: %w", err))
 				continue
 			}
 			data["password"] = password
@@ -194,37 +216,42 @@ func (s *DashNGoImpl) UploadUsers(filter filters.V2Filter) []domain.UserProfileW
 			}
 
 			if err = json.Unmarshal(rawUser, &newUser); err != nil {
-				slog.Error("failed to unmarshall file", "filename", fileLocation, "err", err)
+				s.recordFailure(resourceTypes.UserResource, fileLocation, fmt.Errorf("failed to unmarshall file: %w", err))
 				continue
 			}
 
 			if newUser.Name == "admin" {
-				slog.Info("Skipping admin user")
+				s.recordSkipped(resourceTypes.UserResource, newUser.Login, "admin user")
 				continue
 			}
 			params := admin_users.NewAdminCreateUserParams()
 			params.Body = &newUser
 			userCreated, err := s.GetBasicAuthClient().AdminUsers.AdminCreateUser(&newUser)
 			if err != nil {
-				slog.Error("Failed to create user for file", "filename", fileLocation, "err", err)
+				s.recordFailure(resourceTypes.UserResource, newUser.Login, fmt.Errorf("failed to create user: %w", err))
 				continue
 			}
 			resp, err := s.GetBasicAuthClient().Users.GetUserByID(userCreated.Payload.ID)
 			if err != nil {
-				slog.Error("unable to read user back from grafana", "username", newUser.Email, "userID", userCreated.GetPayload().ID)
+				s.recordFailure(resourceTypes.UserResource, newUser.Login, fmt.Errorf("unable to read user back from grafana: %w", err))
 				continue
 			}
+			// the password was just assigned by gdg, it's only used when preferences are backed up
+			if credentials != nil {
+				s.uploadUserPreferences(newUser.Login, string(newUser.Password), &userPrefs)
+			}
+			s.recordSuccess(resourceTypes.UserResource, newUser.Login)
 			userListings = append(userListings, domain.UserProfileWithAuth{UserProfileDTO: *resp.GetPayload(), Password: string(newUser.Password)})
 		}
 	}
 
-	return userListings
+	return userListings, nil
 }
 
 // ListUsers list all grafana users
-func (s *DashNGoImpl) ListUsers(filter filters.V2Filter) []*models.UserSearchHitDTO {
+func (s *DashNGoImpl) ListUsers(filter filters.V2Filter) ([]*models.UserSearchHitDTO, error) {
 	if !s.grafanaConf.IsBasicAuth() {
-		log.Fatal("User listing requires basic auth to be configured.  Token based listing is not supported")
+		return nil, fmt.Errorf("user listing %w", ErrBasicAuthRequired)
 	}
 	var filteredUsers []*models.UserSearchHitDTO
 	params := users.NewSearchUsersParams()
@@ -232,7 +259,7 @@ func (s *DashNGoImpl) ListUsers(filter filters.V2Filter) []*models.UserSearchHit
 	params.Perpage = ptr.Of(int64(5000))
 	usersList, err := s.GetClient().Users.SearchUsers(params)
 	if err != nil {
-		log.Fatal(err.Error())
+		return nil, fmt.Errorf("unable to list users: %w", err)
 	}
 	for _, entry := range usersList.GetPayload() {
 		if len(entry.AuthLabels) == 0 {
@@ -244,33 +271,47 @@ func (s *DashNGoImpl) ListUsers(filter filters.V2Filter) []*models.UserSearchHit
 	sort.Slice(filteredUsers, func(i, j int) bool {
 		return filteredUsers[i].ID < filteredUsers[j].ID
 	})
-	return filteredUsers
+	return filteredUsers, nil
+}
+
+// listAllUsers lists every grafana user regardless of auth labels
+func (s *DashNGoImpl) listAllUsers() ([]*models.UserSearchHitDTO, error) {
+	return s.ListUsers(v2.NewBaseFilter())
 }
 
 // DeleteAllUsers remove all users excluding admin or anything matching the filter
-func (s *DashNGoImpl) DeleteAllUsers(filter filters.V2Filter) []string {
-	userListing := s.ListUsers(filter)
+func (s *DashNGoImpl) DeleteAllUsers(filter filters.V2Filter) ([]string, error) {
+	userListing, err := s.ListUsers(filter)
+	if err != nil {
+		return nil, err
+	}
 	var deletedUsers []string
 	for _, user := range userListing {
 		if s.isAdminUser(user.ID, user.Name) {
-			slog.Info("Skipping admin user")
+			s.recordSkipped(resourceTypes.UserResource, user.Login, "admin user")
 			continue
 
 		}
 		params := admin_users.NewAdminDeleteUserParams()
 		params.UserID = user.ID
-		_, err := s.GetBasicAuthClient().AdminUsers.AdminDeleteUser(user.ID)
-		if err == nil {
-			deletedUsers = append(deletedUsers, user.Email)
+		_, err = s.GetBasicAuthClient().AdminUsers.AdminDeleteUser(user.ID)
+		if err != nil {
+			s.recordFailure(resourceTypes.UserResource, user.Login, fmt.Errorf("failed to delete user: %w", err))
+			continue
 		}
+		s.recordSuccess(resourceTypes.UserResource, user.Login)
+		deletedUsers = append(deletedUsers, user.Email)
 	}
-	return deletedUsers
+	return deletedUsers, nil
 }
 
 // PromoteUser promote the user to have Admin Access
 func (s *DashNGoImpl) PromoteUser(userLogin string) (string, error) {
 	// Get all users
-	userListing := s.ListUsers(v2.NewBaseFilter())
+	userListing, err := s.listAllUsers()
+	if err != nil {
+		return "", err
+	}
 	var user *models.UserSearchHitDTO
 	for ndx, item := range userListing {
 		if item.Email == userLogin {
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/esnet/gdg/internal/tools/ptr"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/search"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/samber/lo"
+)
+
+// Grafana only exposes user preferences and stars for the signed-in user, there is no admin equivalent.  All calls
+// below authenticate as the user whose settings are being read or restored, which is only done for the users whose
+// credentials are supplied through preferences_credentials.  Passwords are never guessed.
+
+// getUserClient returns a basic auth client authenticated as the given user
+func (s *DashNGoImpl) getUserClient(login, password string) *client.GrafanaHTTPAPI {
+	grafanaClient, _ := s.getNewClient(func(clientCfg *client.TransportConfig) {
+		clientCfg.BasicAuth = url.UserPassword(login, password)
+		clientCfg.Debug = s.GetGlobals().ApiDebug
+	})
+	return grafanaClient
+}
+
+// getPreferencesCredentials returns the passwords of the users whose preferences are backed up keyed by login, nil
+// when preferences aren't backed up.
+func (s *DashNGoImpl) getPreferencesCredentials() map[string]string {
+	credentials, err := s.grafanaConf.GetPreferencesCredentials(s.encoder)
+	if err != nil {
+		slog.Warn("unable to read user preferences credentials, preferences are skipped", "err", err)
+		return nil
+	}
+	if credentials == nil {
+		slog.Debug("preferences_credentials isn't configured, user preferences are skipped")
+	}
+	return credentials
+}
+
+// getUserPreferences returns the preferences and the UIDs of the starred dashboards of the given user
+func (s *DashNGoImpl) getUserPreferences(login, password string) (*models.PreferencesSpec, []string, error) {
+	userClient := s.getUserClient(login, password)
+	prefs, err := userClient.SignedInUser.GetUserPreferences()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to retrieve user preferences: %w", err)
+	}
+	starred, err := s.getStarredDashboards(userClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	return prefs.GetPayload(), starred, nil
+}
+
+// getStarredDashboards returns the UIDs of the dashboards starred by the user the client is authenticated as
+func (s *DashNGoImpl) getStarredDashboards(userClient *client.GrafanaHTTPAPI) ([]string, error) {
+	p := search.NewSearchParams()
+	p.Starred = ptr.Of(true)
+	p.Type = ptr.Of(searchTypeDashboard)
+	p.Limit = ptr.Of(int64(5000))
+	resp, err := userClient.Search.Search(p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve starred dashboards: %w", err)
+	}
+	return lo.Map(resp.GetPayload(), func(item *models.Hit, _ int) string {
+		return item.UID
+	}), nil
+}
+
+// restoreUserPreferences applies the preferences and stars the given dashboards for the user.  Dashboards that are
+// already starred are left untouched, missing dashboards are reported and skipped.
+func (s *DashNGoImpl) restoreUserPreferences(login, password string, prefs *models.PreferencesSpec, starred []string) error {
+	userClient := s.getUserClient(login, password)
+	if prefs != nil {
+		update := &models.UpdatePrefsCmd{
+			HomeDashboardUID: prefs.HomeDashboardUID,
+			Language:         prefs.Language,
+			RegionalFormat:   prefs.RegionalFormat,
+			Theme:            prefs.Theme,
+			Timezone:         prefs.Timezone,
+			WeekStart:        prefs.WeekStart,
+		}
+		if _, err := userClient.SignedInUser.UpdateUserPreferences(update); err != nil {
+			return fmt.Errorf("unable to update user preferences: %w", err)
+		}
+	}
+	if len(starred) == 0 {
+		return nil
+	}
+	current, err := s.getStarredDashboards(userClient)
+	if err != nil {
+		return err
+	}
+	for _, uid := range lo.Without(lo.Uniq(starred), current...) {
+		if _, err = userClient.SignedInUser.StarDashboardByUID(uid); err != nil {
+			slog.Warn("unable to star dashboard for user", "username", login, "dashboardUID", uid, "err", err)
+		}
+	}
+	return nil
+}
+
+// uploadUserPreferences restores the preferences and stars saved with the user, failures are logged and ignored
+func (s *DashNGoImpl) uploadUserPreferences(login, password string, user *domain.UserWithPreferences) {
+	if user.Preferences == nil && len(user.StarredDashboards) == 0 {
+		return
+	}
+	if err := s.restoreUserPreferences(login, password, user.Preferences, user.StarredDashboards); err != nil {
+		slog.Warn("unable to restore user preferences", "username", login, "err", err)
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	configDomain "github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/pkg/plugins/secure"
+	"github.com/esnet/gdg/pkg/service/domain"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPreferencesCredentials(t *testing.T) {
+	grafanaConf := configDomain.NewGrafanaConfig("test")
+	grafanaConf.SecureLocationOverride = t.TempDir()
+	s := &DashNGoImpl{grafanaConf: grafanaConf, encoder: secure.NoOpEncoder{}}
+	// no user is logged in as unless credentials are supplied
+	assert.Nil(t, s.getPreferencesCredentials())
+
+	grafanaConf.SetPreferencesCredentials("users.yaml")
+	assert.Nil(t, s.getPreferencesCredentials(), "a missing file skips preferences")
+
+	require.NoError(t, os.WriteFile(filepath.Join(grafanaConf.SecureLocation(), "users.yaml"), []byte("bob: hunter2\n"), 0o600))
+	assert.Equal(t, map[string]string{"bob": "hunter2"}, s.getPreferencesCredentials())
+}
+
+func TestUserWithPreferencesFormat(t *testing.T) {
+	entry := domain.UserWithPreferences{
+		UserSearchHitDTO:  models.UserSearchHitDTO{Login: "bob", Email: "bob@example.com"},
+		Preferences:       &models.PreferencesSpec{HomeDashboardUID: "home-uid", Theme: "dark"},
+		StarredDashboards: []string{"abc", "def"},
+	}
+	raw, err := json.Marshal(entry)
+	assert.NoError(t, err)
+	// user fields must remain at the top level, upload reads them into the create user form
+	var form models.AdminCreateUserForm
+	assert.NoError(t, json.Unmarshal(raw, &form))
+	assert.Equal(t, "bob", form.Login)
+	var parsed domain.UserWithPreferences
+	assert.NoError(t, json.Unmarshal(raw, &parsed))
+	assert.Equal(t, entry, parsed)
+}
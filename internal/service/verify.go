@@ -0,0 +1,348 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	resourceTypes "github.com/esnet/gdg/pkg/config/domain"
+)
+
+const (
+	VerifyCheckRead      = "read"
+	VerifyCheckJSON      = "json"
+	VerifyCheckLayout    = "layout"
+	VerifyCheckReference = "reference"
+	VerifyCheckManifest  = "manifest"
+)
+
+// VerifyIssue a problem found in the backup
+type VerifyIssue struct {
+	File    string `json:"file"`
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+// fileLayout describes where the files of a resource type are expected, depth being the number of path elements
+// below the resource folder.  A maxDepth of 0 allows any nesting.
+type fileLayout struct {
+	minDepth int
+	maxDepth int
+	names    []string
+}
+
+var verifyLayouts = map[resourceTypes.ResourceType]fileLayout{
+	resourceTypes.DashboardResource:            {minDepth: 2},
+	resourceTypes.DashboardPermissionsResource: {minDepth: 2},
+	resourceTypes.AlertingRulesResource:        {minDepth: 2},
+	resourceTypes.LibraryElementResource:       {minDepth: 2},
+	resourceTypes.FolderResource:               {minDepth: 1},
+	resourceTypes.FolderPermissionResource:     {minDepth: 1, maxDepth: 1},
+	resourceTypes.ConnectionResource:           {minDepth: 1, maxDepth: 1},
+	resourceTypes.ConnectionPermissionResource: {minDepth: 1, maxDepth: 1},
+	resourceTypes.AlertingResource:             {minDepth: 1, maxDepth: 1},
+	resourceTypes.RoleResource:                 {minDepth: 1, maxDepth: 1},
+	resourceTypes.TeamResource:                 {minDepth: 2, maxDepth: 2, names: []string{"team.json", "members.json"}},
+	resourceTypes.UserResource:                 {minDepth: 1, maxDepth: 1},
+	resourceTypes.OrganizationResource:         {minDepth: 1, maxDepth: 1},
+	resourceTypes.SSOResource:                  {minDepth: 1, maxDepth: 1},
+}
+
+// verifySkipped folders of the output path holding configuration rather than backups
+var verifySkipped = []resourceTypes.ResourceType{resourceTypes.SecureSecretsResource, resourceTypes.TemplatesResource}
+
+// backupFile a file of the backup, org is empty for resources that aren't namespaced
+type backupFile struct {
+	rel      string
+	org      string
+	resource resourceTypes.ResourceType
+	// sub path of the file inside the resource folder
+	sub  string
+	data []byte
+}
+
+// VerifyBackup validates the backup of the current context without contacting Grafana.  Every file needs to be valid
+// JSON stored where gdg writes it, references between entities need to resolve and the checksums need to match the
+// manifest when one exists.
+func (s *DashNGoImpl) VerifyBackup() ([]VerifyIssue, error) {
+	ctx := s.requestContext()
+	root := s.grafanaConf.OutputPath
+	files, err := s.storage.FindAllFiles(ctx, root, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list backup files: %w", err)
+	}
+	var (
+		issues    []VerifyIssue
+		backup    []backupFile
+		checksums = make(map[string]string, len(files))
+	)
+	for _, file := range files {
+		rel := backupRelativePath(s.storage, root, file)
+		if rel == ManifestFile || isHiddenPath(rel) || slices.Contains(verifySkipped, resourceTypes.ResourceType(strings.Split(rel, "/")[0])) {
+			continue
+		}
+		data, readErr := s.storage.ReadFile(ctx, file)
+		if readErr != nil {
+			issues = append(issues, VerifyIssue{File: rel, Check: VerifyCheckRead, Message: readErr.Error()})
+			continue
+		}
+		checksums[rel] = checksum(data)
+
+		entry, layoutErr := parseBackupPath(rel)
+		if layoutErr != "" {
+			issues = append(issues, VerifyIssue{File: rel, Check: VerifyCheckLayout, Message: layoutErr})
+			continue
+		}
+		if !json.Valid(data) {
+			issues = append(issues, VerifyIssue{File: rel, Check: VerifyCheckJSON, Message: "file is not valid JSON"})
+			continue
+		}
+		entry.data = data
+		backup = append(backup, entry)
+	}
+	issues = append(issues, verifyReferences(backup)...)
+
+	manifest, err := s.readManifest(ctx)
+	if err != nil {
+		issues = append(issues, VerifyIssue{File: ManifestFile, Check: VerifyCheckManifest, Message: err.Error()})
+	} else if manifest != nil {
+		for _, file := range slices.Sorted(maps.Keys(manifest.Files)) {
+			if issue := manifest.checkFile(file, checksums); issue != "" {
+				issues = append(issues, VerifyIssue{File: file, Check: VerifyCheckManifest, Message: "file " + issue})
+			}
+		}
+	}
+	return issues, nil
+}
+
+// parseBackupPath returns the resource the file belongs to, or a description of why the file is misplaced
+func parseBackupPath(rel string) (backupFile, string) {
+	entry := backupFile{rel: rel}
+	segments := strings.Split(rel, "/")
+	orgName, isOrg := strings.CutPrefix(segments[0], string(resourceTypes.OrganizationMetaResource)+"_")
+	if isOrg {
+		if len(segments) < 2 {
+			return entry, "file is not part of any resource folder"
+		}
+		entry.org = segments[0]
+		segments = segments[1:]
+	}
+	if len(segments) < 2 {
+		return entry, "file is not part of any resource folder"
+	}
+	entry.resource = resourceTypes.ResourceType(segments[0])
+	entry.sub = path.Join(segments[1:]...)
+	layout, ok := verifyLayouts[entry.resource]
+	if !ok {
+		return entry, fmt.Sprintf("unknown resource folder %s", entry.resource)
+	}
+	expected := path.Join(entry.org, entry.resource.String())
+	if actual := entry.resource.GetPath("", orgName); actual != expected {
+		if !isOrg {
+			return entry, fmt.Sprintf("%s are expected under an organization folder", entry.resource)
+		}
+		return entry, fmt.Sprintf("%s are expected under %s", entry.resource, actual)
+	}
+	depth := len(segments) - 1
+	if depth < layout.minDepth || (layout.maxDepth > 0 && depth > layout.maxDepth) {
+		return entry, fmt.Sprintf("unexpected nesting for %s", entry.resource)
+	}
+	if len(layout.names) > 0 && !slices.Contains(layout.names, path.Base(rel)) {
+		return entry, fmt.Sprintf("unexpected file name, expected one of %s", strings.Join(layout.names, ", "))
+	}
+	if path.Ext(rel) != ".json" {
+		return entry, "only JSON files are expected"
+	}
+	return entry, ""
+}
+
+// orgEntities the identifiers of the entities of an organization that other entities refer to
+type orgEntities struct {
+	dashboards      map[string]bool
+	dashboardFiles  map[string]bool
+	folders         map[string]bool
+	folderPaths     map[string]bool
+	connections     map[string]bool
+	libraryElements map[string]bool
+}
+
+func newOrgEntities() *orgEntities {
+	return &orgEntities{
+		dashboards:      make(map[string]bool),
+		dashboardFiles:  make(map[string]bool),
+		folders:         make(map[string]bool),
+		folderPaths:     make(map[string]bool),
+		connections:     make(map[string]bool),
+		libraryElements: make(map[string]bool),
+	}
+}
+
+// verifyReferences checks that the entities referenced by dashboards, alert rules, permissions and teams are part of
+// the backup.
+func verifyReferences(backup []backupFile) []VerifyIssue {
+	orgs := make(map[string]*orgEntities)
+	users := make(map[string]bool)
+	getOrg := func(name string) *orgEntities {
+		if _, ok := orgs[name]; !ok {
+			orgs[name] = newOrgEntities()
+		}
+		return orgs[name]
+	}
+	// index the entities being referenced
+	for _, file := range backup {
+		org := getOrg(file.org)
+		switch file.resource {
+		case resourceTypes.DashboardResource:
+			var board struct {
+				UID string `json:"uid"`
+			}
+			_ = json.Unmarshal(file.data, &board)
+			org.dashboards[board.UID] = true
+			org.dashboardFiles[file.sub] = true
+		case resourceTypes.FolderResource:
+			var folder struct {
+				UID        string `json:"uid"`
+				NestedPath string `json:"NestedPath"`
+			}
+			_ = json.Unmarshal(file.data, &folder)
+			if folder.NestedPath == "" {
+				folder.NestedPath = strings.TrimSuffix(file.sub, ".json")
+			}
+			org.folders[folder.UID] = true
+			org.folderPaths[folder.NestedPath] = true
+		case resourceTypes.ConnectionResource:
+			var connection struct {
+				UID string `json:"uid"`
+			}
+			_ = json.Unmarshal(file.data, &connection)
+			org.connections[connection.UID] = true
+		case resourceTypes.LibraryElementResource:
+			var element struct {
+				Entity struct {
+					UID string `json:"uid"`
+				} `json:"Entity"`
+			}
+			_ = json.Unmarshal(file.data, &element)
+			org.libraryElements[element.Entity.UID] = true
+		case resourceTypes.UserResource:
+			var user struct {
+				Login string `json:"login"`
+			}
+			_ = json.Unmarshal(file.data, &user)
+			users[user.Login] = true
+		}
+	}
+
+	var issues []VerifyIssue
+	missing := func(file backupFile, format string, args ...any) {
+		issues = append(issues, VerifyIssue{File: file.rel, Check: VerifyCheckReference, Message: fmt.Sprintf(format, args...)})
+	}
+	for _, file := range backup {
+		org := getOrg(file.org)
+		switch file.resource {
+		case resourceTypes.DashboardResource:
+			var board any
+			_ = json.Unmarshal(file.data, &board)
+			for _, uid := range libraryPanelUIDs(board) {
+				if !org.libraryElements[uid] {
+					missing(file, "library panel %s is missing from %s", uid, resourceTypes.LibraryElementResource)
+				}
+			}
+		case resourceTypes.AlertingRulesResource:
+			var rule struct {
+				FolderUID  string `json:"folderUID"`
+				NestedPath string `json:"NestedPath"`
+			}
+			_ = json.Unmarshal(file.data, &rule)
+			if rule.NestedPath != "" && !org.folderPaths[rule.NestedPath] {
+				missing(file, "folder %s is missing from %s", rule.NestedPath, resourceTypes.FolderResource)
+			} else if rule.NestedPath == "" && !org.folders[rule.FolderUID] {
+				missing(file, "folder %s is missing from %s", rule.FolderUID, resourceTypes.FolderResource)
+			}
+		case resourceTypes.DashboardPermissionsResource:
+			for _, uid := range permissionUIDs(file.data) {
+				if !org.dashboards[uid] {
+					missing(file, "dashboard %s is missing from %s", uid, resourceTypes.DashboardResource)
+				}
+			}
+			if !org.dashboardFiles[file.sub] {
+				missing(file, "dashboard %s is missing from %s", file.sub, resourceTypes.DashboardResource)
+			}
+		case resourceTypes.FolderPermissionResource:
+			for _, uid := range permissionUIDs(file.data) {
+				if !org.folders[uid] {
+					missing(file, "folder %s is missing from %s", uid, resourceTypes.FolderResource)
+				}
+			}
+		case resourceTypes.ConnectionPermissionResource:
+			var permission struct {
+				Connection struct {
+					UID  string `json:"uid"`
+					Name string `json:"name"`
+				} `json:"Connection"`
+			}
+			_ = json.Unmarshal(file.data, &permission)
+			if !org.connections[permission.Connection.UID] {
+				missing(file, "connection %s is missing from %s", permission.Connection.Name, resourceTypes.ConnectionResource)
+			}
+		case resourceTypes.TeamResource:
+			if path.Base(file.sub) != "members.json" {
+				continue
+			}
+			var members []struct {
+				Login  string `json:"login"`
+				UserID int64  `json:"userId"`
+			}
+			_ = json.Unmarshal(file.data, &members)
+			for _, member := range members {
+				if !users[member.Login] && !(member.UserID == 1 || member.Login == "admin") {
+					missing(file, "team member %s is missing from %s", member.Login, resourceTypes.UserResource)
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// libraryPanelUIDs returns the uid of every library panel used by the dashboard, including panels nested in rows
+func libraryPanelUIDs(node any) []string {
+	var uids []string
+	switch value := node.(type) {
+	case map[string]any:
+		if panel, ok := value["libraryPanel"].(map[string]any); ok {
+			if uid, ok := panel["uid"].(string); ok && uid != "" {
+				uids = append(uids, uid)
+			}
+		}
+		for key, child := range value {
+			if key != "libraryPanel" {
+				uids = append(uids, libraryPanelUIDs(child)...)
+			}
+		}
+	case []any:
+		for _, child := range value {
+			uids = append(uids, libraryPanelUIDs(child)...)
+		}
+	}
+	slices.Sort(uids)
+	return slices.Compact(uids)
+}
+
+// permissionUIDs returns the uid of the resources the permission entries apply to
+func permissionUIDs(data []byte) []string {
+	var entries []struct {
+		UID string `json:"uid"`
+	}
+	_ = json.Unmarshal(data, &entries)
+	var uids []string
+	for _, entry := range entries {
+		if entry.UID != "" {
+			uids = append(uids, entry.UID)
+		}
+	}
+	slices.Sort(uids)
+	return slices.Compact(uids)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	configDomain "github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/esnet/gdg/pkg/test_tooling/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBackup(t *testing.T) {
+	assert.NoError(t, path.FixTestDir("service", "../.."))
+	root := t.TempDir()
+	require.NoError(t, os.CopyFS(root, os.DirFS("test/data")))
+	svc := &DashNGoImpl{grafanaConf: &configDomain.GrafanaConfig{OutputPath: root}, storage: storage.NewLocalStorage(t.Context())}
+
+	issues, err := svc.VerifyBackup()
+	require.NoError(t, err)
+	assert.Empty(t, issues, "test data is expected to be a valid backup")
+
+	write := func(name, data string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, name)), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(root, name), []byte(data), 0o600))
+	}
+	write("org_main-org/connections/broken.json", `{"name":`)
+	write("dashboards/General/misplaced.json", `{}`)
+	write("org_main-org/teams/engineers/extra.json", `{}`)
+	write(ManifestFile, `{"files": {"users/bob.json": "bad", "users/gone.json": "bad"}}`)
+	require.NoError(t, os.Remove(filepath.Join(root, "org_main-org/libraryelements/General/dashboard-makeover-lighting-status.json")))
+	require.NoError(t, os.Remove(filepath.Join(root, "org_main-org/folders/linux%2Fgnu/Others.json")))
+	require.NoError(t, os.Remove(filepath.Join(root, "users/tux.json")))
+
+	issues, err = svc.VerifyBackup()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []VerifyIssue{
+		{File: "org_main-org/connections/broken.json", Check: VerifyCheckJSON, Message: "file is not valid JSON"},
+		{File: "dashboards/General/misplaced.json", Check: VerifyCheckLayout, Message: "dashboards are expected under an organization folder"},
+		{File: "org_main-org/teams/engineers/extra.json", Check: VerifyCheckLayout, Message: "unexpected file name, expected one of team.json, members.json"},
+		{File: "org_main-org/dashboards/linux%2Fgnu/Others/n%2B_%3D23r/dashboard-makeover-challenge.json", Check: VerifyCheckReference, Message: "library panel u97RX_Q7z is missing from libraryelements"},
+		{File: "org_main-org/alerting-rules/ignored/boom.json", Check: VerifyCheckReference, Message: "folder linux%2Fgnu/Others is missing from folders"},
+		{File: "org_main-org/teams/engineers/members.json", Check: VerifyCheckReference, Message: "team member tux is missing from users"},
+		{File: "users/bob.json", Check: VerifyCheckManifest, Message: "file was modified since the backup was taken"},
+		{File: "users/gone.json", Check: VerifyCheckManifest, Message: "file listed in the manifest is missing"},
+	}, issues)
+}
+
+func TestVerifyBackupWithoutGrafana(t *testing.T) {
+	assert.NoError(t, path.FixTestDir("service", "../.."))
+	root := t.TempDir()
+	require.NoError(t, os.CopyFS(root, os.DirFS("test/data")))
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+	grafanaConf := configDomain.NewGrafanaConfig("ctx")
+	grafanaConf.URL = server.URL
+	grafanaConf.OutputPath = root
+	cfg := &configDomain.GDGAppConfiguration{
+		ContextName: "ctx",
+		Contexts:    map[string]*configDomain.GrafanaConfig{"ctx": grafanaConf},
+	}
+
+	svc, err := NewStorageService(t.Context(), cfg)
+	require.NoError(t, err)
+	issues, err := svc.VerifyBackup()
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+	assert.Zero(t, calls.Load(), "Grafana isn't contacted")
+}
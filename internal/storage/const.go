@@ -11,18 +11,49 @@ func (t Type) String() string {
 const (
 	Context = ContextStorage("storage")
 	// Cloud Specific const
-	CloudType             = "cloud_type"
-	BucketName            = "bucket_name"
-	Prefix                = "prefix"
-	Custom                = "custom"
-	AccessId              = "access_id"
-	SecretKey             = "secret_key"
-	Endpoint              = "endpoint"
-	Region                = "region"
-	InitBucket            = "init_bucket"
-	LocalStorageType Type = "LocalStorage"
-	SecureLocation        = "secure_location"
+	CloudType                = "cloud_type"
+	BucketName               = "bucket_name"
+	Prefix                   = "prefix"
+	Custom                   = "custom"
+	AccessId                 = "access_id"
+	SecretKey                = "secret_key"
+	Endpoint                 = "endpoint"
+	Region                   = "region"
+	InitBucket               = "init_bucket"
+	LocalStorageType    Type = "LocalStorage"
+	GitStorageType      Type = "GitStorage"
+	ArchiveStorageType  Type = "ArchiveStorage"
+	SnapshotStorageType Type = "SnapshotStorage"
+	PluginStorageType   Type = "PluginStorage"
+	MemoryStorageType   Type = "MemoryStorage"
+	SecureLocation           = "secure_location"
+	// Git Specific const
+	GitCloudType   = "git"
+	GitPath        = "path"
+	GitAuthorName  = "author_name"
+	GitAuthorEmail = "author_email"
+	// Archive Specific const
+	ArchiveCloudType   = "archive"
+	ArchivePath        = "path"
+	ArchiveFormat      = "format"
+	ArchiveName        = "archive"
+	ArchiveBackend     = "backend"
+	ArchiveContextName = "context_name"
+	// Plugin Specific const
+	PluginCloudType = "plugin"
+	// Snapshot const, applicable to any storage engine but archives
+	Snapshots    = "snapshots"
+	SnapshotName = "snapshot"
+	SnapshotRoot = "snapshot_root"
+	// Encryption const, applicable to any storage engine
+	LocalCloudType    = "local"
+	Encryption        = "encryption"
+	EncryptionKeyFile = "encryption_key_file"
+	EncryptionKeyEnv  = "encryption_key_env"
+	AgeRecipients     = "age_recipients"
 	// Auth
 	CloudKey    = "AWS_ACCESS_KEY" // #nosec G101
 	CloudSecret = "AWS_SECRET_KEY" // #nosec G101
+	ArchiveEnv  = "GDG_ARCHIVE"
+	SnapshotEnv = "GDG_SNAPSHOT"
 )
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	CopyStatusCopied  = "copied"
+	CopyStatusSkipped = "skipped"
+	CopyStatusFailed  = "failed"
+)
+
+// CopyResult the outcome of copying a single file
+type CopyResult struct {
+	File   string `json:"file"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// RelativeName returns the file name without the prefix of the storage engine, which can be written to any engine
+func RelativeName(engine Storage, file string) string {
+	prefix := strings.Trim(engine.GetPrefix(), "/")
+	if prefix == "" || prefix == "<nil>" {
+		return file
+	}
+	if rel, ok := strings.CutPrefix(strings.TrimPrefix(filepath.ToSlash(file), "/"), prefix+"/"); ok {
+		return rel
+	}
+	return file
+}
+
+// Copy copies every file found under folder from src to dst.  Every copy is read back from dst and its SHA-256
+// checksum compared with the source.  When resume is set, files already present in dst with the same content are
+// skipped so an interrupted copy can be restarted.  Copying stops at the first failure.  Encrypted backups are only
+// copied to an engine that encrypts them as well, they would otherwise be written in plaintext.
+func Copy(ctx context.Context, src, dst Storage, folder string, resume bool) ([]CopyResult, error) {
+	_, srcEncrypted := findDecorator[*EncryptedStorage](src)
+	if _, dstEncrypted := findDecorator[*EncryptedStorage](dst); srcEncrypted && !dstEncrypted {
+		return nil, errors.New("refusing to copy encrypted backups to a storage engine without encryption")
+	}
+	files, err := src.FindAllFiles(ctx, folder, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list files to copy: %w", err)
+	}
+	results := make([]CopyResult, 0, len(files))
+	for _, file := range files {
+		name := RelativeName(src, file)
+		status, copyErr := copyFile(ctx, src, dst, file, name, resume)
+		if copyErr != nil {
+			results = append(results, CopyResult{File: name, Status: CopyStatusFailed, Error: copyErr.Error()})
+			return results, fmt.Errorf("unable to copy %s: %w", name, copyErr)
+		}
+		results = append(results, CopyResult{File: name, Status: status})
+	}
+	return results, nil
+}
+
+func copyFile(ctx context.Context, src, dst Storage, file, name string, resume bool) (string, error) {
+	data, err := src.ReadFile(ctx, file)
+	if err != nil {
+		return "", err
+	}
+	checksum := sha256.Sum256(data)
+	if resume {
+		exists, existsErr := dst.Exists(ctx, name)
+		if existsErr != nil {
+			return "", existsErr
+		}
+		if exists && matchesChecksum(ctx, dst, name, checksum[:]) {
+			slog.Debug("file already copied, skipping", "file", name)
+			return CopyStatusSkipped, nil
+		}
+	}
+	if err = dst.WriteFile(ctx, name, data); err != nil {
+		return "", err
+	}
+	// files buffered until they are committed, ie. archives, are verified when read back from the buffer
+	if !matchesChecksum(ctx, dst, name, checksum[:]) {
+		return "", fmt.Errorf("checksum mismatch after copy")
+	}
+	return CopyStatusCopied, nil
+}
+
+// matchesChecksum returns true if the content of the file in the engine has the given SHA-256 checksum
+func matchesChecksum(ctx context.Context, engine Storage, name string, checksum []byte) bool {
+	data, err := engine.ReadFile(ctx, name)
+	if err != nil {
+		return false
+	}
+	actual := sha256.Sum256(data)
+	return bytes.Equal(actual[:], checksum)
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob/memblob"
+)
+
+func TestCopyBetweenEngines(t *testing.T) {
+	ctx := t.Context()
+	t.Chdir(t.TempDir())
+	local := NewLocalStorage(ctx)
+	require.NoError(t, local.WriteFile(ctx, "test/data/org_main-org/dashboards/General/a.json", []byte(`{"a":1}`)))
+	require.NoError(t, local.WriteFile(ctx, "test/data/users/bob.json", []byte(`{"login":"bob"}`)))
+
+	// the prefix also appears in the backup path and must still be added
+	bucket := &CloudStorage{BucketRef: memblob.OpenBucket(nil), Prefix: "data"}
+	defer bucket.BucketRef.Close()
+	results, err := Copy(ctx, local, bucket, "test/data", false)
+	require.NoError(t, err)
+	assert.Equal(t, []CopyResult{
+		{File: filepath.Join("test/data/org_main-org/dashboards/General/a.json"), Status: CopyStatusCopied},
+		{File: filepath.Join("test/data/users/bob.json"), Status: CopyStatusCopied},
+	}, results)
+	exists, err := bucket.BucketRef.Exists(ctx, "data/test/data/users/bob.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// copy to another bucket layout, the source prefix is dropped
+	other := &CloudStorage{BucketRef: memblob.OpenBucket(nil), Prefix: "gdg/backups"}
+	defer other.BucketRef.Close()
+	results, err = Copy(ctx, bucket, other, "test/data", false)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+	data, err := other.BucketRef.ReadAll(ctx, "gdg/backups/test/data/org_main-org/dashboards/General/a.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(data))
+
+	// resume skips files already copied
+	require.NoError(t, local.WriteFile(ctx, "test/data/users/alice.json", []byte(`{"login":"alice"}`)))
+	results, err = Copy(ctx, local, bucket, "test/data", true)
+	require.NoError(t, err)
+	statuses := make(map[string]string)
+	for _, result := range results {
+		statuses[filepath.Base(result.File)] = result.Status
+	}
+	assert.Equal(t, map[string]string{"a.json": CopyStatusSkipped, "bob.json": CopyStatusSkipped, "alice.json": CopyStatusCopied}, statuses)
+}
+
+func TestCopyEncryptedBackup(t *testing.T) {
+	ctx := t.Context()
+	t.Chdir(t.TempDir())
+	src := newTestEncryptedStorage(t, map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)})
+	require.NoError(t, src.WriteFile(ctx, "test/data/users/bob.json", []byte(`{"login":"bob"}`)))
+
+	bucket := &CloudStorage{BucketRef: memblob.OpenBucket(nil), Prefix: "data"}
+	defer bucket.BucketRef.Close()
+	_, err := Copy(ctx, src, bucket, "test/data", false)
+	assert.ErrorContains(t, err, "without encryption")
+	exists, err := bucket.BucketRef.Exists(ctx, "data/test/data/users/bob.json")
+	require.NoError(t, err)
+	assert.False(t, exists, "no plaintext is written")
+
+	// the files are encrypted with the key of the destination
+	encrypted, err := NewEncryptedStorage(bucket, map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)})
+	require.NoError(t, err)
+	results, err := Copy(ctx, src, encrypted, "test/data", false)
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+	raw, err := bucket.BucketRef.ReadAll(ctx, "data/test/data/users/bob.json")
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(raw))
+}
+
+func TestRelativeName(t *testing.T) {
+	bucket := &CloudStorage{Prefix: "backups"}
+	assert.Equal(t, "test/data/a.json", RelativeName(bucket, "backups/test/data/a.json"))
+	assert.Equal(t, "test/data/a.json", RelativeName(bucket, "/backups/test/data/a.json"))
+	assert.Equal(t, "other/a.json", RelativeName(bucket, "other/a.json"))
+	assert.Equal(t, "test/data/a.json", RelativeName(NewLocalStorage(t.Context()), "test/data/a.json"))
+}
@@ -1,6 +1,10 @@
 package storage
 
 import (
+	"context"
+
+	"github.com/esnet/gdg/pkg/plugins/storage/contract"
+
 	_ "gocloud.dev/blob/azureblob"
 	_ "gocloud.dev/blob/gcsblob"
 	_ "gocloud.dev/blob/s3blob"
@@ -8,9 +12,28 @@ import (
 
 // TODO: pull all the cloud based interaction into a Plugin System
 type Storage interface {
-	WriteFile(filename string, data []byte) error                // WriteFile returns error or writes byte array to destination
-	ReadFile(filename string) ([]byte, error)                    // ReadFile returns byte array or error with data from file
-	FindAllFiles(folder string, fullPath bool) ([]string, error) // FindAllFiles recursively list all files for a given path
-	Name() string                                                // Name of storage engine
-	GetPrefix() string                                           // Prefix used by storage engine
+	WriteFile(ctx context.Context, filename string, data []byte) error                // WriteFile returns error or writes byte array to destination
+	ReadFile(ctx context.Context, filename string) ([]byte, error)                    // ReadFile returns byte array or error with data from file
+	FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error) // FindAllFiles recursively list all files for a given path
+	Delete(ctx context.Context, name string) error                                    // Delete removes the file, or the folder and every file it contains
+	Stat(ctx context.Context, filename string) (*FileInfo, error)                     // Stat returns the size, modification time and checksum of the file
+	Exists(ctx context.Context, filename string) (bool, error)                        // Exists returns true if the file exists
+	Name() string                                                                     // Name of storage engine
+	GetPrefix() string                                                                // Prefix used by storage engine
+}
+
+// FileInfo describes a stored file, the type is public so that storage engines can be implemented outside of gdg
+type FileInfo = contract.FileInfo
+
+// Algorithms of the checksums reported in FileInfo
+const (
+	ChecksumSHA256 = contract.ChecksumSHA256
+	ChecksumMD5    = contract.ChecksumMD5
+	ChecksumETag   = contract.ChecksumETag
+)
+
+// Committer is implemented by storage engines that record all changes made by a command as a single unit,
+// Commit is invoked once the command completes.
+type Committer interface {
+	Commit(ctx context.Context, subject string) error
 }
@@ -0,0 +1,396 @@
+package storage
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ArchiveTarGz = "tar.gz"
+	ArchiveZip   = "zip"
+
+	archiveTimeFormat = "20060102T150405Z"
+)
+
+// ArchiveStorage writes all the files of a command into a single compressed archive, stored on the local disk or in
+// any other storage engine such as a cloud bucket.  Reads are served from a chosen archive, by default the most
+// recent one for the context.  Every command backs up a single resource, each new archive therefore holds the content
+// of the archive read from along with the files written, and without the files removed, by the command.
+type ArchiveStorage struct {
+	backend     Storage
+	location    string
+	contextName string
+	format      string
+	archiveName string
+
+	mu      sync.Mutex
+	pending map[string][]byte
+	entries map[string][]byte // content of the archive being read, loaded on first access
+	removed bool              // entries were removed by the current command
+}
+
+// Name returns the storage engine name
+func (s *ArchiveStorage) Name() string {
+	return ArchiveStorageType.String()
+}
+
+// GetPrefix returns the prefix of the backend storage
+func (s *ArchiveStorage) GetPrefix() string {
+	return s.backend.GetPrefix()
+}
+
+// WriteFile adds the file to the archive, which is only written once the command completes
+func (s *ArchiveStorage) WriteFile(_ context.Context, filename string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[archiveEntryName(filename)] = bytes.Clone(data)
+	return nil
+}
+
+// ReadFile returns the content of the file from the archive
+func (s *ArchiveStorage) ReadFile(ctx context.Context, filename string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := archiveEntryName(filename)
+	if data, ok := s.pending[name]; ok {
+		return data, nil
+	}
+	if err := s.loadArchive(ctx); err != nil {
+		return nil, err
+	}
+	data, ok := s.entries[name]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found in archive %s", filename, s.archiveName)
+	}
+	return data, nil
+}
+
+// FindAllFiles recursively lists all the files of the archive found under the given folder
+func (s *ArchiveStorage) FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.loadArchive(ctx); err != nil {
+		return nil, err
+	}
+	prefix := archiveEntryName(folder) + "/"
+	var fileList []string
+	for _, source := range []map[string][]byte{s.entries, s.pending} {
+		for name := range source {
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			if fullPath {
+				fileList = append(fileList, name)
+			} else {
+				fileList = append(fileList, path.Base(name))
+			}
+		}
+	}
+	slices.Sort(fileList)
+	return slices.Compact(fileList), nil
+}
+
+// Commit writes the files of the archive read from, along with the pending files, into a new archive named
+// gdg-<context>-<timestamp>.<format>.  The new archive is read from afterward.
+func (s *ArchiveStorage) Commit(ctx context.Context, subject string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.pending) == 0 && !s.removed {
+		return nil
+	}
+	if err := s.loadArchive(ctx); err != nil {
+		return err
+	}
+	files := maps.Clone(s.entries)
+	maps.Copy(files, s.pending)
+	var (
+		data []byte
+		err  error
+	)
+	switch s.format {
+	case ArchiveZip:
+		data, err = writeZipArchive(files)
+	default:
+		data, err = writeTarGzArchive(files)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to create archive: %w", err)
+	}
+	name := fmt.Sprintf("%s%s.%s", s.archivePrefix(), time.Now().UTC().Format(archiveTimeFormat), s.format)
+	if err = s.backend.WriteFile(ctx, path.Join(s.location, name), data); err != nil {
+		return fmt.Errorf("unable to write archive %s: %w", name, err)
+	}
+	slog.Info("Backup archive written", "archive", path.Join(s.location, name), "files", len(files),
+		"written", len(s.pending), "command", subject)
+	s.archiveName = name
+	s.entries = files
+	s.pending = make(map[string][]byte)
+	s.removed = false
+	return nil
+}
+
+// Delete removes the file, or every file under the folder, from the archive being written.  The archive read from
+// is left as is, the files are left out of the archive written by Commit.
+func (s *ArchiveStorage) Delete(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.loadArchive(ctx); err != nil {
+		return err
+	}
+	entry := archiveEntryName(name)
+	matches := func(key string, _ []byte) bool {
+		return key == entry || strings.HasPrefix(key, entry+"/")
+	}
+	before := len(s.entries)
+	maps.DeleteFunc(s.entries, matches)
+	s.removed = s.removed || len(s.entries) != before
+	maps.DeleteFunc(s.pending, matches)
+	return nil
+}
+
+// Stat returns the size and SHA-256 checksum of the file, the modification time is the one of the archive
+func (s *ArchiveStorage) Stat(ctx context.Context, filename string) (*FileInfo, error) {
+	data, err := s.ReadFile(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	modTime := time.Now().UTC()
+	if _, pending := s.pending[archiveEntryName(filename)]; !pending {
+		modTime, _ = time.Parse(archiveTimeFormat, strings.TrimSuffix(strings.TrimPrefix(s.archiveName, s.archivePrefix()), "."+archiveFormat(s.archiveName)))
+	}
+	s.mu.Unlock()
+	checksum := sha256.Sum256(data)
+	return &FileInfo{
+		Name:      archiveEntryName(filename),
+		Size:      int64(len(data)),
+		ModTime:   modTime,
+		Checksum:  hex.EncodeToString(checksum[:]),
+		Algorithm: ChecksumSHA256,
+	}, nil
+}
+
+// Exists returns true if the file is part of the archive
+func (s *ArchiveStorage) Exists(ctx context.Context, filename string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := archiveEntryName(filename)
+	if _, ok := s.pending[name]; ok {
+		return true, nil
+	}
+	if err := s.loadArchive(ctx); err != nil {
+		return false, err
+	}
+	_, ok := s.entries[name]
+	return ok, nil
+}
+
+func (s *ArchiveStorage) archivePrefix() string {
+	return fmt.Sprintf("gdg-%s-", s.contextName)
+}
+
+// loadArchive reads the configured archive, or the most recent one for the context, the first time it's needed.
+func (s *ArchiveStorage) loadArchive(ctx context.Context) error {
+	if s.entries != nil {
+		return nil
+	}
+	name := s.archiveName
+	if name == "" {
+		files, err := s.backend.FindAllFiles(ctx, s.location, false)
+		if err != nil {
+			return fmt.Errorf("unable to list archives: %w", err)
+		}
+		candidates := slices.DeleteFunc(files, func(file string) bool {
+			return !strings.HasPrefix(file, s.archivePrefix()) || archiveFormat(file) == ""
+		})
+		if len(candidates) == 0 {
+			slog.Debug("no archive found", "location", s.location, "context", s.contextName)
+			s.entries = make(map[string][]byte)
+			return nil
+		}
+		// timestamps sort lexicographically, the last one is the most recent
+		slices.Sort(candidates)
+		name = candidates[len(candidates)-1]
+		s.archiveName = name
+	}
+	raw, err := s.backend.ReadFile(ctx, path.Join(s.location, name))
+	if err != nil {
+		return fmt.Errorf("unable to read archive %s: %w", name, err)
+	}
+	switch archiveFormat(name) {
+	case ArchiveZip:
+		s.entries, err = readZipArchive(raw)
+	case ArchiveTarGz:
+		s.entries, err = readTarGzArchive(raw)
+	default:
+		err = fmt.Errorf("unsupported archive format, expected .%s or .%s", ArchiveTarGz, ArchiveZip)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read archive %s: %w", name, err)
+	}
+	slog.Info("Reading from backup archive", "archive", name)
+	return nil
+}
+
+// archiveEntryName normalizes a file name into a slash separated relative path
+func archiveEntryName(filename string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(filename)), "/")
+}
+
+// archiveFormat returns the format of the archive based on its extension, empty if unsupported
+func archiveFormat(name string) string {
+	switch {
+	case strings.HasSuffix(name, "."+ArchiveTarGz):
+		return ArchiveTarGz
+	case strings.HasSuffix(name, "."+ArchiveZip):
+		return ArchiveZip
+	default:
+		return ""
+	}
+}
+
+func writeTarGzArchive(files map[string][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, name := range sortedNames(files) {
+		hdr := &tar.Header{Name: name, Mode: 0o640, Size: int64(len(files[name])), ModTime: now, Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readTarGzArchive(data []byte) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	entries := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tr) // #nosec G110 archives are produced by gdg
+		if err != nil {
+			return nil, err
+		}
+		entries[archiveEntryName(hdr.Name)] = content
+	}
+	return entries, nil
+}
+
+func writeZipArchive(files map[string][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range sortedNames(files) {
+		w, err := zw.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readZipArchive(data []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string][]byte)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(rc) // #nosec G110 archives are produced by gdg
+		_ = rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		entries[archiveEntryName(f.Name)] = content
+	}
+	return entries, nil
+}
+
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NewArchiveStorage creates an ArchiveStorage writing archives to the given backend.  The archive folder, format and
+// the archive to read from are read from the storage configuration.
+func NewArchiveStorage(c context.Context, backend Storage) (Storage, error) {
+	contextVal := c.Value(Context)
+	if contextVal == nil {
+		return nil, errors.New("cannot configure archive storage, context missing")
+	}
+	appData, ok := contextVal.(map[string]string)
+	if !ok {
+		return nil, errors.New("cannot convert appData to string map")
+	}
+	format := getMapValue(ArchiveFormat, ArchiveTarGz, stringEmpty, appData)
+	if format != ArchiveTarGz && format != ArchiveZip {
+		return nil, fmt.Errorf("unsupported archive format '%s', supported values are: %s, %s", format, ArchiveTarGz, ArchiveZip)
+	}
+	if backend == nil {
+		backend = NewLocalStorage(c)
+	}
+	return &ArchiveStorage{
+		backend:     backend,
+		location:    appData[ArchivePath],
+		contextName: getMapValue(ArchiveContextName, "default", stringEmpty, appData),
+		format:      format,
+		archiveName: getMapValueOrEnvOverride(ArchiveName, ArchiveEnv, appData),
+		pending:     make(map[string][]byte),
+	}, nil
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestArchiveStorage(t *testing.T, appData map[string]string) *ArchiveStorage {
+	ctx := context.WithValue(context.Background(), Context, appData)
+	engine, err := NewArchiveStorage(ctx, nil)
+	require.NoError(t, err)
+	return engine.(*ArchiveStorage)
+}
+
+func TestArchiveStorageRoundTrip(t *testing.T) {
+	for _, format := range []string{ArchiveTarGz, ArchiveZip} {
+		t.Run(format, func(t *testing.T) {
+			location := t.TempDir()
+			appData := map[string]string{ArchivePath: location, ArchiveFormat: format, ArchiveContextName: "testing"}
+			writer := newTestArchiveStorage(t, appData)
+			require.NoError(t, writer.WriteFile(t.Context(), "test/data/org_main-org/dashboards/General/a.json", []byte(`{"a":1}`)))
+			require.NoError(t, writer.WriteFile(t.Context(), "test/data/org_main-org/dashboards/Other/b.json", []byte(`{"b":1}`)))
+			require.NoError(t, writer.WriteFile(t.Context(), "test/data/users/bob.json", []byte(`{"login":"bob"}`)))
+			require.NoError(t, writer.Commit(t.Context(), "gdg backup dashboards download"))
+
+			archives, err := filepath.Glob(filepath.Join(location, "gdg-testing-*."+format))
+			require.NoError(t, err)
+			assert.Len(t, archives, 1)
+
+			reader := newTestArchiveStorage(t, appData)
+			files, err := reader.FindAllFiles(t.Context(), "test/data/org_main-org/dashboards", true)
+			require.NoError(t, err)
+			assert.Equal(t, []string{
+				"test/data/org_main-org/dashboards/General/a.json",
+				"test/data/org_main-org/dashboards/Other/b.json",
+			}, files)
+			files, err = reader.FindAllFiles(t.Context(), "test/data/users", false)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"bob.json"}, files)
+			data, err := reader.ReadFile(t.Context(), "test/data/org_main-org/dashboards/Other/b.json")
+			require.NoError(t, err)
+			assert.Equal(t, `{"b":1}`, string(data))
+			_, err = reader.ReadFile(t.Context(), "test/data/missing.json")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestArchiveStorageSeparateCommands(t *testing.T) {
+	appData := map[string]string{ArchivePath: t.TempDir(), ArchiveContextName: "testing"}
+	dashboards := newTestArchiveStorage(t, appData)
+	require.NoError(t, dashboards.WriteFile(t.Context(), "test/data/org_main-org/dashboards/General/a.json", []byte(`{"a":1}`)))
+	require.NoError(t, dashboards.WriteFile(t.Context(), "test/data/users/bob.json", []byte(`{"login":"bob"}`)))
+	require.NoError(t, dashboards.Commit(t.Context(), "gdg backup dashboards download"))
+
+	// users are downloaded by a separate command, which clears the previous ones
+	users := newTestArchiveStorage(t, appData)
+	require.NoError(t, users.Delete(t.Context(), "test/data/users"))
+	require.NoError(t, users.WriteFile(t.Context(), "test/data/users/alice.json", []byte(`{"login":"alice"}`)))
+	require.NoError(t, users.Commit(t.Context(), "gdg backup users download"))
+
+	reader := newTestArchiveStorage(t, appData)
+	files, err := reader.FindAllFiles(t.Context(), "test/data", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"test/data/org_main-org/dashboards/General/a.json",
+		"test/data/users/alice.json",
+	}, files)
+}
+
+func TestArchiveStorageNoArchive(t *testing.T) {
+	reader := newTestArchiveStorage(t, map[string]string{ArchivePath: t.TempDir(), ArchiveContextName: "testing"})
+	files, err := reader.FindAllFiles(t.Context(), "test/data/users", false)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+	// nothing written, no archive created
+	assert.NoError(t, reader.Commit(t.Context(), "gdg backup users list"))
+}
+
+func TestArchiveStorageInvalidFormat(t *testing.T) {
+	ctx := context.WithValue(context.Background(), Context, map[string]string{ArchiveFormat: "rar"})
+	_, err := NewArchiveStorage(ctx, nil)
+	assert.ErrorContains(t, err, "unsupported archive format")
+}
@@ -2,9 +2,10 @@ package storage
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"log"
+	"io"
 	"log/slog"
 	"net/url"
 	"os"
@@ -55,9 +56,12 @@ func (s *CloudStorage) getCloudLocation(fileName string) string {
 	if s.Prefix == "<nil>" {
 		s.Prefix = ""
 	}
-	// Skip if prefix is already in Path.
-	if len(s.Prefix) > 0 && (strings.Contains(fileName, s.Prefix) || strings.Contains(fileName, s.Prefix[1:])) {
-		return fileName
+	// Skip if the path already starts with the prefix, ie. a key returned by FindAllFiles.
+	if prefix := strings.Trim(s.Prefix, "/"); prefix != "" {
+		name := strings.TrimPrefix(fileName, "/")
+		if name == prefix || strings.HasPrefix(name, prefix+"/") {
+			return fileName
+		}
 	}
 	if fileName[0] != '/' && s.Prefix != "" {
 		return path.Join(s.Prefix, "/", fileName)
@@ -66,27 +70,26 @@ func (s *CloudStorage) getCloudLocation(fileName string) string {
 }
 
 // ReadFile read file from Cloud Provider and return byte array
-func (s *CloudStorage) ReadFile(filename string) ([]byte, error) {
+func (s *CloudStorage) ReadFile(ctx context.Context, filename string) ([]byte, error) {
 	if s.BucketRef == nil {
 		return nil, errors.New("unable to find valid bucket to read file from")
 	}
-	ctx := context.Background()
 	return s.BucketRef.ReadAll(ctx, s.getCloudLocation(filename))
 }
 
 // WriteFile persists data to Cloud Provider Storage returning error if operation failed
-func (s *CloudStorage) WriteFile(filename string, data []byte) error {
+func (s *CloudStorage) WriteFile(ctx context.Context, filename string, data []byte) error {
 	if s.BucketRef == nil {
 		return errors.New("unable to get valid bucket ")
 	}
-	return s.BucketRef.WriteAll(context.Background(), s.getCloudLocation(filename), data, nil)
+	return s.BucketRef.WriteAll(ctx, s.getCloudLocation(filename), data, nil)
 }
 
 func (s *CloudStorage) Name() string {
 	return s.StorageName
 }
 
-func (s *CloudStorage) FindAllFiles(folder string, fullPath bool) ([]string, error) {
+func (s *CloudStorage) FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error) {
 	if s.BucketRef == nil {
 		return nil, errors.New("unable to find valid bucket to list files from")
 	}
@@ -104,10 +107,17 @@ func (s *CloudStorage) FindAllFiles(folder string, fullPath bool) ([]string, err
 
 	iterator := s.BucketRef.List(&opts)
 	for {
-		obj, err := iterator.Next(context.Background())
-		if err != nil {
+		obj, err := iterator.Next(ctx)
+		if errors.Is(err, io.EOF) {
 			break
 		}
+		if err == nil {
+			// iterators only check the context when fetching a new page
+			err = ctx.Err()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list files in %s: %w", folderName, err)
+		}
 		if fullPath {
 			if strings.Contains(obj.Key, folderName) {
 				fileList = append(fileList, obj.Key)
@@ -122,6 +132,66 @@ func (s *CloudStorage) FindAllFiles(folder string, fullPath bool) ([]string, err
 	return fileList, nil
 }
 
+// Delete removes the object, or every object found under the folder when no object matches the name
+func (s *CloudStorage) Delete(ctx context.Context, name string) error {
+	if s.BucketRef == nil {
+		return errors.New("unable to find valid bucket to delete files from")
+	}
+	key := strings.TrimPrefix(s.getCloudLocation(name), "/")
+	exists, err := s.BucketRef.Exists(ctx, key)
+	if err != nil {
+		return fmt.Errorf("unable to check if %s exists: %w", key, err)
+	}
+	if exists {
+		return s.BucketRef.Delete(ctx, key)
+	}
+	iterator := s.BucketRef.List(&blob.ListOptions{Prefix: strings.TrimSuffix(key, "/") + "/"})
+	for {
+		obj, err := iterator.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to list files in %s: %w", key, err)
+		}
+		if err = s.BucketRef.Delete(ctx, obj.Key); err != nil {
+			return fmt.Errorf("unable to delete %s: %w", obj.Key, err)
+		}
+	}
+	return nil
+}
+
+// Stat returns the size, modification time and checksum of the object
+func (s *CloudStorage) Stat(ctx context.Context, filename string) (*FileInfo, error) {
+	if s.BucketRef == nil {
+		return nil, errors.New("unable to find valid bucket to read file from")
+	}
+	key := s.getCloudLocation(filename)
+	attrs, err := s.BucketRef.Attributes(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	checksum, algorithm := strings.Trim(attrs.ETag, `"`), ChecksumETag
+	if len(attrs.MD5) > 0 {
+		checksum, algorithm = hex.EncodeToString(attrs.MD5), ChecksumMD5
+	}
+	return &FileInfo{
+		Name:      key,
+		Size:      attrs.Size,
+		ModTime:   attrs.ModTime,
+		Checksum:  checksum,
+		Algorithm: algorithm,
+	}, nil
+}
+
+// Exists returns true if the object exists in the bucket
+func (s *CloudStorage) Exists(ctx context.Context, filename string) (bool, error) {
+	if s.BucketRef == nil {
+		return false, errors.New("unable to find valid bucket to read file from")
+	}
+	return s.BucketRef.Exists(ctx, s.getCloudLocation(filename))
+}
+
 // NewCloudStorage creates a CloudStorage instance using context‑provided config.
 // It validates context, parses app data, supports custom S3 endpoints or native cloud URLs,
 // initializes the bucket (creating it if requested), and returns a configured Storage.
@@ -173,9 +243,9 @@ func NewCloudStorage(c context.Context, encoder contract.CipherEncoder) (Storage
 		if session == nil {
 			errorMsg = "No valid session could be created"
 		}
-		bucketObj, err = s3blob.OpenBucketV2(context.Background(), session, appData[BucketName], nil)
+		bucketObj, err = s3blob.OpenBucketV2(c, session, appData[BucketName], nil)
 		if err != nil {
-			errorMsg = err.Error()
+			errorMsg = fmt.Sprintf("failed to open bucket %s", appData[BucketName])
 		}
 		if err == nil && boolStrCheck(getMapValue(InitBucket, "false", stringEmpty, appData)) {
 			slog.Info("attempting to bootstrap bucket", slog.Any("bucket", appData[BucketName]))
@@ -185,7 +255,7 @@ func NewCloudStorage(c context.Context, encoder contract.CipherEncoder) (Storage
 					Bucket: aws.String(appData[BucketName]),
 				}
 				// attempt to create bucket
-				_, err := session.CreateBucket(context.Background(), &m)
+				_, err := session.CreateBucket(c, &m)
 				if err != nil {
 					slog.Warn("bucket already exists or cannot be created", "bucket", *m.Bucket)
 				} else {
@@ -203,7 +273,7 @@ func NewCloudStorage(c context.Context, encoder contract.CipherEncoder) (Storage
 	}
 
 	if err != nil {
-		log.Fatalf("unable to connect to cloud provider, err: %v, message: %s", err, errorMsg)
+		return nil, fmt.Errorf("unable to connect to cloud provider, %s: %w", errorMsg, err)
 	}
 
 	entity := &CloudStorage{
@@ -227,6 +297,11 @@ func boolStrCheck(val string) bool {
 	return strings.ToLower(val) == "true" || val == "1"
 }
 
+// IsEnabled returns true if the configuration value is set to true
+func IsEnabled(val string) bool {
+	return boolStrCheck(val)
+}
+
 // getMapValue a generic utility that will get a value from a map and return a default if key does not exist
 func getMapValue[T comparable](key, defaultValue T, emptyTest func(key T) bool, data map[T]T) T {
 	val, ok := data[key]
@@ -1,8 +1,14 @@
 package storage
 
 import (
+	"context"
 	"os"
 	"testing"
+
+	"github.com/esnet/gdg/pkg/plugins/secure/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob/memblob"
 )
 
 func TestGetMapValue(t *testing.T) {
@@ -105,3 +111,60 @@ func TestGetMapValueOrEnvOverride(t *testing.T) {
 		}
 	})
 }
+
+func TestCloudStorageFileOperations(t *testing.T) {
+	ctx := t.Context()
+	engine := &CloudStorage{BucketRef: memblob.OpenBucket(nil), Prefix: "backups"}
+	defer engine.BucketRef.Close()
+	require.NoError(t, engine.WriteFile(ctx, "test/data/dashboards/a.json", []byte(`{"a":1}`)))
+	require.NoError(t, engine.WriteFile(ctx, "test/data/dashboards/b.json", []byte(`{"b":1}`)))
+
+	exists, err := engine.Exists(ctx, "test/data/dashboards/a.json")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	info, err := engine.Stat(ctx, "test/data/dashboards/a.json")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), info.Size)
+	assert.NotEmpty(t, info.Checksum)
+	assert.Contains(t, []string{ChecksumMD5, ChecksumETag}, info.Algorithm)
+	assert.False(t, info.ModTime.IsZero())
+
+	require.NoError(t, engine.Delete(ctx, "test/data/dashboards/a.json"))
+	exists, err = engine.Exists(ctx, "test/data/dashboards/a.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	files, err := engine.FindAllFiles(ctx, "test/data/dashboards", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backups/test/data/dashboards/b.json"}, files)
+
+	// folders are removed recursively
+	require.NoError(t, engine.Delete(ctx, "test/data"))
+	files, err = engine.FindAllFiles(ctx, "test/data", true)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestCloudStorageListingError(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	engine := &CloudStorage{BucketRef: memblob.OpenBucket(nil), Prefix: "backups"}
+	defer engine.BucketRef.Close()
+	require.NoError(t, engine.WriteFile(ctx, "test/data/a.json", []byte(`{}`)))
+	cancel()
+	_, err := engine.FindAllFiles(ctx, "test/data", true)
+	assert.Error(t, err)
+}
+
+// plainEncoder returns the values as is
+type plainEncoder struct {
+	contract.CipherEncoder
+}
+
+func (plainEncoder) DecodeValue(s string) (string, error) {
+	return s, nil
+}
+
+func TestNewCloudStorageInvalidProvider(t *testing.T) {
+	ctx := context.WithValue(t.Context(), Context, map[string]string{CloudType: "unknown", BucketName: "backups"})
+	_, err := NewCloudStorage(ctx, plainEncoder{})
+	assert.ErrorContains(t, err, "failed to open bucket unknown://backups")
+}
@@ -0,0 +1,325 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+)
+
+const (
+	EncryptionAESGCM = "aes-gcm"
+	EncryptionAge    = "age"
+
+	// envelopeHeader prefixes files encrypted with AES-GCM, each file has its own data key wrapped by the master key
+	envelopeHeader = "gdg-envelope-v1\n"
+	// ageHeader prefixes files encrypted with age
+	ageHeader = "age-encryption.org/v1\n"
+	// aesKeySize AES-256 keys only
+	aesKeySize = 32
+)
+
+// fileCipher encrypts and decrypts the content of a single file
+type fileCipher interface {
+	encrypt(data []byte) ([]byte, error)
+	decrypt(data []byte) ([]byte, error)
+}
+
+// ErrNotEncrypted is returned when reading a plaintext file through an EncryptedStorage.  Such a file wasn't written by
+// gdg with encryption enabled and can't be authenticated, plaintext backups are migrated using ReEncrypt.
+var ErrNotEncrypted = errors.New("file is not encrypted")
+
+// EncryptedStorage decorates any storage engine, encrypting every file on write and decrypting it on read.  Reading a
+// file that isn't encrypted fails, see ReEncrypt to migrate an existing backup.  Stat describes the encrypted content
+// as stored by the underlying engine.
+type EncryptedStorage struct {
+	Storage
+	cipher fileCipher
+}
+
+// Backend returns the storage engine being decorated
+func (s *EncryptedStorage) Backend() Storage {
+	return s.Storage
+}
+
+// WriteFile encrypts the data before writing it to the underlying storage
+func (s *EncryptedStorage) WriteFile(ctx context.Context, filename string, data []byte) error {
+	encrypted, err := s.cipher.encrypt(data)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt %s: %w", filename, err)
+	}
+	return s.Storage.WriteFile(ctx, filename, encrypted)
+}
+
+// ReadFile reads and decrypts the file from the underlying storage
+func (s *EncryptedStorage) ReadFile(ctx context.Context, filename string) ([]byte, error) {
+	data, err := s.Storage.ReadFile(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	return s.Decrypt(filename, data)
+}
+
+// Decrypt returns the decrypted content of the file, ErrNotEncrypted is returned for plaintext content
+func (s *EncryptedStorage) Decrypt(filename string, data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, fmt.Errorf("unable to read %s: %w", filename, ErrNotEncrypted)
+	}
+	decrypted, err := s.cipher.decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt %s: %w", filename, err)
+	}
+	return decrypted, nil
+}
+
+// Commit forwards the commit to the underlying storage engine if it supports it
+func (s *EncryptedStorage) Commit(ctx context.Context, subject string) error {
+	if committer, ok := s.Storage.(Committer); ok {
+		return committer.Commit(ctx, subject)
+	}
+	return nil
+}
+
+// IsEncrypted returns true if the data was encrypted by EncryptedStorage
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(envelopeHeader)) || bytes.HasPrefix(data, []byte(ageHeader))
+}
+
+// aesEnvelope encrypts every file with a random data key, the data key is wrapped with the master key and stored with
+// the file.
+type aesEnvelope struct {
+	masterKey cipher.AEAD
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (a *aesEnvelope) encrypt(data []byte) ([]byte, error) {
+	dataKey := make([]byte, aesKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	dataCipher, err := newAESGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	keyNonce := make([]byte, a.masterKey.NonceSize())
+	dataNonce := make([]byte, dataCipher.NonceSize())
+	if _, err = rand.Read(keyNonce); err != nil {
+		return nil, err
+	}
+	if _, err = rand.Read(dataNonce); err != nil {
+		return nil, err
+	}
+	out := []byte(envelopeHeader)
+	out = append(out, keyNonce...)
+	out = a.masterKey.Seal(out, keyNonce, dataKey, []byte(envelopeHeader))
+	out = append(out, dataNonce...)
+	return dataCipher.Seal(out, dataNonce, data, []byte(envelopeHeader)), nil
+}
+
+func (a *aesEnvelope) decrypt(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, []byte(envelopeHeader)) {
+		return nil, errors.New("file was not encrypted using aes-gcm")
+	}
+	payload := data[len(envelopeHeader):]
+	nonceSize := a.masterKey.NonceSize()
+	wrappedKeySize := aesKeySize + a.masterKey.Overhead()
+	if len(payload) < 2*nonceSize+wrappedKeySize {
+		return nil, errors.New("invalid encrypted file, content is truncated")
+	}
+	keyNonce, payload := payload[:nonceSize], payload[nonceSize:]
+	wrappedKey, payload := payload[:wrappedKeySize], payload[wrappedKeySize:]
+	dataKey, err := a.masterKey.Open(nil, keyNonce, wrappedKey, []byte(envelopeHeader))
+	if err != nil {
+		return nil, errors.New("unable to unwrap data key, invalid encryption key")
+	}
+	dataCipher, err := newAESGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	dataNonce, payload := payload[:nonceSize], payload[nonceSize:]
+	return dataCipher.Open(nil, dataNonce, payload, []byte(envelopeHeader))
+}
+
+// ageCipher encrypts files to a list of age recipients
+type ageCipher struct {
+	recipients []age.Recipient
+	identities []age.Identity
+}
+
+func (a *ageCipher) encrypt(data []byte) ([]byte, error) {
+	if len(a.recipients) == 0 {
+		return nil, errors.New("no age recipients configured")
+	}
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, a.recipients...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (a *ageCipher) decrypt(data []byte) ([]byte, error) {
+	if len(a.identities) == 0 {
+		return nil, errors.New("no age identity configured, unable to decrypt")
+	}
+	r, err := age.Decrypt(bytes.NewReader(data), a.identities...)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// readKeyMaterial returns the key from the configured file, or the configured environment variable
+func readKeyMaterial(appData map[string]string) (string, error) {
+	if keyFile := appData[EncryptionKeyFile]; keyFile != "" {
+		raw, err := os.ReadFile(keyFile) // #nosec G304 key location is user provided
+		if err != nil {
+			return "", fmt.Errorf("unable to read encryption key file: %w", err)
+		}
+		return strings.TrimSpace(string(raw)), nil
+	}
+	if keyEnv := appData[EncryptionKeyEnv]; keyEnv != "" {
+		val := strings.TrimSpace(os.Getenv(keyEnv))
+		if val == "" {
+			return "", fmt.Errorf("environment variable %s is not set", keyEnv)
+		}
+		return val, nil
+	}
+	return "", nil
+}
+
+func newFileCipher(appData map[string]string) (fileCipher, error) {
+	keyMaterial, err := readKeyMaterial(appData)
+	if err != nil {
+		return nil, err
+	}
+	switch appData[Encryption] {
+	case EncryptionAESGCM:
+		if keyMaterial == "" {
+			return nil, fmt.Errorf("%s encryption requires %s or %s to be set", EncryptionAESGCM, EncryptionKeyFile, EncryptionKeyEnv)
+		}
+		key, decodeErr := base64.StdEncoding.DecodeString(keyMaterial)
+		if decodeErr != nil || len(key) != aesKeySize {
+			return nil, fmt.Errorf("encryption key must be %d bytes encoded in base64", aesKeySize)
+		}
+		masterKey, cipherErr := newAESGCM(key)
+		if cipherErr != nil {
+			return nil, cipherErr
+		}
+		return &aesEnvelope{masterKey: masterKey}, nil
+	case EncryptionAge:
+		result := &ageCipher{}
+		if keyMaterial != "" {
+			if result.identities, err = age.ParseIdentities(strings.NewReader(keyMaterial)); err != nil {
+				return nil, fmt.Errorf("invalid age identity: %w", err)
+			}
+		}
+		if recipients := appData[AgeRecipients]; recipients != "" {
+			if result.recipients, err = age.ParseRecipients(strings.NewReader(strings.ReplaceAll(recipients, ",", "\n"))); err != nil {
+				return nil, fmt.Errorf("invalid age recipients: %w", err)
+			}
+		} else {
+			// encrypt to the configured identities when no recipients are listed
+			for _, identity := range result.identities {
+				if x25519, ok := identity.(*age.X25519Identity); ok {
+					result.recipients = append(result.recipients, x25519.Recipient())
+				}
+			}
+		}
+		if len(result.recipients) == 0 && len(result.identities) == 0 {
+			return nil, fmt.Errorf("%s encryption requires %s, %s or %s to be set", EncryptionAge, AgeRecipients, EncryptionKeyFile, EncryptionKeyEnv)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported encryption '%s', supported values are: %s, %s", appData[Encryption], EncryptionAESGCM, EncryptionAge)
+	}
+}
+
+// NewEncryptedStorage wraps the storage engine when encryption is configured, otherwise returns it unchanged.
+func NewEncryptedStorage(backend Storage, appData map[string]string) (Storage, error) {
+	if appData[Encryption] == "" {
+		return backend, nil
+	}
+	fc, err := newFileCipher(appData)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedStorage{Storage: backend, cipher: fc}, nil
+}
+
+// ReEncrypt rewrites every file found under folder with the encryption configured on target.  This is the only place
+// plaintext files are accepted, they are encrypted which migrates an existing backup.  Encrypted files are read using
+// the previous encryption settings when given, falling back on the current ones so that an interrupted run can be
+// resumed once some files were already rewritten.
+func ReEncrypt(ctx context.Context, target Storage, previous map[string]string, folder string) ([]string, error) {
+	encrypted, ok := target.(*EncryptedStorage)
+	if !ok {
+		return nil, errors.New("encryption is not configured for the storage engine")
+	}
+	ciphers := []fileCipher{encrypted.cipher}
+	if previous[Encryption] != "" {
+		fc, err := newFileCipher(previous)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous encryption settings: %w", err)
+		}
+		ciphers = []fileCipher{fc, encrypted.cipher}
+	}
+	files, err := encrypted.Backend().FindAllFiles(ctx, folder, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list files in %s: %w", folder, err)
+	}
+	var result []string
+	for _, file := range files {
+		raw, readErr := encrypted.Backend().ReadFile(ctx, file)
+		if readErr != nil {
+			return result, readErr
+		}
+		data, decryptErr := migrateContent(raw, ciphers)
+		if decryptErr != nil {
+			return result, fmt.Errorf("unable to decrypt %s: %w", file, decryptErr)
+		}
+		if err = encrypted.WriteFile(ctx, file, data); err != nil {
+			return result, err
+		}
+		result = append(result, file)
+	}
+	return result, nil
+}
+
+// migrateContent returns plaintext content unchanged, and encrypted content decrypted by the first cipher that succeeds
+func migrateContent(data []byte, ciphers []fileCipher) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	var errs []error
+	for _, fc := range ciphers {
+		decrypted, err := fc.decrypt(data)
+		if err == nil {
+			return decrypted, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
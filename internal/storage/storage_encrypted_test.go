@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAESKey(t *testing.T) string {
+	key := make([]byte, aesKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "gdg.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0o600))
+	return keyFile
+}
+
+func newTestEncryptedStorage(t *testing.T, appData map[string]string) *EncryptedStorage {
+	engine, err := NewEncryptedStorage(NewLocalStorage(context.Background()), appData)
+	require.NoError(t, err)
+	encrypted, ok := engine.(*EncryptedStorage)
+	require.True(t, ok)
+	return encrypted
+}
+
+func TestEncryptedStorageRoundTrip(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	t.Setenv("GDG_TEST_AGE_IDENTITY", identity.String())
+
+	testCases := map[string]map[string]string{
+		EncryptionAESGCM: {Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)},
+		EncryptionAge:    {Encryption: EncryptionAge, EncryptionKeyEnv: "GDG_TEST_AGE_IDENTITY"},
+	}
+	for name, appData := range testCases {
+		t.Run(name, func(t *testing.T) {
+			engine := newTestEncryptedStorage(t, appData)
+			file := filepath.Join(t.TempDir(), "dashboards", "a.json")
+			require.NoError(t, engine.WriteFile(t.Context(), file, []byte(`{"a":1}`)))
+
+			raw, err := os.ReadFile(file)
+			require.NoError(t, err)
+			assert.True(t, IsEncrypted(raw))
+			assert.NotContains(t, string(raw), `{"a":1}`)
+
+			data, err := engine.ReadFile(t.Context(), file)
+			require.NoError(t, err)
+			assert.Equal(t, `{"a":1}`, string(data))
+		})
+	}
+}
+
+func TestEncryptedStorageWrongKey(t *testing.T) {
+	engine := newTestEncryptedStorage(t, map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)})
+	other := newTestEncryptedStorage(t, map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)})
+	file := filepath.Join(t.TempDir(), "a.json")
+	require.NoError(t, engine.WriteFile(t.Context(), file, []byte(`{"a":1}`)))
+	_, err := other.ReadFile(t.Context(), file)
+	assert.Error(t, err)
+}
+
+func TestEncryptedStorageRejectsPlaintext(t *testing.T) {
+	engine := newTestEncryptedStorage(t, map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)})
+	file := filepath.Join(t.TempDir(), "planted.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"planted":true}`), 0o600))
+	_, err := engine.ReadFile(t.Context(), file)
+	assert.ErrorIs(t, err, ErrNotEncrypted)
+}
+
+func TestEncryptedStorageInvalidConfig(t *testing.T) {
+	_, err := NewEncryptedStorage(NewLocalStorage(context.Background()), map[string]string{Encryption: "rot13"})
+	assert.ErrorContains(t, err, "unsupported encryption")
+	_, err = NewEncryptedStorage(NewLocalStorage(context.Background()), map[string]string{Encryption: EncryptionAESGCM})
+	assert.Error(t, err)
+	engine, err := NewEncryptedStorage(NewLocalStorage(context.Background()), map[string]string{})
+	require.NoError(t, err)
+	assert.IsType(t, &LocalStorage{}, engine)
+}
+
+func TestReEncrypt(t *testing.T) {
+	previousKey := newTestAESKey(t)
+	previous := map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: previousKey}
+	current := newTestEncryptedStorage(t, map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)})
+	folder := t.TempDir()
+	require.NoError(t, newTestEncryptedStorage(t, previous).WriteFile(t.Context(), filepath.Join(folder, "a.json"), []byte(`{"a":1}`)))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "b.json"), []byte(`{"b":1}`), 0o600))
+
+	// plaintext is migrated, files encrypted with an unknown key fail
+	_, err := ReEncrypt(t.Context(), current, nil, folder)
+	assert.Error(t, err)
+
+	files, err := ReEncrypt(t.Context(), current, previous, folder)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+	for name, expected := range map[string]string{"a.json": `{"a":1}`, "b.json": `{"b":1}`} {
+		data, readErr := current.ReadFile(t.Context(), filepath.Join(folder, name))
+		require.NoError(t, readErr)
+		assert.Equal(t, expected, string(data))
+	}
+
+	_, err = ReEncrypt(t.Context(), NewLocalStorage(context.Background()), nil, folder)
+	assert.ErrorContains(t, err, "encryption is not configured")
+}
+
+func TestReEncryptResume(t *testing.T) {
+	previous := map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)}
+	current := newTestEncryptedStorage(t, map[string]string{Encryption: EncryptionAESGCM, EncryptionKeyFile: newTestAESKey(t)})
+	folder := t.TempDir()
+	for _, name := range []string{"a.json", "b.json", "c.json"} {
+		require.NoError(t, newTestEncryptedStorage(t, previous).WriteFile(t.Context(), filepath.Join(folder, name), []byte(name)))
+	}
+	// a corrupted file interrupts the first run after a.json was rewritten with the current key
+	corrupted := filepath.Join(folder, "b.json")
+	original, err := os.ReadFile(corrupted)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(corrupted, append([]byte(envelopeHeader), "truncated"...), 0o600))
+	files, err := ReEncrypt(t.Context(), current, previous, folder)
+	assert.Error(t, err)
+	assert.Equal(t, []string{filepath.Join(folder, "a.json")}, files)
+
+	// once fixed, the run is resumed with the same settings
+	require.NoError(t, os.WriteFile(corrupted, original, 0o600))
+	files, err = ReEncrypt(t.Context(), current, previous, folder)
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+	for _, name := range []string{"a.json", "b.json", "c.json"} {
+		data, readErr := current.ReadFile(t.Context(), filepath.Join(folder, name))
+		require.NoError(t, readErr)
+		assert.Equal(t, name, string(data))
+	}
+}
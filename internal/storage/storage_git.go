@@ -0,0 +1,253 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/esnet/gdg/pkg/config/domain"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// GitStorage writes backups into a local git working tree and commits all changes made by a command at once.
+type GitStorage struct {
+	LocalStorage
+	root        string
+	authorName  string
+	authorEmail string
+
+	mu      sync.Mutex
+	touched map[string]bool // resource folders, relative to the repository root, written to by the current command
+	written map[string]bool // files, relative to the repository root, written by the current command
+}
+
+// Name returns the storage engine name
+func (s *GitStorage) Name() string {
+	return GitStorageType.String()
+}
+
+// WriteFile writes the file to the working tree, changes are staged and committed by Commit
+func (s *GitStorage) WriteFile(ctx context.Context, filename string, data []byte) error {
+	if err := s.LocalStorage.WriteFile(ctx, filename, data); err != nil {
+		return err
+	}
+	rel, err := s.relativePath(filename)
+	if err != nil {
+		slog.Warn("file is outside of the git repository and will not be committed", "filename", filename, "repository", s.root)
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.touched[resourceScope(rel)] = true
+	s.written[rel] = true
+	return nil
+}
+
+// Delete removes the file or folder from the working tree, the removal is committed by Commit
+func (s *GitStorage) Delete(ctx context.Context, name string) error {
+	info, statErr := os.Stat(name)
+	if err := s.LocalStorage.Delete(ctx, name); err != nil {
+		return err
+	}
+	rel, err := s.relativePath(name)
+	if err != nil {
+		return nil
+	}
+	if statErr == nil && info.IsDir() {
+		// scope the folder as if it contained a file
+		rel += "/"
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.touched[resourceScope(rel)] = true
+	return nil
+}
+
+// relativePath returns the slash separated path of the file relative to the repository root
+func (s *GitStorage) relativePath(filename string) (string, error) {
+	absFile, err := filepath.Abs(filename)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(s.root, absFile)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is not in %s", filename, s.root)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// resourceScope returns the resource folder of a file, ie. org_main-org/dashboards or users
+func resourceScope(rel string) string {
+	parts := strings.Split(rel, "/")
+	if len(parts) > 2 && strings.HasPrefix(parts[0], string(domain.OrganizationMetaResource)+"_") {
+		return strings.Join(parts[:2], "/")
+	}
+	if len(parts) > 1 {
+		return parts[0]
+	}
+	return "."
+}
+
+// Commit stages every change in the resource folders written to, including files that were removed, and commits them.
+// Tracked files that weren't rewritten in a folder the command wrote to, ie. entities deleted from Grafana since the
+// previous backup, are removed as well.  The message lists the number of added, modified and deleted files per
+// resource.  Nothing is committed if no files changed.
+func (s *GitStorage) Commit(_ context.Context, subject string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.touched) == 0 {
+		return nil
+	}
+	defer clear(s.touched)
+	defer clear(s.written)
+
+	repo, err := git.PlainOpen(s.root)
+	if err != nil {
+		return fmt.Errorf("unable to open git repository %s: %w", s.root, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	type changeCount struct{ added, modified, deleted int }
+	changes := make(map[string]*changeCount)
+	stale, err := s.removeStale(repo, worktree)
+	if err != nil {
+		return err
+	}
+	for _, file := range stale {
+		scope := resourceScope(file)
+		if changes[scope] == nil {
+			changes[scope] = &changeCount{}
+		}
+		changes[scope].deleted++
+	}
+	status, err := worktree.Status()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve git status: %w", err)
+	}
+	for file, fileStatus := range status {
+		scope := resourceScope(file)
+		if !s.touched[scope] || fileStatus.Worktree == git.Unmodified {
+			continue
+		}
+		if changes[scope] == nil {
+			changes[scope] = &changeCount{}
+		}
+		switch fileStatus.Worktree {
+		case git.Deleted:
+			if _, err = worktree.Remove(file); err != nil {
+				return fmt.Errorf("unable to stage removal of %s: %w", file, err)
+			}
+			changes[scope].deleted++
+		case git.Untracked:
+			changes[scope].added++
+		default:
+			changes[scope].modified++
+		}
+		if fileStatus.Worktree != git.Deleted {
+			if _, err = worktree.Add(file); err != nil {
+				return fmt.Errorf("unable to stage %s: %w", file, err)
+			}
+		}
+	}
+	if len(changes) == 0 {
+		slog.Info("No changes to commit")
+		return nil
+	}
+
+	scopes := make([]string, 0, len(changes))
+	for scope := range changes {
+		scopes = append(scopes, scope)
+	}
+	slices.Sort(scopes)
+	lines := []string{subject, ""}
+	for _, scope := range scopes {
+		c := changes[scope]
+		lines = append(lines, fmt.Sprintf("%s: %d added, %d modified, %d deleted", scope, c.added, c.modified, c.deleted))
+	}
+
+	hash, err := worktree.Commit(strings.Join(lines, "\n")+"\n", &git.CommitOptions{
+		Author: &object.Signature{Name: s.authorName, Email: s.authorEmail, When: time.Now()},
+	})
+	if errors.Is(err, git.ErrEmptyCommit) {
+		slog.Info("No changes to commit")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to commit changes: %w", err)
+	}
+	slog.Info("Committed backup to git repository", "repository", s.root, "commit", hash.String()[:8])
+	return nil
+}
+
+// removeStale removes the tracked files found in the folders written to by the command that weren't rewritten, and
+// returns them.  Files at the root of the repository, such as the manifest, are left as is.
+func (s *GitStorage) removeStale(repo *git.Repository, worktree *git.Worktree) ([]string, error) {
+	folders := make(map[string]bool)
+	for file := range s.written {
+		if folder := path.Dir(file); folder != "." {
+			folders[folder] = true
+		}
+	}
+	if len(folders) == 0 {
+		return nil, nil
+	}
+	index, err := repo.Storer.Index()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read git index: %w", err)
+	}
+	var stale []string
+	for _, entry := range index.Entries {
+		if folders[path.Dir(entry.Name)] && !s.written[entry.Name] {
+			stale = append(stale, entry.Name)
+		}
+	}
+	for _, file := range stale {
+		if _, err = worktree.Remove(file); err != nil {
+			return nil, fmt.Errorf("unable to stage removal of %s: %w", file, err)
+		}
+	}
+	return stale, nil
+}
+
+// NewGitStorage creates a GitStorage rooted at the configured path, the repository is initialized if needed.
+func NewGitStorage(c context.Context) (Storage, error) {
+	contextVal := c.Value(Context)
+	if contextVal == nil {
+		return nil, errors.New("cannot configure git storage, context missing")
+	}
+	appData, ok := contextVal.(map[string]string)
+	if !ok {
+		return nil, errors.New("cannot convert appData to string map")
+	}
+	root, err := filepath.Abs(getMapValue(GitPath, ".", stringEmpty, appData))
+	if err != nil {
+		return nil, fmt.Errorf("invalid git repository path: %w", err)
+	}
+	if _, err = git.PlainOpen(root); errors.Is(err, git.ErrRepositoryNotExists) {
+		slog.Info("initializing git repository", "repository", root)
+		_, err = git.PlainInit(root, false)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to open git repository %s: %w", root, err)
+	}
+
+	return &GitStorage{
+		LocalStorage: LocalStorage{},
+		root:         root,
+		authorName:   getMapValue(GitAuthorName, "gdg", stringEmpty, appData),
+		authorEmail:  getMapValue(GitAuthorEmail, "gdg@localhost", stringEmpty, appData),
+		touched:      make(map[string]bool),
+		written:      make(map[string]bool),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitStorageCommit(t *testing.T) {
+	root := t.TempDir()
+	ctx := context.WithValue(context.Background(), Context, map[string]string{GitPath: root, GitAuthorName: "tester"})
+	engine, err := NewGitStorage(ctx)
+	require.NoError(t, err)
+	gitStorage := engine.(*GitStorage)
+	assert.Equal(t, GitStorageType.String(), gitStorage.Name())
+
+	dashboards := filepath.Join(root, "org_main-org", "dashboards", "General")
+	require.NoError(t, os.MkdirAll(dashboards, 0o750))
+	require.NoError(t, gitStorage.WriteFile(t.Context(), filepath.Join(dashboards, "a.json"), []byte(`{"a":1}`)))
+	require.NoError(t, gitStorage.WriteFile(t.Context(), filepath.Join(dashboards, "b.json"), []byte(`{"b":1}`)))
+	require.NoError(t, gitStorage.Commit(t.Context(), "gdg backup dashboards download"))
+
+	repo, err := git.PlainOpen(root)
+	require.NoError(t, err)
+	head, err := repo.Head()
+	require.NoError(t, err)
+	commit, err := repo.CommitObject(head.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, "tester", commit.Author.Name)
+	assert.Equal(t, "gdg backup dashboards download\n\norg_main-org/dashboards: 2 added, 0 modified, 0 deleted\n", commit.Message)
+
+	// An entity that no longer exists is removed from the tree, ie. clear_output
+	require.NoError(t, os.Remove(filepath.Join(dashboards, "b.json")))
+	require.NoError(t, gitStorage.WriteFile(t.Context(), filepath.Join(dashboards, "a.json"), []byte(`{"a":2}`)))
+	require.NoError(t, gitStorage.Commit(t.Context(), "gdg backup dashboards download"))
+	head, err = repo.Head()
+	require.NoError(t, err)
+	commit, err = repo.CommitObject(head.Hash())
+	require.NoError(t, err)
+	assert.Contains(t, commit.Message, "org_main-org/dashboards: 0 added, 1 modified, 1 deleted")
+
+	// No changes, no commit
+	require.NoError(t, gitStorage.WriteFile(t.Context(), filepath.Join(dashboards, "a.json"), []byte(`{"a":2}`)))
+	require.NoError(t, gitStorage.Commit(t.Context(), "gdg backup dashboards download"))
+	newHead, err := repo.Head()
+	require.NoError(t, err)
+	assert.Equal(t, head.Hash(), newHead.Hash())
+}
+
+func TestGitStorageRemovesStaleFiles(t *testing.T) {
+	root := t.TempDir()
+	ctx := context.WithValue(context.Background(), Context, map[string]string{GitPath: root})
+	engine, err := NewGitStorage(ctx)
+	require.NoError(t, err)
+
+	general := filepath.Join(root, "org_main-org", "dashboards", "General")
+	other := filepath.Join(root, "org_main-org", "dashboards", "Other")
+	for _, file := range []string{filepath.Join(general, "a.json"), filepath.Join(general, "b.json"), filepath.Join(other, "c.json"), filepath.Join(root, "users", "bob.json")} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o750))
+		require.NoError(t, engine.WriteFile(t.Context(), file, []byte(`{}`)))
+	}
+	require.NoError(t, engine.(*GitStorage).Commit(t.Context(), "gdg backup all download"))
+
+	// b was deleted from Grafana, the files are downloaded again without clear_output
+	require.NoError(t, engine.WriteFile(t.Context(), filepath.Join(general, "a.json"), []byte(`{"a":2}`)))
+	require.NoError(t, engine.(*GitStorage).Commit(t.Context(), "gdg backup dashboards download"))
+
+	repo, err := git.PlainOpen(root)
+	require.NoError(t, err)
+	head, err := repo.Head()
+	require.NoError(t, err)
+	commit, err := repo.CommitObject(head.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, "gdg backup dashboards download\n\norg_main-org/dashboards: 0 added, 1 modified, 1 deleted\n", commit.Message)
+	assert.NoFileExists(t, filepath.Join(general, "b.json"))
+	assert.FileExists(t, filepath.Join(other, "c.json"), "folders that weren't written to are left as is")
+	assert.FileExists(t, filepath.Join(root, "users", "bob.json"))
+	_, err = commit.File("org_main-org/dashboards/General/b.json")
+	assert.ErrorIs(t, err, object.ErrFileNotFound)
+	_, err = commit.File("org_main-org/dashboards/Other/c.json")
+	assert.NoError(t, err)
+}
+
+func TestResourceScope(t *testing.T) {
+	assert.Equal(t, "org_main-org/dashboards", resourceScope("org_main-org/dashboards/General/a.json"))
+	assert.Equal(t, "users", resourceScope("users/bob.json"))
+	assert.Equal(t, ".", resourceScope("manifest.json"))
+}
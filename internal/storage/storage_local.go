@@ -2,7 +2,12 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -12,9 +17,7 @@ import (
 )
 
 // LocalStorage default storage engine
-type LocalStorage struct {
-	ctx context.Context
-}
+type LocalStorage struct{}
 
 // GetPrefix should always return "" for local storage
 func (s *LocalStorage) GetPrefix() string {
@@ -22,13 +25,13 @@ func (s *LocalStorage) GetPrefix() string {
 }
 
 // ReadFile returns a byte array of file content
-func (s *LocalStorage) ReadFile(filename string) ([]byte, error) {
+func (s *LocalStorage) ReadFile(ctx context.Context, filename string) ([]byte, error) {
 	mb, err := s.getBucket(filepath.Dir(filename))
 	if err != nil {
 		return nil, err
 	}
 	f := filepath.Base(filename)
-	data, err := mb.ReadAll(s.ctx, f)
+	data, err := mb.ReadAll(ctx, f)
 	if err != nil || len(data) == 0 {
 		return nil, errors.New("unable to read file")
 	}
@@ -37,7 +40,7 @@ func (s *LocalStorage) ReadFile(filename string) ([]byte, error) {
 
 func (s *LocalStorage) getBucket(baseFolder string) (*blob.Bucket, error) {
 	if _, err := os.Stat(baseFolder); err != nil {
-		_ = os.Mkdir(baseFolder, 0o750)
+		_ = os.MkdirAll(baseFolder, 0o750)
 	}
 	opts := fileblob.Options{
 		NoTempDir: true,
@@ -46,13 +49,13 @@ func (s *LocalStorage) getBucket(baseFolder string) (*blob.Bucket, error) {
 }
 
 // WriteFile writes file to disk and returns an error if operation failed
-func (s *LocalStorage) WriteFile(filename string, data []byte) error {
+func (s *LocalStorage) WriteFile(ctx context.Context, filename string, data []byte) error {
 	mb, err := s.getBucket(filepath.Dir(filename))
 	if err != nil {
 		return err
 	}
 	f := filepath.Base(filename)
-	err = mb.WriteAll(s.ctx, f, data, nil)
+	err = mb.WriteAll(ctx, f, data, nil)
 	if err == nil {
 		// Remove attribute file being generated by local storage
 		attrFile := filename + ".attrs"
@@ -67,7 +70,7 @@ func (s *LocalStorage) Name() string {
 	return LocalStorageType.String()
 }
 
-func (s *LocalStorage) FindAllFiles(folder string, fullPath bool) ([]string, error) {
+func (s *LocalStorage) FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error) {
 	mb, err := s.getBucket(folder)
 	if err != nil {
 		return nil, err
@@ -76,10 +79,17 @@ func (s *LocalStorage) FindAllFiles(folder string, fullPath bool) ([]string, err
 	var fileList []string
 	iterator := mb.List(nil)
 	for {
-		obj, err := iterator.Next(s.ctx)
-		if err != nil {
+		obj, err := iterator.Next(ctx)
+		if errors.Is(err, io.EOF) {
 			break
 		}
+		if err == nil {
+			// iterators only check the context when fetching a new page
+			err = ctx.Err()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to list files in %s: %w", folder, err)
+		}
 		if fullPath {
 			fileList = append(fileList, filepath.Join(folder, obj.Key))
 		} else {
@@ -90,6 +100,56 @@ func (s *LocalStorage) FindAllFiles(folder string, fullPath bool) ([]string, err
 	return fileList, nil
 }
 
-func NewLocalStorage(ctx context.Context) Storage {
-	return &LocalStorage{ctx: ctx}
+// Delete removes the file, or the folder and all of its content, from disk.  Missing files are ignored.
+func (s *LocalStorage) Delete(ctx context.Context, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return os.RemoveAll(name)
+}
+
+// Stat returns the size, modification time and SHA-256 checksum of the file
+func (s *LocalStorage) Stat(ctx context.Context, filename string) (*FileInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory", filename)
+	}
+	f, err := os.Open(filename) // #nosec G304 file is part of the backup
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, f); err != nil {
+		return nil, err
+	}
+	return &FileInfo{
+		Name:      filename,
+		Size:      info.Size(),
+		ModTime:   info.ModTime(),
+		Checksum:  hex.EncodeToString(hash.Sum(nil)),
+		Algorithm: ChecksumSHA256,
+	}, nil
+}
+
+// Exists returns true if the file exists on disk
+func (s *LocalStorage) Exists(ctx context.Context, filename string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	_, err := os.Stat(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func NewLocalStorage(_ context.Context) Storage {
+	return &LocalStorage{}
 }
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorageFileOperations(t *testing.T) {
+	ctx := t.Context()
+	engine := NewLocalStorage(ctx)
+	root := t.TempDir()
+	file := filepath.Join(root, "dashboards", "General", "a.json")
+	require.NoError(t, engine.WriteFile(ctx, file, []byte(`{"a":1}`)))
+
+	exists, err := engine.Exists(ctx, file)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	info, err := engine.Stat(ctx, file)
+	require.NoError(t, err)
+	checksum := sha256.Sum256([]byte(`{"a":1}`))
+	assert.Equal(t, int64(7), info.Size)
+	assert.Equal(t, hex.EncodeToString(checksum[:]), info.Checksum)
+	assert.Equal(t, ChecksumSHA256, info.Algorithm)
+	assert.False(t, info.ModTime.IsZero())
+	_, err = engine.Stat(ctx, filepath.Join(root, "missing.json"))
+	assert.Error(t, err)
+
+	require.NoError(t, engine.Delete(ctx, filepath.Join(root, "dashboards")))
+	exists, err = engine.Exists(ctx, file)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	// missing files are ignored
+	assert.NoError(t, engine.Delete(ctx, file))
+}
+
+func TestLocalStorageListingError(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	engine := NewLocalStorage(ctx)
+	root := t.TempDir()
+	require.NoError(t, engine.WriteFile(ctx, filepath.Join(root, "a.json"), []byte(`{}`)))
+	files, err := engine.FindAllFiles(ctx, root, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.json"}, files)
+	cancel()
+	_, err = engine.FindAllFiles(ctx, root, false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"gocloud.dev/blob/memblob"
+)
+
+// memoryPrefix every file is stored under a prefix so that listing a folder never returns the whole bucket
+const memoryPrefix = "memory"
+
+// NewMemoryStorage returns a storage engine keeping every file in memory, nothing is persisted once it is discarded.
+func NewMemoryStorage() Storage {
+	return &CloudStorage{
+		BucketRef:   memblob.OpenBucket(nil),
+		BucketName:  memoryPrefix,
+		Prefix:      memoryPrefix,
+		StorageName: MemoryStorageType.String(),
+	}
+}
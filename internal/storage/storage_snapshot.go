@@ -0,0 +1,520 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	snapshotFolder  = "snapshots"
+	snapshotLatest  = "latest"
+	snapshotPruned  = "pruned"
+	snapshotKept    = "kept"
+	snapshotPending = "pending"
+	// snapshotLayout names the snapshot folders, colons aren't valid in Windows paths or portable in object keys
+	snapshotLayout = "20060102T150405Z"
+)
+
+// RetentionPolicy the number of snapshots to keep, the most recent snapshot of every day, week or month is kept until
+// the configured number of days, weeks or months is reached.  The latest snapshot is always kept.
+type RetentionPolicy struct {
+	Last    int
+	Daily   int
+	Weekly  int
+	Monthly int
+}
+
+// IsEmpty returns true if no retention rule is set
+func (p RetentionPolicy) IsEmpty() bool {
+	return p.Last <= 0 && p.Daily <= 0 && p.Weekly <= 0 && p.Monthly <= 0
+}
+
+// SnapshotResult the outcome of pruning a single snapshot
+type SnapshotResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// SnapshotStorage writes every run under <output_path>/snapshots/<UTC timestamp>/ and maintains a `latest` pointer
+// to the most recent snapshot.  Reads are served from the configured snapshot, by default the latest one.
+//
+// gdg backs up a single resource per command, the snapshot of a run is therefore layered over the latest snapshot:
+// files that weren't written or removed by the run are read from it, and copied forward on Commit so that every
+// snapshot holds a complete backup.
+type SnapshotStorage struct {
+	Storage
+	root     string
+	selected string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	current string   // snapshot written by the current run, created on first write
+	base    string   // latest snapshot when the current one was created, the current run is layered over it
+	removed []string // paths, relative to the output path, removed by the current run
+}
+
+// Name returns the storage engine name
+func (s *SnapshotStorage) Name() string {
+	return SnapshotStorageType.String()
+}
+
+// Backend returns the storage engine being decorated
+func (s *SnapshotStorage) Backend() Storage {
+	return s.Storage
+}
+
+func (s *SnapshotStorage) snapshotsDir() string {
+	return path.Join(s.root, snapshotFolder)
+}
+
+// inRoot returns the path relative to the output path, false if the file is outside of it or already in a snapshot
+func (s *SnapshotStorage) inRoot(filename string) (string, bool) {
+	clean := path.Clean(filepath.ToSlash(filename))
+	if clean == s.snapshotsDir() || strings.HasPrefix(clean, s.snapshotsDir()+"/") {
+		return "", false
+	}
+	if clean == s.root {
+		return "", true
+	}
+	rel, ok := strings.CutPrefix(clean, s.root+"/")
+	return rel, ok
+}
+
+// startSnapshot creates the snapshot of the current run, layered over the latest snapshot, unless it already exists.
+// The caller holds the lock.
+func (s *SnapshotStorage) startSnapshot(ctx context.Context) error {
+	if s.current != "" {
+		return nil
+	}
+	base, err := s.Latest(ctx)
+	if err != nil {
+		return err
+	}
+	s.current = s.now().UTC().Format(snapshotLayout)
+	if base != s.current {
+		s.base = base
+	}
+	slog.Info("Writing backup snapshot", "snapshot", s.current, "base", s.base)
+	return nil
+}
+
+// writePath returns the location of the file in the snapshot of the current run
+func (s *SnapshotStorage) writePath(ctx context.Context, filename string) (string, error) {
+	rel, ok := s.inRoot(filename)
+	if !ok {
+		return filename, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.startSnapshot(ctx); err != nil {
+		return "", err
+	}
+	return path.Join(s.snapshotsDir(), s.current, rel), nil
+}
+
+// isRemoved returns true if the path, relative to the output path, was removed by the current run.  The caller holds
+// the lock.
+func (s *SnapshotStorage) isRemoved(rel string) bool {
+	return slices.ContainsFunc(s.removed, func(removed string) bool {
+		return removed == "" || rel == removed || strings.HasPrefix(rel, removed+"/")
+	})
+}
+
+// readSnapshots returns the snapshot reads are served from, and the snapshot it is layered over when it is the one
+// being written.
+func (s *SnapshotStorage) readSnapshots(ctx context.Context) (string, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != "" {
+		return s.current, s.base, nil
+	}
+	if s.selected != "" {
+		return s.selected, "", nil
+	}
+	latest, err := s.Latest(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	s.selected = latest
+	return latest, "", nil
+}
+
+// readPath returns the location of the file in the snapshot being read from, files the current run didn't write
+// are read from the snapshot it is layered over.
+func (s *SnapshotStorage) readPath(ctx context.Context, filename string) (string, string, error) {
+	rel, ok := s.inRoot(filename)
+	if !ok {
+		return filename, "", nil
+	}
+	snapshot, base, err := s.readSnapshots(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if snapshot == "" {
+		// no snapshot was taken yet, fallback to the regular layout
+		return filename, "", nil
+	}
+	location := path.Join(s.snapshotsDir(), snapshot, rel)
+	s.mu.Lock()
+	removed := s.isRemoved(rel)
+	s.mu.Unlock()
+	if base == "" || removed {
+		return location, snapshot, nil
+	}
+	exists, err := s.Storage.Exists(ctx, location)
+	if err != nil || exists {
+		return location, snapshot, err
+	}
+	return path.Join(s.snapshotsDir(), base, rel), base, nil
+}
+
+// WriteFile writes the file in the snapshot of the current run
+func (s *SnapshotStorage) WriteFile(ctx context.Context, filename string, data []byte) error {
+	location, err := s.writePath(ctx, filename)
+	if err != nil {
+		return err
+	}
+	return s.Storage.WriteFile(ctx, location, data)
+}
+
+// ReadFile reads the file from the selected snapshot
+func (s *SnapshotStorage) ReadFile(ctx context.Context, filename string) ([]byte, error) {
+	location, _, err := s.readPath(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	return s.Storage.ReadFile(ctx, location)
+}
+
+// Stat describes the file of the selected snapshot
+func (s *SnapshotStorage) Stat(ctx context.Context, filename string) (*FileInfo, error) {
+	location, _, err := s.readPath(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	return s.Storage.Stat(ctx, location)
+}
+
+// Exists returns true if the file exists in the selected snapshot
+func (s *SnapshotStorage) Exists(ctx context.Context, filename string) (bool, error) {
+	location, _, err := s.readPath(ctx, filename)
+	if err != nil {
+		return false, err
+	}
+	return s.Storage.Exists(ctx, location)
+}
+
+// Delete removes the file, or folder, from the snapshot of the current run.  It is no longer read from, or copied
+// forward from, the snapshot the run is layered over.  Previous snapshots are only removed by Prune or when addressed
+// by their full path.
+func (s *SnapshotStorage) Delete(ctx context.Context, name string) error {
+	rel, ok := s.inRoot(name)
+	if !ok {
+		return s.Storage.Delete(ctx, name)
+	}
+	s.mu.Lock()
+	if err := s.startSnapshot(ctx); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	s.removed = append(s.removed, rel)
+	location := path.Join(s.snapshotsDir(), s.current, rel)
+	s.mu.Unlock()
+	return s.Storage.Delete(ctx, location)
+}
+
+// FindAllFiles lists the files of the selected snapshot, paths are returned as if no snapshot was used
+func (s *SnapshotStorage) FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error) {
+	rel, ok := s.inRoot(folder)
+	if !ok {
+		return s.Storage.FindAllFiles(ctx, folder, fullPath)
+	}
+	snapshot, base, err := s.readSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == "" {
+		// no snapshot was taken yet, fallback to the regular layout
+		return s.Storage.FindAllFiles(ctx, folder, fullPath)
+	}
+	files, err := s.snapshotFiles(ctx, snapshot, rel)
+	if err != nil {
+		return nil, err
+	}
+	if base != "" {
+		baseFiles, baseErr := s.snapshotFiles(ctx, base, rel)
+		if baseErr != nil {
+			return nil, baseErr
+		}
+		s.mu.Lock()
+		for _, file := range baseFiles {
+			if !s.isRemoved(file) {
+				files = append(files, file)
+			}
+		}
+		s.mu.Unlock()
+	}
+	for ndx, file := range files {
+		if fullPath {
+			files[ndx] = path.Join(s.root, file)
+		} else {
+			files[ndx] = path.Base(file)
+		}
+	}
+	slices.Sort(files)
+	return slices.Compact(files), nil
+}
+
+// snapshotFiles lists the files of the snapshot found under the folder, relative to the output path
+func (s *SnapshotStorage) snapshotFiles(ctx context.Context, snapshot, folder string) ([]string, error) {
+	prefix := path.Join(s.snapshotsDir(), snapshot)
+	files, err := s.Storage.FindAllFiles(ctx, path.Join(prefix, folder), true)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(files))
+	for _, file := range files {
+		file = filepath.ToSlash(file)
+		ndx := strings.Index(file, prefix+"/")
+		if ndx == -1 {
+			continue
+		}
+		result = append(result, file[ndx+len(prefix)+1:])
+	}
+	return result, nil
+}
+
+// Latest returns the snapshot the latest pointer refers to, empty if no snapshot exists
+func (s *SnapshotStorage) Latest(ctx context.Context) (string, error) {
+	latest := path.Join(s.snapshotsDir(), snapshotLatest)
+	exists, err := s.Storage.Exists(ctx, latest)
+	if err != nil {
+		return "", fmt.Errorf("unable to read latest snapshot: %w", err)
+	}
+	if !exists {
+		return "", nil
+	}
+	data, err := s.Storage.ReadFile(ctx, latest)
+	if err != nil {
+		return "", fmt.Errorf("unable to read latest snapshot: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Commit copies the files the current run didn't write or remove forward from the snapshot it is layered over, then
+// updates the latest pointer to the snapshot written by the current run.  The commit is forwarded to the underlying
+// storage engine if it supports it.
+func (s *SnapshotStorage) Commit(ctx context.Context, subject string) error {
+	s.mu.Lock()
+	current := s.current
+	s.mu.Unlock()
+	if current != "" {
+		if err := s.copyForward(ctx); err != nil {
+			return err
+		}
+		if err := s.Storage.WriteFile(ctx, path.Join(s.snapshotsDir(), snapshotLatest), []byte(current+"\n")); err != nil {
+			return fmt.Errorf("unable to update latest snapshot: %w", err)
+		}
+	}
+	if committer, ok := s.Storage.(Committer); ok {
+		return committer.Commit(ctx, subject)
+	}
+	return nil
+}
+
+// copyForward copies the files of the base snapshot that the current run didn't write or remove into the current
+// snapshot, which then no longer depends on it.
+func (s *SnapshotStorage) copyForward(ctx context.Context) error {
+	s.mu.Lock()
+	current, base := s.current, s.base
+	s.mu.Unlock()
+	if base == "" {
+		return nil
+	}
+	files, err := s.snapshotFiles(ctx, base, "")
+	if err != nil {
+		return fmt.Errorf("unable to list snapshot %s: %w", base, err)
+	}
+	copied := 0
+	for _, file := range files {
+		s.mu.Lock()
+		removed := s.isRemoved(file)
+		s.mu.Unlock()
+		target := path.Join(s.snapshotsDir(), current, file)
+		if removed {
+			continue
+		}
+		exists, existsErr := s.Storage.Exists(ctx, target)
+		if existsErr != nil {
+			return existsErr
+		}
+		if exists {
+			continue
+		}
+		data, readErr := s.Storage.ReadFile(ctx, path.Join(s.snapshotsDir(), base, file))
+		if readErr != nil {
+			return fmt.Errorf("unable to copy %s from snapshot %s: %w", file, base, readErr)
+		}
+		if err = s.Storage.WriteFile(ctx, target, data); err != nil {
+			return fmt.Errorf("unable to copy %s from snapshot %s: %w", file, base, err)
+		}
+		copied++
+	}
+	slog.Debug("Copied unchanged files forward", "snapshot", current, "base", base, "files", copied)
+	s.mu.Lock()
+	s.base = ""
+	s.removed = nil
+	s.mu.Unlock()
+	return nil
+}
+
+// ListSnapshots returns the name of all snapshots, oldest first
+func (s *SnapshotStorage) ListSnapshots(ctx context.Context) ([]string, error) {
+	files, err := s.Storage.FindAllFiles(ctx, s.snapshotsDir(), true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list snapshots: %w", err)
+	}
+	marker := s.snapshotsDir() + "/"
+	var snapshots []string
+	for _, file := range files {
+		file = filepath.ToSlash(file)
+		ndx := strings.Index(file, marker)
+		if ndx == -1 {
+			continue
+		}
+		name, _, found := strings.Cut(file[ndx+len(marker):], "/")
+		if !found {
+			continue
+		}
+		if _, parseErr := time.Parse(snapshotLayout, name); parseErr != nil {
+			continue
+		}
+		snapshots = append(snapshots, name)
+	}
+	slices.Sort(snapshots)
+	return slices.Compact(snapshots), nil
+}
+
+// Prune removes the snapshots not retained by the policy, nothing is removed when dryRun is set.
+func (s *SnapshotStorage) Prune(ctx context.Context, policy RetentionPolicy, dryRun bool) ([]SnapshotResult, error) {
+	if policy.IsEmpty() {
+		return nil, errors.New("at least one retention rule needs to be set")
+	}
+	snapshots, err := s.ListSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.Latest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keep := retainedSnapshots(snapshots, policy)
+	keep[latest] = true
+
+	result := make([]SnapshotResult, 0, len(snapshots))
+	for _, snapshot := range slices.Backward(snapshots) {
+		entry := SnapshotResult{Name: snapshot, Status: snapshotKept}
+		if !keep[snapshot] {
+			entry.Status = snapshotPending
+			if !dryRun {
+				if err = s.Storage.Delete(ctx, path.Join(s.snapshotsDir(), snapshot)); err != nil {
+					return result, fmt.Errorf("unable to remove snapshot %s: %w", snapshot, err)
+				}
+				entry.Status = snapshotPruned
+			}
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// retainedSnapshots returns the snapshots kept by the policy, snapshots are expected sorted oldest first
+func retainedSnapshots(snapshots []string, policy RetentionPolicy) map[string]bool {
+	keep := make(map[string]bool)
+	rules := []struct {
+		count  int
+		bucket func(t time.Time) string
+	}{
+		{policy.Last, func(t time.Time) string { return t.Format(snapshotLayout) }},
+		{policy.Daily, func(t time.Time) string { return t.Format(time.DateOnly) }},
+		{policy.Weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%02d", year, week)
+		}},
+		{policy.Monthly, func(t time.Time) string { return t.Format("2006-01") }},
+	}
+	for _, rule := range rules {
+		seen := make(map[string]bool)
+		for _, snapshot := range slices.Backward(snapshots) {
+			if len(seen) >= rule.count {
+				break
+			}
+			t, err := time.Parse(snapshotLayout, snapshot)
+			if err != nil {
+				continue
+			}
+			bucket := rule.bucket(t)
+			if seen[bucket] {
+				continue
+			}
+			seen[bucket] = true
+			keep[snapshot] = true
+		}
+	}
+	return keep
+}
+
+// FindSnapshotStorage returns the snapshot storage the engine is or decorates, if any
+func FindSnapshotStorage(engine Storage) (*SnapshotStorage, bool) {
+	return findDecorator[*SnapshotStorage](engine)
+}
+
+// findDecorator returns the storage of type T the engine is or decorates, if any
+func findDecorator[T Storage](engine Storage) (T, bool) {
+	for engine != nil {
+		if found, ok := engine.(T); ok {
+			return found, true
+		}
+		decorator, ok := engine.(interface{ Backend() Storage })
+		if !ok {
+			break
+		}
+		engine = decorator.Backend()
+	}
+	var zero T
+	return zero, false
+}
+
+// NewSnapshotStorage wraps the storage engine so every run is written into its own snapshot of the output path.
+func NewSnapshotStorage(c context.Context, backend Storage) (Storage, error) {
+	contextVal := c.Value(Context)
+	if contextVal == nil {
+		return nil, errors.New("cannot configure snapshot storage, context missing")
+	}
+	appData, ok := contextVal.(map[string]string)
+	if !ok {
+		return nil, errors.New("cannot convert appData to string map")
+	}
+	root := path.Clean(filepath.ToSlash(appData[SnapshotRoot]))
+	if appData[SnapshotRoot] == "" || root == "." {
+		return nil, errors.New("snapshots require an output path")
+	}
+	selected := getMapValueOrEnvOverride(SnapshotName, SnapshotEnv, appData)
+	if selected != "" && selected != snapshotLatest {
+		if _, err := time.Parse(snapshotLayout, selected); err != nil {
+			return nil, fmt.Errorf("invalid snapshot name '%s', expected a timestamp such as %s", selected, snapshotLayout)
+		}
+	}
+	if selected == snapshotLatest {
+		selected = ""
+	}
+	return &SnapshotStorage{Storage: backend, root: root, selected: selected, now: time.Now}, nil
+}
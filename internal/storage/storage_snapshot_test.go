@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSnapshotStorage(t *testing.T, appData map[string]string) *SnapshotStorage {
+	ctx := context.WithValue(context.Background(), Context, appData)
+	engine, err := NewSnapshotStorage(ctx, NewLocalStorage(ctx))
+	require.NoError(t, err)
+	return engine.(*SnapshotStorage)
+}
+
+func TestSnapshotStorageRoundTrip(t *testing.T) {
+	root := t.TempDir()
+	appData := map[string]string{SnapshotRoot: root}
+	writer := newTestSnapshotStorage(t, appData)
+	require.NoError(t, writer.WriteFile(t.Context(), filepath.Join(root, "org_main-org/dashboards/General/a.json"), []byte(`{"a":1}`)))
+	require.NoError(t, writer.Commit(t.Context(), "gdg backup dashboards download"))
+
+	snapshots, err := writer.ListSnapshots(t.Context())
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	latest, err := writer.Latest(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, snapshots[0], latest)
+	assert.FileExists(t, filepath.Join(root, snapshotFolder, latest, "org_main-org/dashboards/General/a.json"))
+	assert.NoFileExists(t, filepath.Join(root, "org_main-org/dashboards/General/a.json"))
+
+	reader := newTestSnapshotStorage(t, appData)
+	files, err := reader.FindAllFiles(t.Context(), filepath.Join(root, "org_main-org/dashboards"), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.ToSlash(filepath.Join(root, "org_main-org/dashboards/General/a.json"))}, files)
+	data, err := reader.ReadFile(t.Context(), files[0])
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(data))
+
+	// a named snapshot that doesn't contain the file
+	t.Setenv(SnapshotEnv, "20200101T000000Z")
+	named := newTestSnapshotStorage(t, appData)
+	_, err = named.ReadFile(t.Context(), files[0])
+	assert.Error(t, err)
+}
+
+func TestSnapshotStorageSeparateCommands(t *testing.T) {
+	root := t.TempDir()
+	appData := map[string]string{SnapshotRoot: root}
+	dashboard := filepath.Join(root, "org_main-org/dashboards/General/a.json")
+	folder := filepath.Join(root, "org_main-org/folders/General.json")
+	stale := filepath.Join(root, "org_main-org/folders/Stale.json")
+	run := func(at string, fn func(engine *SnapshotStorage)) {
+		engine := newTestSnapshotStorage(t, appData)
+		engine.now = func() time.Time {
+			ts, err := time.Parse(snapshotLayout, at)
+			require.NoError(t, err)
+			return ts
+		}
+		fn(engine)
+		require.NoError(t, engine.Commit(t.Context(), "gdg backup"))
+	}
+
+	run("20250101T000000Z", func(engine *SnapshotStorage) {
+		require.NoError(t, engine.WriteFile(t.Context(), dashboard, []byte(`{"dashboard":1}`)))
+		require.NoError(t, engine.WriteFile(t.Context(), stale, []byte(`{}`)))
+	})
+	// folders are downloaded by a separate command, which clears the previous ones
+	run("20250101T000100Z", func(engine *SnapshotStorage) {
+		require.NoError(t, engine.Delete(t.Context(), filepath.Join(root, "org_main-org/folders")))
+		require.NoError(t, engine.WriteFile(t.Context(), folder, []byte(`{"folder":1}`)))
+		files, err := engine.FindAllFiles(t.Context(), filepath.Join(root, "org_main-org"), true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.ToSlash(dashboard), filepath.ToSlash(folder)}, files, "the run is layered over the latest snapshot")
+	})
+
+	reader := newTestSnapshotStorage(t, appData)
+	latest, err := reader.Latest(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "20250101T000100Z", latest)
+	data, err := reader.ReadFile(t.Context(), dashboard)
+	require.NoError(t, err)
+	assert.Equal(t, `{"dashboard":1}`, string(data))
+	data, err = reader.ReadFile(t.Context(), folder)
+	require.NoError(t, err)
+	assert.Equal(t, `{"folder":1}`, string(data))
+	exists, err := reader.Exists(t.Context(), stale)
+	require.NoError(t, err)
+	assert.False(t, exists, "removed files aren't copied forward")
+
+	// the latest snapshot is complete, pruning the previous one loses nothing
+	_, err = reader.Prune(t.Context(), RetentionPolicy{Last: 1}, false)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(root, snapshotFolder, latest, "org_main-org/dashboards/General/a.json"))
+	assert.NoDirExists(t, filepath.Join(root, snapshotFolder, "20250101T000000Z"))
+}
+
+func TestSnapshotStorageWithoutSnapshot(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.json"), []byte(`{"a":1}`), 0o600))
+	reader := newTestSnapshotStorage(t, map[string]string{SnapshotRoot: root})
+	data, err := reader.ReadFile(t.Context(), filepath.Join(root, "a.json"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(data))
+}
+
+func TestSnapshotStorageInvalidName(t *testing.T) {
+	ctx := context.WithValue(context.Background(), Context, map[string]string{SnapshotRoot: t.TempDir(), SnapshotName: "yesterday"})
+	_, err := NewSnapshotStorage(ctx, NewLocalStorage(ctx))
+	assert.ErrorContains(t, err, "invalid snapshot name")
+
+	ctx = context.WithValue(context.Background(), Context, map[string]string{SnapshotRoot: t.TempDir(), SnapshotName: "2025-01-01T00:00:00Z"})
+	_, err = NewSnapshotStorage(ctx, NewLocalStorage(ctx))
+	assert.ErrorContains(t, err, "invalid snapshot name")
+}
+
+func TestRetainedSnapshots(t *testing.T) {
+	snapshots := []string{
+		"20250115T100000Z",
+		"20250220T100000Z",
+		"20250301T100000Z",
+		"20250302T100000Z",
+		"20250310T080000Z",
+		"20250310T100000Z",
+	}
+	keep := retainedSnapshots(snapshots, RetentionPolicy{Daily: 2})
+	assert.Equal(t, map[string]bool{"20250310T100000Z": true, "20250302T100000Z": true}, keep)
+	keep = retainedSnapshots(snapshots, RetentionPolicy{Monthly: 2})
+	assert.Equal(t, map[string]bool{"20250310T100000Z": true, "20250220T100000Z": true}, keep)
+	keep = retainedSnapshots(snapshots, RetentionPolicy{Last: 1, Weekly: 2})
+	assert.Equal(t, map[string]bool{"20250310T100000Z": true, "20250302T100000Z": true}, keep)
+}
+
+func TestSnapshotStoragePrune(t *testing.T) {
+	root := t.TempDir()
+	engine := newTestSnapshotStorage(t, map[string]string{SnapshotRoot: root})
+	for _, name := range []string{"20250101T000000Z", "20250102T000000Z", "20250103T000000Z"} {
+		require.NoError(t, engine.Storage.WriteFile(t.Context(), filepath.Join(root, snapshotFolder, name, "a.json"), []byte(`{}`)))
+	}
+	require.NoError(t, engine.Storage.WriteFile(t.Context(), filepath.Join(root, snapshotFolder, snapshotLatest), []byte("20250101T000000Z")))
+
+	_, err := engine.Prune(t.Context(), RetentionPolicy{}, false)
+	assert.Error(t, err)
+
+	results, err := engine.Prune(t.Context(), RetentionPolicy{Last: 1}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []SnapshotResult{
+		{Name: "20250103T000000Z", Status: snapshotKept},
+		{Name: "20250102T000000Z", Status: snapshotPending},
+		{Name: "20250101T000000Z", Status: snapshotKept},
+	}, results)
+	assert.DirExists(t, filepath.Join(root, snapshotFolder, "20250102T000000Z"))
+
+	_, err = engine.Prune(t.Context(), RetentionPolicy{Last: 1}, false)
+	require.NoError(t, err)
+	assert.NoDirExists(t, filepath.Join(root, snapshotFolder, "20250102T000000Z"))
+	snapshots, err := engine.ListSnapshots(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20250101T000000Z", "20250103T000000Z"}, snapshots)
+}
@@ -96,7 +96,7 @@ func (t *templateImpl) Generate(templateName string) (map[string][]string, error
 				slog.Any("data", outputEntity.TemplateData),
 			)
 			grafana.OrganizationName = outputEntity.OrganizationName
-			outputPath := service.BuildResourceFolder(t.gdgCfg, outputEntity.Folder, resourceTypes.DashboardResource, true, false)
+			outputPath := service.BuildResourceFolder(t.gdgCfg, outputEntity.Folder, resourceTypes.DashboardResource, true, nil)
 			// Merge two maps.
 			tmpl, tmplErr := template.New("").Funcs(fns).Parse(string(templateData))
 			if tmplErr != nil {
@@ -105,7 +105,7 @@ func (t *templateImpl) Generate(templateName string) (map[string][]string, error
 			}
 
 			// Create new file.
-			tools.CreateDestinationPath("", false, outputPath)
+			tools.CreateDestinationPath("", nil, outputPath)
 			dashboardName := entity.TemplateName
 			if outputEntity.DashboardName != "" {
 				dashboardName = service.GetSlug(outputEntity.DashboardName)
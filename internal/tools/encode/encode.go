@@ -1,7 +1,7 @@
 package encode
 
 import (
-	"log"
+	"log/slog"
 	"net/url"
 	"os"
 	"regexp"
@@ -53,10 +53,12 @@ func DecodeEscapeSpecialChars(s string) string {
 	return Decode(s)
 }
 
+// Decode reverses Encode, a string that isn't a valid encoding is returned as is
 func Decode(s string) string {
 	res, err := url.QueryUnescape(s)
 	if err != nil {
-		log.Fatal("unable to decode string", "input", s)
+		slog.Warn("unable to decode string, using it as is", "input", s, "err", err)
+		return s
 	}
 	return res
 }
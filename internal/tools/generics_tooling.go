@@ -2,7 +2,6 @@ package tools
 
 import (
 	"encoding/json"
-	"log"
 	"log/slog"
 	"os"
 	"sync"
@@ -23,15 +22,17 @@ func DeepCopy[T any](value T) (*T, error) {
 	return clone, nil
 }
 
-var syncMap = new(sync.Map)
+// ClearedFolders records the folders removed by CreateDestinationPath, the zero value is ready to use
+type ClearedFolders struct {
+	folders sync.Map
+}
 
-// CreateDestinationPath Handle osMkdir Errors
-func CreateDestinationPath(folderName string, clearOutput bool, v string) {
-	if clearOutput {
+// CreateDestinationPath Handle osMkdir Errors.  When cleared is set, folderName is removed first unless it was already
+// cleared, nil keeps the previous backup.
+func CreateDestinationPath(folderName string, cleared *ClearedFolders, v string) {
+	if cleared != nil {
 		// ensure the folder is only removed once.  This prevents valid data from being removed.
-		_, ok := syncMap.Load(folderName)
-		if !ok {
-			syncMap.Store(folderName, true)
+		if _, loaded := cleared.folders.LoadOrStore(folderName, true); !loaded {
 			clearBackup := os.RemoveAll(folderName)
 			if clearBackup != nil {
 				slog.Warn("Unable to remove previous backup at location", "location", v)
@@ -39,8 +40,9 @@ func CreateDestinationPath(folderName string, clearOutput bool, v string) {
 		}
 	}
 
+	// failures are reported by the storage engine once the files are written
 	err := os.MkdirAll(v, 0o750)
 	if err != nil {
-		log.Fatalf("unable to create path %s, err: %s", v, err.Error())
+		slog.Warn("unable to create path", "location", v, "err", err)
 	}
 }
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -31,8 +32,11 @@ func (v VersionRange) Validate() bool {
 // InRange returns true if the current grafana version in within all of the ranges
 // specified.  Falls if it is not.
 func InRange(ranges []VersionRange, api GetVersion) bool {
-	versionCheck := api.GetServerInfo()
-	currentVersion := fmt.Sprintf("v%s", versionCheck[VersionKey].(string))
+	currentVersion, err := serverVersion(api)
+	if err != nil {
+		slog.Warn("Unable to determine the Grafana version", "err", err)
+		return false
+	}
 	valid := true
 	for _, entry := range ranges {
 		if !entry.Validate() {
@@ -55,7 +59,20 @@ func InRange(ranges []VersionRange, api GetVersion) bool {
 }
 
 type GetVersion interface {
-	GetServerInfo() map[string]any
+	GetServerInfo() (map[string]any, error)
+}
+
+// serverVersion returns the semver formatted version of the Grafana server
+func serverVersion(api GetVersion) (string, error) {
+	versionCheck, err := api.GetServerInfo()
+	if err != nil {
+		return "", err
+	}
+	version, ok := versionCheck[VersionKey].(string)
+	if !ok {
+		return "", errors.New("grafana server did not report a version")
+	}
+	return fmt.Sprintf("v%s", version), nil
 }
 
 func ValidateMinimumVersion(minVersion string, api GetVersion) bool {
@@ -64,8 +81,11 @@ func ValidateMinimumVersion(minVersion string, api GetVersion) bool {
 		return false
 
 	}
-	versionCheck := api.GetServerInfo()
-	currentVersion := fmt.Sprintf("v%s", versionCheck[VersionKey].(string))
+	currentVersion, err := serverVersion(api)
+	if err != nil {
+		slog.Warn("Unable to determine the Grafana version", "err", err)
+		return false
+	}
 
 	return semver.Compare(currentVersion, minVersion) != -1
 }
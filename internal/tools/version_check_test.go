@@ -10,8 +10,8 @@ type moo struct {
 	s string
 }
 
-func (v moo) GetServerInfo() map[string]any {
-	return map[string]any{"Version": v.s}
+func (v moo) GetServerInfo() (map[string]any, error) {
+	return map[string]any{"Version": v.s}, nil
 }
 
 func TestGrafanaRange(t *testing.T) {
@@ -25,6 +25,8 @@ const (
 	SecureSecretsResource        ResourceType = "secure"
 	AlertingResource             ResourceType = "alerting"
 	AlertingRulesResource        ResourceType = "alerting-rules"
+	RoleResource                 ResourceType = "roles"
+	SSOResource                  ResourceType = "sso"
 )
 
 var orgNamespacedResource = map[ResourceType]bool{
@@ -38,6 +40,7 @@ var orgNamespacedResource = map[ResourceType]bool{
 	TeamResource:                 true,
 	AlertingResource:             true,
 	AlertingRulesResource:        true,
+	RoleResource:                 true,
 }
 
 // isNamespaced returns true if the resource type is namespaced
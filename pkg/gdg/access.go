@@ -0,0 +1,117 @@
+package gdg
+
+import (
+	"context"
+
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+// ListDashboardPermissions returns the dashboards matching the filter along with their permissions
+func (c *Client) ListDashboardPermissions(ctx context.Context, filter *Filter) ([]DashboardPermissions, error) {
+	return invoke(ctx, c, filter, c.defaultDashboardFilter, (*service.DashNGoImpl).ListDashboardPermissions)
+}
+
+// DownloadDashboardPermissions saves the permissions of the dashboards matching the filter to the storage engine and
+// returns their paths
+func (c *Client) DownloadDashboardPermissions(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultDashboardFilter, (*service.DashNGoImpl).DownloadDashboardPermissions)
+}
+
+// UploadDashboardPermissions uploads the stored permissions of the dashboards matching the filter and returns their
+// names
+func (c *Client) UploadDashboardPermissions(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultDashboardFilter, (*service.DashNGoImpl).UploadDashboardPermissions)
+}
+
+// ClearDashboardPermissions removes the permissions of the dashboards matching the filter
+func (c *Client) ClearDashboardPermissions(ctx context.Context, filter *Filter) error {
+	_, err := invoke(ctx, c, filter, c.defaultDashboardFilter, func(svc *service.DashNGoImpl, f filters.V2Filter) (any, error) {
+		return nil, svc.ClearDashboardPermissions(f)
+	})
+	return err
+}
+
+// ListFolderPermissions returns the folders matching the filter along with their permissions
+func (c *Client) ListFolderPermissions(ctx context.Context, filter *Filter) (map[*NestedHit][]*models.DashboardACLInfoDTO, error) {
+	return invoke(ctx, c, filter, c.defaultFolderFilter, (*service.DashNGoImpl).ListFolderPermissions)
+}
+
+// DownloadFolderPermissions saves the permissions of the folders matching the filter to the storage engine and returns
+// their paths
+func (c *Client) DownloadFolderPermissions(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultFolderFilter, (*service.DashNGoImpl).DownloadFolderPermissions)
+}
+
+// UploadFolderPermissions uploads the stored permissions of the folders matching the filter and returns their names
+func (c *Client) UploadFolderPermissions(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultFolderFilter, (*service.DashNGoImpl).UploadFolderPermissions)
+}
+
+// ListConnectionPermissions returns the connections matching the filter along with their permissions, Grafana
+// Enterprise is required
+func (c *Client) ListConnectionPermissions(ctx context.Context, filter *Filter) ([]ConnectionPermissions, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).ListConnectionPermissions)
+}
+
+// DownloadConnectionPermissions saves the permissions of the connections matching the filter to the storage engine and
+// returns their paths, Grafana Enterprise is required
+func (c *Client) DownloadConnectionPermissions(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).DownloadConnectionPermissions)
+}
+
+// UploadConnectionPermissions uploads the stored permissions of the connections matching the filter and returns their
+// names, Grafana Enterprise is required
+func (c *Client) UploadConnectionPermissions(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).UploadConnectionPermissions)
+}
+
+// DeleteAllConnectionPermissions removes the permissions of the connections matching the filter and returns their
+// names, Grafana Enterprise is required
+func (c *Client) DeleteAllConnectionPermissions(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).DeleteAllConnectionPermissions)
+}
+
+// ListRoles returns the custom roles matching the filter along with their assignments, Grafana Enterprise is required
+func (c *Client) ListRoles(ctx context.Context, filter *Filter) ([]*Role, error) {
+	return invoke(ctx, c, filter, defaultRoleFilter, (*service.DashNGoImpl).ListRoles)
+}
+
+// DownloadRoles saves the custom roles matching the filter to the storage engine and returns their paths, Grafana
+// Enterprise is required
+func (c *Client) DownloadRoles(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultRoleFilter, (*service.DashNGoImpl).DownloadRoles)
+}
+
+// UploadRoles uploads the stored custom roles matching the filter and returns their names, Grafana Enterprise is
+// required
+func (c *Client) UploadRoles(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultRoleFilter, (*service.DashNGoImpl).UploadRoles)
+}
+
+// DeleteAllRoles deletes the custom roles matching the filter and returns their names, Grafana Enterprise is required
+func (c *Client) DeleteAllRoles(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultRoleFilter, (*service.DashNGoImpl).DeleteAllRoles)
+}
+
+// ListSSOSettings returns the settings of every SSO provider
+func (c *Client) ListSSOSettings(ctx context.Context) ([]*models.ListAllProvidersSettingsOKBodyItems, error) {
+	return call(ctx, c, (*service.DashNGoImpl).ListSSOSettings)
+}
+
+// DownloadSSOSettings saves the settings of every SSO provider to the storage engine and returns their paths
+func (c *Client) DownloadSSOSettings(ctx context.Context) ([]string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).DownloadSSOSettings)
+}
+
+// UploadSSOSettings uploads the stored SSO provider settings and returns their paths.  Grafana doesn't return the
+// secrets of the providers, they are read from the secure folder of the output path on the local filesystem.
+func (c *Client) UploadSSOSettings(ctx context.Context) ([]string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).UploadSSOSettings)
+}
+
+// DiffSSOSettings compares the stored SSO provider settings with the current ones, redacted secrets are ignored
+func (c *Client) DiffSSOSettings(ctx context.Context) ([]SSOSettingsDiff, error) {
+	return call(ctx, c, (*service.DashNGoImpl).DiffSSOSettings)
+}
@@ -0,0 +1,94 @@
+package gdg
+
+import (
+	"context"
+
+	"github.com/esnet/gdg/internal/service"
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+// ListContactPoints returns the alerting contact points
+func (c *Client) ListContactPoints(ctx context.Context) ([]*models.EmbeddedContactPoint, error) {
+	return call(ctx, c, (*service.DashNGoImpl).ListContactPoints)
+}
+
+// DownloadContactPoints saves the alerting contact points to the storage engine and returns the path
+func (c *Client) DownloadContactPoints(ctx context.Context) (string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).DownloadContactPoints)
+}
+
+// UploadContactPoints uploads the stored alerting contact points and returns their names
+func (c *Client) UploadContactPoints(ctx context.Context) ([]string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).UploadContactPoints)
+}
+
+// ClearContactPoints deletes the alerting contact points and returns their names
+func (c *Client) ClearContactPoints(ctx context.Context) ([]string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).ClearContactPoints)
+}
+
+// ListAlertTemplates returns the alerting notification templates
+func (c *Client) ListAlertTemplates(ctx context.Context) ([]*models.NotificationTemplate, error) {
+	return call(ctx, c, (*service.DashNGoImpl).ListAlertTemplates)
+}
+
+// DownloadAlertTemplates saves the alerting notification templates to the storage engine and returns the path
+func (c *Client) DownloadAlertTemplates(ctx context.Context) (string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).DownloadAlertTemplates)
+}
+
+// UploadAlertTemplates uploads the stored alerting notification templates and returns their names
+func (c *Client) UploadAlertTemplates(ctx context.Context) ([]string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).UploadAlertTemplates)
+}
+
+// ClearAlertTemplates deletes the alerting notification templates and returns their names
+func (c *Client) ClearAlertTemplates(ctx context.Context) ([]string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).ClearAlertTemplates)
+}
+
+// ListAlertNotifications returns the alerting notification policy tree
+func (c *Client) ListAlertNotifications(ctx context.Context) (*models.Route, error) {
+	return call(ctx, c, (*service.DashNGoImpl).ListAlertNotifications)
+}
+
+// DownloadAlertNotifications saves the alerting notification policy tree to the storage engine and returns the path
+func (c *Client) DownloadAlertNotifications(ctx context.Context) (string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).DownloadAlertNotifications)
+}
+
+// UploadAlertNotifications uploads the stored alerting notification policy tree and returns it
+func (c *Client) UploadAlertNotifications(ctx context.Context) (*models.Route, error) {
+	return call(ctx, c, (*service.DashNGoImpl).UploadAlertNotifications)
+}
+
+// ClearAlertNotifications resets the alerting notification policy tree
+func (c *Client) ClearAlertNotifications(ctx context.Context) error {
+	_, err := call(ctx, c, func(svc *service.DashNGoImpl) (any, error) {
+		return nil, svc.ClearAlertNotifications()
+	})
+	return err
+}
+
+// ListAlertTimings returns the alerting mute timings
+func (c *Client) ListAlertTimings(ctx context.Context) ([]*models.MuteTimeInterval, error) {
+	return call(ctx, c, (*service.DashNGoImpl).ListAlertTimings)
+}
+
+// DownloadAlertTimings saves the alerting mute timings to the storage engine and returns the path
+func (c *Client) DownloadAlertTimings(ctx context.Context) (string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).DownloadAlertTimings)
+}
+
+// UploadAlertTimings uploads the stored alerting mute timings and returns their names
+func (c *Client) UploadAlertTimings(ctx context.Context) ([]string, error) {
+	return call(ctx, c, (*service.DashNGoImpl).UploadAlertTimings)
+}
+
+// ClearAlertTimings deletes the alerting mute timings
+func (c *Client) ClearAlertTimings(ctx context.Context) error {
+	_, err := call(ctx, c, func(svc *service.DashNGoImpl) (any, error) {
+		return nil, svc.ClearAlertTimings()
+	})
+	return err
+}
@@ -0,0 +1,177 @@
+// Package gdg exposes the gdg resource management as a Go library, allowing Grafana backups to be downloaded, uploaded
+// and cleared without shelling out to the CLI.
+//
+// A client manages the organization of its configuration, switching organizations as the CLI does isn't supported,
+// one client per organization is used instead.  Service accounts and organization memberships aren't exposed yet.
+package gdg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/filters"
+	customModels "github.com/esnet/gdg/pkg/service/domain"
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+type (
+	// NestedHit a dashboard or folder along with its nested folder path
+	NestedHit = customModels.NestedHit
+	// LibraryElement a library element along with its nested folder path
+	LibraryElement = customModels.WithNested[models.LibraryElementDTO]
+	// AlertRule an alert rule along with its nested folder path
+	AlertRule = customModels.AlertRuleWithNestedFolder
+	// Organization an organization along with its preferences
+	Organization = customModels.OrgsDTOWithPreferences
+	// UserProfile an uploaded user along with its generated password
+	UserProfile = customModels.UserProfileWithAuth
+	// DashboardPermissions a dashboard along with its permissions
+	DashboardPermissions = customModels.DashboardAndPermissions
+	// ConnectionPermissions a connection along with its permissions
+	ConnectionPermissions = customModels.ConnectionPermissionItem
+	// Role a custom role along with the users, teams and service accounts it is assigned to
+	Role = customModels.RoleWithAssignments
+	// SSOSettingsDiff differences between the stored and the current settings of an SSO provider
+	SSOSettingsDiff = customModels.SSOSettingsDiff
+	// EntityResult outcome of an entity processed by the client
+	EntityResult = customModels.EntityResult
+)
+
+// Entity result statuses
+const (
+	ResultSucceeded = customModels.ResultSucceeded
+	ResultSkipped   = customModels.ResultSkipped
+	ResultFailed    = customModels.ResultFailed
+)
+
+// Option customizes the client returned by New
+type Option func(*options)
+
+type options struct {
+	storage Storage
+}
+
+// WithStorage sets the storage engine backups are read from and written to, the local filesystem is used by default.
+func WithStorage(s Storage) Option {
+	return func(o *options) {
+		o.storage = s
+	}
+}
+
+// Client manages the resources of a single Grafana instance.  Every call is bound to the context it is given, calls
+// don't affect the context of one another.
+type Client struct {
+	cfg *domain.GDGAppConfiguration
+	svc *service.DashNGoImpl
+}
+
+// New returns a client logged into the Grafana instance of the configuration, ctx bounds the login requests.
+func New(ctx context.Context, cfg Config, opts ...Option) (*Client, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.storage == nil {
+		o.storage = NewLocalStorage()
+	}
+	appCfg, err := cfg.appConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	svc, err := service.NewGrafanaService(ctx, appCfg, o.storage)
+	if err != nil {
+		return nil, err
+	}
+	// ctx only bounds the login, calls are bound to the context they are given
+	return &Client{cfg: appCfg, svc: svc.WithContext(context.Background())}, nil
+}
+
+// Results returns the outcome of every entity processed since the last call to ResetResults
+func (c *Client) Results() []EntityResult {
+	return c.svc.Results()
+}
+
+// ResetResults discards the recorded entity results
+func (c *Client) ResetResults() {
+	c.svc.ResetResults()
+}
+
+// ServerInfo returns the health information of the Grafana instance
+func (c *Client) ServerInfo(ctx context.Context) (map[string]any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.svc.WithContext(ctx).GetServerInfo()
+}
+
+// call calls fn with a copy of the service bound to ctx, no request is issued when ctx is already done
+func call[T any](ctx context.Context, c *Client, fn func(*service.DashNGoImpl) (T, error)) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var empty T
+		return empty, err
+	}
+	return fn(c.svc.WithContext(ctx))
+}
+
+// invoke calls fn with a copy of the service bound to ctx and the filter, or the default filter of the resource when
+// it is nil.
+func invoke[T any](ctx context.Context, c *Client, filter *Filter, defaultFilter func(*service.DashNGoImpl) (*Filter, error), fn func(*service.DashNGoImpl, filters.V2Filter) (T, error)) (T, error) {
+	return call(ctx, c, func(svc *service.DashNGoImpl) (T, error) {
+		if filter == nil {
+			var err error
+			if filter, err = defaultFilter(svc); err != nil {
+				var empty T
+				return empty, err
+			}
+		}
+		return fn(svc, filter.filter)
+	})
+}
+
+// defaultConnectionFilter matches every connection
+func defaultConnectionFilter(*service.DashNGoImpl) (*Filter, error) {
+	return ConnectionFilter("")
+}
+
+// defaultUserFilter matches every user
+func defaultUserFilter(*service.DashNGoImpl) (*Filter, error) {
+	return UserFilter("")
+}
+
+// defaultTeamFilter matches every team
+func defaultTeamFilter(*service.DashNGoImpl) (*Filter, error) {
+	return TeamFilter("")
+}
+
+// defaultOrganizationFilter matches every organization
+func defaultOrganizationFilter(*service.DashNGoImpl) (*Filter, error) {
+	return OrganizationFilter("")
+}
+
+// defaultRoleFilter matches every role
+func defaultRoleFilter(*service.DashNGoImpl) (*Filter, error) {
+	return RoleFilter("")
+}
+
+// defaultDashboardFilter matches every dashboard of the watched folders
+func (c *Client) defaultDashboardFilter(*service.DashNGoImpl) (*Filter, error) {
+	return c.DashboardFilter("", "")
+}
+
+// defaultFolderFilter matches the watched folders
+func (c *Client) defaultFolderFilter(*service.DashNGoImpl) (*Filter, error) {
+	return c.FolderFilter()
+}
+
+// defaultLibraryElementFilter matches the library elements of the watched folders
+func (c *Client) defaultLibraryElementFilter(*service.DashNGoImpl) (*Filter, error) {
+	return c.LibraryElementFilter()
+}
+
+// defaultAlertRuleFilter matches the alert rules of the watched folders, the folders of the rules are looked up with
+// the given service
+func (c *Client) defaultAlertRuleFilter(svc *service.DashNGoImpl) (*Filter, error) {
+	return newFilter(service.NewAlertRuleFilter(c.cfg, svc))
+}
@@ -0,0 +1,76 @@
+package gdg
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigAppConfiguration(t *testing.T) {
+	_, err := Config{}.appConfiguration()
+	assert.Error(t, err)
+
+	appCfg, err := Config{
+		Context:        "Staging",
+		URL:            "http://grafana:3000",
+		UserName:       "admin",
+		Password:       "secret",
+		WatchedFolders: []string{"Ops"},
+		RetryCount:     3,
+	}.appConfiguration()
+	require.NoError(t, err)
+	assert.Equal(t, "staging", appCfg.GetContext())
+	grafanaConf := appCfg.GetDefaultGrafanaConfig()
+	assert.Equal(t, "http://grafana:3000", grafanaConf.URL)
+	assert.Equal(t, "secret", grafanaConf.GetPassword())
+	assert.True(t, grafanaConf.IsBasicAuth())
+	assert.Equal(t, []string{"Ops"}, grafanaConf.GetMonitoredFolders(false))
+	assert.Equal(t, 3, appCfg.GetAppGlobals().RetryCount)
+	assert.False(t, appCfg.IgnoreSSL())
+}
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"database":"ok","version":"12.0.0"}`))
+	}))
+	defer server.Close()
+
+	loginCtx, loginCancel := context.WithCancel(t.Context())
+	client, err := New(loginCtx, Config{URL: server.URL, APIToken: "token"}, WithStorage(NewMemoryStorage()))
+	require.NoError(t, err)
+	loginCancel()
+
+	info, err := client.ServerInfo(t.Context())
+	require.NoError(t, err, "calls aren't bound to the login context")
+	assert.Equal(t, "12.0.0", info["Version"])
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+			_, err := client.ServerInfo(ctx)
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = client.ListDashboards(ctx, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	filter, err := RoleFilter("Editors")
+	require.NoError(t, err)
+	_, err = client.ListRoles(ctx, filter)
+	assert.ErrorIs(t, err, context.Canceled)
+	_, err = client.ListContactPoints(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, client.Results())
+}
@@ -0,0 +1,130 @@
+package gdg
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"github.com/gosimple/slug"
+)
+
+// defaultContext name of the context used when none is configured
+const defaultContext = "default"
+
+type (
+	// ConnectionSettings filters and credential rules applied to connections
+	ConnectionSettings = domain.ConnectionSettings
+	// MatchingRule matches a field of an entity against a regular expression
+	MatchingRule = domain.MatchingRule
+	// RegexMatchesList rules selecting the secure data of the connections they match
+	RegexMatchesList = domain.RegexMatchesList
+	// UserSettings settings applied to uploaded users
+	UserSettings = domain.UserSettings
+	// TLSSettings CA bundle, client certificate and server name used to reach Grafana
+	TLSSettings = domain.TLSSettings
+	// ProxySettings HTTP proxy used to reach Grafana
+	ProxySettings = domain.ProxySettings
+	// AuthSettings token provider, OAuth2 client credentials or command, used instead of static credentials
+	AuthSettings = domain.AuthSettings
+	// OAuth2Settings client credentials flow settings
+	OAuth2Settings = domain.OAuth2Settings
+	// ExecSettings command printing a token
+	ExecSettings = domain.ExecSettings
+)
+
+// Config is the programmatic configuration of a Client, it replaces the gdg configuration file.  The Grafana
+// credentials are taken from the configuration instead of the secure location.
+type Config struct {
+	// Context name of the context, used as the archive and manifest name.  Defaults to "default"
+	Context string
+	// URL of the Grafana instance
+	URL string
+	// UserName and Password are used for basic auth, Grafana admin features require them
+	UserName string
+	Password string
+	// APIToken takes precedence over basic auth when set
+	APIToken string
+	// Auth obtains short-lived tokens from a token provider, see AuthSettings
+	Auth *AuthSettings
+	// ClientSecret secret of the OAuth2 client
+	ClientSecret string
+	// OrganizationName of the organization to manage, the default organization is used when empty
+	OrganizationName string
+	// OutputPath location of the backup within the storage engine
+	OutputPath string
+	// WatchedFolders folders managed by the client, defaults to the General folder
+	WatchedFolders []string
+	// IgnoreDashboardFilters manages every dashboard regardless of the watched folders
+	IgnoreDashboardFilters bool
+	// Connections filters and credential rules applied to connections
+	Connections *ConnectionSettings
+	// Users settings applied to uploaded users
+	Users *UserSettings
+	// IgnoreSSLErrors disables the validation of the Grafana certificate, highly discouraged in production
+	IgnoreSSLErrors bool
+	// TLS custom CA bundle, mTLS client certificate and server name
+	TLS *TLSSettings
+	// Proxy HTTP proxy the requests are sent through, the environment proxy settings are used when nil
+	Proxy *ProxySettings
+	// Headers static headers sent with every request, `env:` and `file:` values are resolved
+	Headers map[string]string
+	// RetryCount number of times a failed request is retried, requests aren't retried when 0
+	RetryCount int
+	// RetryDelay delay before the first retry, doubled for every following one.  Defaults to 100ms
+	RetryDelay time.Duration
+	// RateLimit maximum number of requests per second sent to Grafana, unlimited when 0
+	RateLimit float64
+	// RequestTimeout time allowed for a single request including its retries, defaults to 30s.  The run time of a
+	// resource method is bounded by its context.
+	RequestTimeout time.Duration
+	// APIDebug logs every API request
+	APIDebug bool
+}
+
+// appConfiguration converts the configuration into the one used by the service layer
+func (c Config) appConfiguration() (*domain.GDGAppConfiguration, error) {
+	if c.URL == "" {
+		return nil, errors.New("a Grafana URL is required")
+	}
+	name := c.Context
+	if name == "" {
+		name = defaultContext
+	}
+	name = strings.ToLower(name)
+	grafanaConf := domain.NewGrafanaConfig(slug.Make(name))
+	grafanaConf.URL = c.URL
+	grafanaConf.UserName = c.UserName
+	grafanaConf.OrganizationName = c.OrganizationName
+	grafanaConf.OutputPath = c.OutputPath
+	grafanaConf.MonitoredFolders = c.WatchedFolders
+	grafanaConf.DashboardSettings = &domain.DashboardSettings{IgnoreFilters: c.IgnoreDashboardFilters}
+	grafanaConf.ConnectionSettings = c.Connections
+	grafanaConf.UserSettings = c.Users
+	grafanaConf.RateLimit = c.RateLimit
+	grafanaConf.TLS = c.TLS
+	grafanaConf.Proxy = c.Proxy
+	grafanaConf.Headers = c.Headers
+	// credentials are always set so that the secure location is never read
+	grafanaConf.SetSecureAuth(domain.SecureModel{Password: c.Password, Token: c.APIToken, ClientSecret: c.ClientSecret})
+	grafanaConf.Auth = c.Auth
+
+	globals := &domain.AppGlobals{
+		ApiDebug:        c.APIDebug,
+		IgnoreSSLErrors: c.IgnoreSSLErrors,
+		RetryCount:      c.RetryCount,
+	}
+	if c.RetryDelay > 0 {
+		globals.RetryDelay = c.RetryDelay.String()
+	}
+	if c.RequestTimeout > 0 {
+		globals.RequestTimeout = c.RequestTimeout.String()
+	}
+
+	return &domain.GDGAppConfiguration{
+		ContextName:  name,
+		Contexts:     map[string]*domain.GrafanaConfig{name: grafanaConf},
+		Global:       globals,
+		PluginConfig: domain.PluginConfig{Disabled: true},
+	}, nil
+}
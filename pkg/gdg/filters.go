@@ -0,0 +1,77 @@
+package gdg
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/filters"
+)
+
+// Filter selects the entities a resource method operates on, it is built using the filter function of the resource.
+// Every resource method accepts a nil Filter, in which case the default filter of the resource is used.
+type Filter struct {
+	filter filters.V2Filter
+}
+
+// newFilter wraps the filter returned by a service constructor
+func newFilter(filter filters.V2Filter, err error) (*Filter, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{filter: filter}, nil
+}
+
+// DashboardFilter returns a filter matching the dashboards of the watched folders, optionally restricted to a folder,
+// a dashboard slug and dashboards having every tag.
+func (c *Client) DashboardFilter(folder, dashboard string, tags ...string) (*Filter, error) {
+	var tagsFilter string
+	if len(tags) > 0 {
+		raw, err := json.Marshal(tags)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode dashboard tags: %w", err)
+		}
+		tagsFilter = string(raw)
+	}
+	return newFilter(service.NewDashboardFilter(c.cfg, folder, dashboard, tagsFilter))
+}
+
+// FolderFilter returns a filter matching the watched folders
+func (c *Client) FolderFilter() (*Filter, error) {
+	return newFilter(service.NewFolderFilter(c.cfg))
+}
+
+// LibraryElementFilter returns a filter matching the library elements of the watched folders
+func (c *Client) LibraryElementFilter() (*Filter, error) {
+	return newFilter(service.NewLibraryElementFilter(c.cfg))
+}
+
+// AlertRuleFilter returns a filter matching the alert rules of the watched folders
+func (c *Client) AlertRuleFilter() (*Filter, error) {
+	return newFilter(service.NewAlertRuleFilter(c.cfg, c.svc))
+}
+
+// ConnectionFilter returns a filter matching the connection with the given slug, every connection when empty
+func ConnectionFilter(name string) (*Filter, error) {
+	return newFilter(service.NewConnectionFilter(name))
+}
+
+// UserFilter returns a filter matching the users with the given auth label, every user when empty
+func UserFilter(authLabel string) (*Filter, error) {
+	return newFilter(service.NewUserFilter(authLabel))
+}
+
+// TeamFilter returns a filter matching the team with the given name, every team when empty
+func TeamFilter(name string) (*Filter, error) {
+	return newFilter(service.NewTeamFilter(name))
+}
+
+// OrganizationFilter returns a filter matching the organization with the given name, every organization when empty
+func OrganizationFilter(name string) (*Filter, error) {
+	return newFilter(service.NewOrganizationFilter(name))
+}
+
+// RoleFilter returns a filter matching the role with the given name, every role when empty
+func RoleFilter(name string) (*Filter, error) {
+	return newFilter(service.NewRoleFilter(name))
+}
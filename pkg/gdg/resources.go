@@ -0,0 +1,177 @@
+package gdg
+
+import (
+	"context"
+
+	"github.com/esnet/gdg/internal/service"
+	"github.com/esnet/gdg/internal/service/filters"
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+// ListDashboards returns the dashboards matching the filter
+func (c *Client) ListDashboards(ctx context.Context, filter *Filter) ([]*NestedHit, error) {
+	return invoke(ctx, c, filter, c.defaultDashboardFilter, (*service.DashNGoImpl).ListDashboards)
+}
+
+// DownloadDashboards saves the dashboards matching the filter to the storage engine and returns their paths
+func (c *Client) DownloadDashboards(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultDashboardFilter, (*service.DashNGoImpl).DownloadDashboards)
+}
+
+// UploadDashboards uploads the stored dashboards matching the filter and returns their names
+func (c *Client) UploadDashboards(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultDashboardFilter, (*service.DashNGoImpl).UploadDashboards)
+}
+
+// DeleteAllDashboards deletes the dashboards matching the filter and returns their names
+func (c *Client) DeleteAllDashboards(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultDashboardFilter, (*service.DashNGoImpl).DeleteAllDashboards)
+}
+
+// ListFolders returns the folders matching the filter
+func (c *Client) ListFolders(ctx context.Context, filter *Filter) ([]*NestedHit, error) {
+	return invoke(ctx, c, filter, c.defaultFolderFilter, (*service.DashNGoImpl).ListFolders)
+}
+
+// DownloadFolders saves the folders matching the filter to the storage engine and returns their paths
+func (c *Client) DownloadFolders(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultFolderFilter, (*service.DashNGoImpl).DownloadFolders)
+}
+
+// UploadFolders uploads the stored folders matching the filter and returns their names
+func (c *Client) UploadFolders(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultFolderFilter, (*service.DashNGoImpl).UploadFolders)
+}
+
+// DeleteAllFolders deletes the folders matching the filter and returns their names
+func (c *Client) DeleteAllFolders(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultFolderFilter, (*service.DashNGoImpl).DeleteAllFolders)
+}
+
+// ListConnections returns the connections matching the filter
+func (c *Client) ListConnections(ctx context.Context, filter *Filter) ([]models.DataSourceListItemDTO, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).ListConnections)
+}
+
+// DownloadConnections saves the connections matching the filter to the storage engine and returns their paths
+func (c *Client) DownloadConnections(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).DownloadConnections)
+}
+
+// UploadConnections uploads the stored connections matching the filter and returns their names
+func (c *Client) UploadConnections(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).UploadConnections)
+}
+
+// DeleteAllConnections deletes the connections matching the filter and returns their names
+func (c *Client) DeleteAllConnections(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultConnectionFilter, (*service.DashNGoImpl).DeleteAllConnections)
+}
+
+// ListLibraryElements returns the library elements matching the filter
+func (c *Client) ListLibraryElements(ctx context.Context, filter *Filter) ([]*LibraryElement, error) {
+	return invoke(ctx, c, filter, c.defaultLibraryElementFilter, (*service.DashNGoImpl).ListLibraryElements)
+}
+
+// DownloadLibraryElements saves the library elements matching the filter to the storage engine and returns their paths
+func (c *Client) DownloadLibraryElements(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultLibraryElementFilter, (*service.DashNGoImpl).DownloadLibraryElements)
+}
+
+// UploadLibraryElements uploads the stored library elements matching the filter and returns their names
+func (c *Client) UploadLibraryElements(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultLibraryElementFilter, (*service.DashNGoImpl).UploadLibraryElements)
+}
+
+// DeleteAllLibraryElements deletes the library elements matching the filter and returns their names
+func (c *Client) DeleteAllLibraryElements(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultLibraryElementFilter, (*service.DashNGoImpl).DeleteAllLibraryElements)
+}
+
+// ListAlertRules returns the alert rules matching the filter
+func (c *Client) ListAlertRules(ctx context.Context, filter *Filter) ([]*AlertRule, error) {
+	return invoke(ctx, c, filter, c.defaultAlertRuleFilter, (*service.DashNGoImpl).ListAlertRules)
+}
+
+// DownloadAlertRules saves the alert rules matching the filter to the storage engine and returns their paths
+func (c *Client) DownloadAlertRules(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultAlertRuleFilter, (*service.DashNGoImpl).DownloadAlertRules)
+}
+
+// UploadAlertRules uploads the stored alert rules matching the filter
+func (c *Client) UploadAlertRules(ctx context.Context, filter *Filter) error {
+	_, err := invoke(ctx, c, filter, c.defaultAlertRuleFilter, func(svc *service.DashNGoImpl, f filters.V2Filter) (any, error) {
+		return nil, svc.UploadAlertRules(f)
+	})
+	return err
+}
+
+// ClearAlertRules deletes the alert rules matching the filter and returns their names
+func (c *Client) ClearAlertRules(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, c.defaultAlertRuleFilter, (*service.DashNGoImpl).ClearAlertRules)
+}
+
+// ListUsers returns the users matching the filter, basic auth is required
+func (c *Client) ListUsers(ctx context.Context, filter *Filter) ([]*models.UserSearchHitDTO, error) {
+	return invoke(ctx, c, filter, defaultUserFilter, (*service.DashNGoImpl).ListUsers)
+}
+
+// DownloadUsers saves the users matching the filter to the storage engine and returns their paths
+func (c *Client) DownloadUsers(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultUserFilter, (*service.DashNGoImpl).DownloadUsers)
+}
+
+// UploadUsers uploads the stored users matching the filter and returns their profiles
+func (c *Client) UploadUsers(ctx context.Context, filter *Filter) ([]UserProfile, error) {
+	return invoke(ctx, c, filter, defaultUserFilter, (*service.DashNGoImpl).UploadUsers)
+}
+
+// DeleteAllUsers deletes the users matching the filter and returns their logins
+func (c *Client) DeleteAllUsers(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultUserFilter, (*service.DashNGoImpl).DeleteAllUsers)
+}
+
+// ListTeams returns the teams matching the filter along with their members
+func (c *Client) ListTeams(ctx context.Context, filter *Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
+	return invoke(ctx, c, filter, defaultTeamFilter, (*service.DashNGoImpl).ListTeams)
+}
+
+// DownloadTeams saves the teams matching the filter to the storage engine and returns them along with their members
+func (c *Client) DownloadTeams(ctx context.Context, filter *Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
+	return invoke(ctx, c, filter, defaultTeamFilter, (*service.DashNGoImpl).DownloadTeams)
+}
+
+// UploadTeams uploads the stored teams matching the filter and returns them along with their members
+func (c *Client) UploadTeams(ctx context.Context, filter *Filter) (map[*models.TeamDTO][]*models.TeamMemberDTO, error) {
+	return invoke(ctx, c, filter, defaultTeamFilter, (*service.DashNGoImpl).UploadTeams)
+}
+
+// DeleteTeams deletes the teams matching the filter and returns them
+func (c *Client) DeleteTeams(ctx context.Context, filter *Filter) ([]*models.TeamDTO, error) {
+	return invoke(ctx, c, filter, defaultTeamFilter, (*service.DashNGoImpl).DeleteTeam)
+}
+
+// ListOrganizations returns the organizations matching the filter, along with their preferences when requested
+func (c *Client) ListOrganizations(ctx context.Context, filter *Filter, withPreferences bool) ([]*Organization, error) {
+	return invoke(ctx, c, filter, defaultOrganizationFilter, func(svc *service.DashNGoImpl, f filters.V2Filter) ([]*Organization, error) {
+		return svc.ListOrganizations(f, withPreferences)
+	})
+}
+
+// DownloadOrganizations saves the organizations matching the filter to the storage engine and returns their paths
+func (c *Client) DownloadOrganizations(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultOrganizationFilter, (*service.DashNGoImpl).DownloadOrganizations)
+}
+
+// UploadOrganizations uploads the stored organizations matching the filter and returns their names.  Renames maps
+// the stored name of an organization to the name it is uploaded as.
+func (c *Client) UploadOrganizations(ctx context.Context, filter *Filter, renames map[string]string) ([]string, error) {
+	return invoke(ctx, c, filter, defaultOrganizationFilter, func(svc *service.DashNGoImpl, f filters.V2Filter) ([]string, error) {
+		return svc.UploadOrganizations(f, renames)
+	})
+}
+
+// DeleteAllOrganizations deletes the organizations matching the filter and returns their names
+func (c *Client) DeleteAllOrganizations(ctx context.Context, filter *Filter) ([]string, error) {
+	return invoke(ctx, c, filter, defaultOrganizationFilter, (*service.DashNGoImpl).DeleteAllOrganizations)
+}
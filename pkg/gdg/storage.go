@@ -0,0 +1,33 @@
+package gdg
+
+import (
+	"context"
+
+	"github.com/esnet/gdg/internal/storage"
+	"github.com/esnet/gdg/pkg/plugins/storage/contract"
+)
+
+// FileInfo describes a stored file
+type FileInfo = contract.FileInfo
+
+// Storage is the engine backups are read from and written to, any implementation may be supplied using WithStorage.
+type Storage interface {
+	WriteFile(ctx context.Context, filename string, data []byte) error                // WriteFile returns error or writes byte array to destination
+	ReadFile(ctx context.Context, filename string) ([]byte, error)                    // ReadFile returns byte array or error with data from file
+	FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error) // FindAllFiles recursively list all files for a given path
+	Delete(ctx context.Context, name string) error                                    // Delete removes the file, or the folder and every file it contains
+	Stat(ctx context.Context, filename string) (*FileInfo, error)                     // Stat returns the size, modification time and checksum of the file
+	Exists(ctx context.Context, filename string) (bool, error)                        // Exists returns true if the file exists
+	Name() string                                                                     // Name of storage engine
+	GetPrefix() string                                                                // Prefix used by storage engine
+}
+
+// NewLocalStorage returns a storage engine writing to the local filesystem, it is used when no storage is given
+func NewLocalStorage() Storage {
+	return storage.NewLocalStorage(context.Background())
+}
+
+// NewMemoryStorage returns a storage engine keeping every file in memory, nothing is persisted once it is discarded.
+func NewMemoryStorage() Storage {
+	return storage.NewMemoryStorage()
+}
@@ -2,10 +2,9 @@ package secure
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"log/slog"
-	"os"
 	"strings"
 
 	"github.com/esnet/gdg/internal/config/domain"
@@ -162,7 +161,7 @@ func (p PluginCipherEncoder) Decode(resourceType resourceTypes.ResourceType, b [
 // NewPluginCipherEncoder creates a CipherEncoder that uses a WebAssembly plugin to encode and decode values.
 // It initializes the plugin from either a file path or URL, processes configuration,
 // and returns a PluginCipherEncoder ready for use.
-func NewPluginCipherEncoder(plugCfg *domain.PluginEntity, secureFields map[string][]string) contract.CipherEncoder {
+func NewPluginCipherEncoder(plugCfg *domain.PluginEntity, secureFields map[string][]string) (contract.CipherEncoder, error) {
 	o := &PluginCipherEncoder{
 		cfg:          plugCfg,
 		secureFields: secureFields,
@@ -173,7 +172,7 @@ func NewPluginCipherEncoder(plugCfg *domain.PluginEntity, secureFields map[strin
 	} else if plugCfg.Url != "" {
 		wasmInt = extism.WasmUrl{Url: plugCfg.Url}
 	} else {
-		log.Fatal("plugin configuration is invalid. No Url or file path was found")
+		return nil, errors.New("plugin configuration is invalid. No Url or file path was found")
 	}
 
 	manifest := extism.Manifest{
@@ -189,10 +188,9 @@ func NewPluginCipherEncoder(plugCfg *domain.PluginEntity, secureFields map[strin
 	}
 	plugin, err := extism.NewPlugin(ctx, manifest, config, []extism.HostFunction{})
 	if err != nil {
-		fmt.Printf("Failed to initialize plugin: %v\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("failed to initialize cipher plugin: %w", err)
 	}
 
 	o.wasmExec = plugin
-	return o
+	return o, nil
 }
@@ -0,0 +1,59 @@
+package contract
+
+import "time"
+
+// Operations exported by a storage plugin.  Every operation receives a JSON encoded Request, failures are reported
+// by returning a non-zero exit code and setting the error message.
+const (
+	// WriteOperation writes Request.Data to Request.Path, creating any missing folder.
+	WriteOperation = "Write"
+	// ReadOperation returns the raw content of Request.Path.
+	ReadOperation = "Read"
+	// ListOperation returns a JSON encoded ListResponse with every file found under the Request.Path folder.
+	ListOperation = "List"
+	// DeleteOperation removes the file, or the folder and everything it contains.  Missing files are ignored.
+	DeleteOperation = "Delete"
+	// StatOperation is optional and returns a JSON encoded StatResponse.
+	StatOperation = "Stat"
+)
+
+// Request input of every operation
+type Request struct {
+	Path string `json:"path"`
+	Data []byte `json:"data,omitempty"` // only set for Write, base64 encoded in JSON
+}
+
+// ListResponse output of List, files are returned with the requested folder as prefix
+type ListResponse struct {
+	Files []string `json:"files"`
+}
+
+// Algorithms of the checksums reported by Stat.  Only checksums computed using the same algorithm can be compared.
+const (
+	// ChecksumSHA256 hex encoded SHA-256 digest of the content
+	ChecksumSHA256 = "sha256"
+	// ChecksumMD5 hex encoded MD5 digest of the content
+	ChecksumMD5 = "md5"
+	// ChecksumETag ETag of a cloud object, it only identifies a version of that object
+	ChecksumETag = "etag"
+)
+
+// StatResponse output of Stat, Exists is false when the file is missing.  Algorithm defaults to ChecksumSHA256.
+type StatResponse struct {
+	Exists    bool      `json:"exists"`
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"modTime"`
+	Checksum  string    `json:"checksum"`
+	Algorithm string    `json:"algorithm,omitempty"`
+}
+
+// FileInfo describes a stored file, as returned by the Stat of a storage engine.  Checksum is computed using
+// Algorithm: SHA-256 of the content for local files, archives and plugins, the MD5, or the ETag when the provider
+// doesn't expose it, for cloud objects.
+type FileInfo struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"modTime"`
+	Checksum  string    `json:"checksum"`
+	Algorithm string    `json:"algorithm"`
+}
@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	gdgStorage "github.com/esnet/gdg/internal/storage"
+	"github.com/esnet/gdg/pkg/plugins/storage/contract"
+	extism "github.com/extism/go-sdk"
+)
+
+// PluginStorage storage engine delegating every operation to a WebAssembly plugin implementing the storage contract.
+type PluginStorage struct {
+	mu       sync.Mutex // a plugin instance can't be called concurrently
+	wasmExec *extism.Plugin
+}
+
+// call invokes the plugin operation with the JSON encoded request and returns its output
+func (p *PluginStorage) call(ctx context.Context, operation string, req contract.Request) ([]byte, error) {
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	exit, out, err := p.wasmExec.CallWithContext(ctx, operation, input)
+	if err != nil {
+		return nil, fmt.Errorf("storage plugin %s failed for %s: %w", operation, req.Path, err)
+	}
+	if exit != 0 {
+		return nil, fmt.Errorf("storage plugin %s returned non-zero exit code for %s", operation, req.Path)
+	}
+	return out, nil
+}
+
+// WriteFile writes the data using the plugin
+func (p *PluginStorage) WriteFile(ctx context.Context, filename string, data []byte) error {
+	_, err := p.call(ctx, contract.WriteOperation, contract.Request{Path: filename, Data: data})
+	return err
+}
+
+// ReadFile reads the file using the plugin
+func (p *PluginStorage) ReadFile(ctx context.Context, filename string) ([]byte, error) {
+	return p.call(ctx, contract.ReadOperation, contract.Request{Path: filename})
+}
+
+// FindAllFiles recursively lists the files of the folder using the plugin
+func (p *PluginStorage) FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error) {
+	out, err := p.call(ctx, contract.ListOperation, contract.Request{Path: folder})
+	if err != nil {
+		return nil, err
+	}
+	var resp contract.ListResponse
+	if err = json.Unmarshal(out, &resp); err != nil {
+		return nil, fmt.Errorf("invalid response from storage plugin: %w", err)
+	}
+	if fullPath {
+		return resp.Files, nil
+	}
+	fileList := make([]string, 0, len(resp.Files))
+	for _, file := range resp.Files {
+		fileList = append(fileList, filepath.Base(file))
+	}
+	return fileList, nil
+}
+
+// Delete removes the file or folder using the plugin
+func (p *PluginStorage) Delete(ctx context.Context, name string) error {
+	_, err := p.call(ctx, contract.DeleteOperation, contract.Request{Path: name})
+	return err
+}
+
+// Stat describes the file, plugins that don't implement Stat have the content read to compute its SHA-256 checksum
+func (p *PluginStorage) Stat(ctx context.Context, filename string) (*gdgStorage.FileInfo, error) {
+	if !p.wasmExec.FunctionExists(contract.StatOperation) {
+		data, err := p.ReadFile(ctx, filename)
+		if err != nil {
+			return nil, err
+		}
+		checksum := sha256.Sum256(data)
+		return &gdgStorage.FileInfo{Name: filename, Size: int64(len(data)), Checksum: hex.EncodeToString(checksum[:]), Algorithm: contract.ChecksumSHA256}, nil
+	}
+	resp, err := p.stat(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Exists {
+		return nil, fmt.Errorf("%s does not exist", filename)
+	}
+	return &gdgStorage.FileInfo{
+		Name:      filename,
+		Size:      resp.Size,
+		ModTime:   resp.ModTime,
+		Checksum:  resp.Checksum,
+		Algorithm: cmp.Or(resp.Algorithm, contract.ChecksumSHA256),
+	}, nil
+}
+
+// Exists returns true if the file exists, plugins that don't implement Stat report missing files as read failures
+func (p *PluginStorage) Exists(ctx context.Context, filename string) (bool, error) {
+	if !p.wasmExec.FunctionExists(contract.StatOperation) {
+		_, err := p.ReadFile(ctx, filename)
+		return err == nil, nil
+	}
+	resp, err := p.stat(ctx, filename)
+	if err != nil {
+		return false, err
+	}
+	return resp.Exists, nil
+}
+
+func (p *PluginStorage) stat(ctx context.Context, filename string) (*contract.StatResponse, error) {
+	out, err := p.call(ctx, contract.StatOperation, contract.Request{Path: filename})
+	if err != nil {
+		return nil, err
+	}
+	resp := &contract.StatResponse{}
+	if err = json.Unmarshal(out, resp); err != nil {
+		return nil, fmt.Errorf("invalid response from storage plugin: %w", err)
+	}
+	return resp, nil
+}
+
+// Name returns the storage engine name
+func (p *PluginStorage) Name() string {
+	return gdgStorage.PluginStorageType.String()
+}
+
+// GetPrefix plugins manage their own layout, no prefix is used
+func (p *PluginStorage) GetPrefix() string {
+	return ""
+}
+
+// Close releases the plugin
+func (p *PluginStorage) Close(ctx context.Context) error {
+	return p.wasmExec.Close(ctx)
+}
+
+// LoadPluginFile creates a storage engine backed by the WebAssembly plugin file, config is passed to the plugin and the
+// host folders of allowedPaths mounted into it.  It allows plugin authors to load their plugin outside of gdg.
+func LoadPluginFile(ctx context.Context, wasmFile string, config, allowedPaths map[string]string) (*PluginStorage, error) {
+	engine, err := NewPluginStorage(ctx, &domain.PluginEntity{FilePath: wasmFile, PluginConfig: config, AllowedPaths: allowedPaths})
+	if err != nil {
+		return nil, err
+	}
+	return engine.(*PluginStorage), nil
+}
+
+// NewPluginStorage creates a storage engine backed by the WebAssembly plugin, loaded from either a file path or URL.
+// Folders listed in allowed_paths are mounted into the plugin.
+func NewPluginStorage(ctx context.Context, plugCfg *domain.PluginEntity) (gdgStorage.Storage, error) {
+	if plugCfg == nil {
+		return nil, errors.New("no storage plugin is configured")
+	}
+	var wasmInt extism.Wasm
+	if plugCfg.FilePath != "" {
+		wasmInt = extism.WasmFile{Path: plugCfg.FilePath}
+	} else if plugCfg.Url != "" {
+		wasmInt = extism.WasmUrl{Url: plugCfg.Url}
+	} else {
+		return nil, errors.New("plugin configuration is invalid. No Url or file path was found")
+	}
+
+	manifest := extism.Manifest{
+		Wasm: []extism.Wasm{
+			wasmInt,
+		},
+		Config:       plugCfg.GetPluginConfig(),
+		AllowedPaths: plugCfg.AllowedPaths,
+	}
+	config := extism.PluginConfig{
+		EnableWasi: true,
+	}
+	plugin, err := extism.NewPlugin(ctx, manifest, config, []extism.HostFunction{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize storage plugin: %w", err)
+	}
+	for _, operation := range []string{contract.WriteOperation, contract.ReadOperation, contract.ListOperation, contract.DeleteOperation} {
+		if !plugin.FunctionExists(operation) {
+			_ = plugin.Close(ctx)
+			return nil, fmt.Errorf("storage plugin does not implement the %s operation", operation)
+		}
+	}
+	return &PluginStorage{wasmExec: plugin}, nil
+}
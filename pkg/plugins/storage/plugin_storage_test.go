@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/esnet/gdg/internal/config/domain"
+	"github.com/esnet/gdg/pkg/plugins/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildReferencePlugin compiles the reference plugin to WebAssembly
+func buildReferencePlugin(t *testing.T) string {
+	t.Helper()
+	wasmFile := filepath.Join(t.TempDir(), "storage_local.wasm")
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", wasmFile, ".")
+	cmd.Dir = "reference"
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return wasmFile
+}
+
+func TestReferencePlugin(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping plugin build in short mode")
+	}
+	dataDir := t.TempDir()
+	engine, err := LoadPluginFile(t.Context(), buildReferencePlugin(t), map[string]string{"root": "/backups"},
+		map[string]string{dataDir: "/backups"})
+	require.NoError(t, err)
+	defer engine.Close(t.Context())
+
+	require.NoError(t, engine.WriteFile(t.Context(), "test/data/connections/prometheus.json", []byte(`{}`)))
+	assert.FileExists(t, filepath.Join(dataDir, "test/data/connections/prometheus.json"))
+	require.NoError(t, engine.Delete(t.Context(), "test/data"))
+
+	storagetest.RunContractTests(t, engine, "test/data")
+}
+
+func TestNewPluginStorageInvalid(t *testing.T) {
+	_, err := NewPluginStorage(t.Context(), nil)
+	assert.Error(t, err)
+	_, err = NewPluginStorage(t.Context(), &domain.PluginEntity{})
+	assert.ErrorContains(t, err, "No Url or file path")
+}
@@ -0,0 +1,3 @@
+module github.com/esnet/gdg/pkg/plugins/storage/reference
+
+go 1.25.5
@@ -0,0 +1,212 @@
+//go:build wasip1
+
+// Reference storage plugin storing backups in a folder of the host.  The folder is mounted into the plugin using the
+// `allowed_paths` setting of the plugin and its guest path is read from the `root` config value, `/data` by default.
+//
+// Build with: GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o storage_local.wasm .
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// request, listResponse and statResponse mirror github.com/esnet/gdg/pkg/plugins/storage/contract
+
+type request struct {
+	Path string `json:"path"`
+	Data []byte `json:"data,omitempty"`
+}
+
+type listResponse struct {
+	Files []string `json:"files"`
+}
+
+type statResponse struct {
+	Exists    bool      `json:"exists"`
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"modTime"`
+	Checksum  string    `json:"checksum"`
+	Algorithm string    `json:"algorithm"`
+}
+
+//go:wasmimport extism:host/env input_length
+func extismInputLength() uint64
+
+//go:wasmimport extism:host/env input_load_u8
+func extismInputLoadU8(offset uint64) uint32
+
+//go:wasmimport extism:host/env alloc
+func extismAlloc(length uint64) uint64
+
+//go:wasmimport extism:host/env store_u8
+func extismStoreU8(offset uint64, value uint32)
+
+//go:wasmimport extism:host/env load_u8
+func extismLoadU8(offset uint64) uint32
+
+//go:wasmimport extism:host/env length
+func extismLength(offset uint64) uint64
+
+//go:wasmimport extism:host/env output_set
+func extismOutputSet(offset, length uint64)
+
+//go:wasmimport extism:host/env error_set
+func extismErrorSet(offset uint64)
+
+//go:wasmimport extism:host/env config_get
+func extismConfigGet(offset uint64) uint64
+
+func input() []byte {
+	length := extismInputLength()
+	data := make([]byte, length)
+	for i := range length {
+		data[i] = byte(extismInputLoadU8(i))
+	}
+	return data
+}
+
+// store copies the data into the extism memory and returns its offset
+func store(data []byte) uint64 {
+	offset := extismAlloc(uint64(len(data)))
+	for i, b := range data {
+		extismStoreU8(offset+uint64(i), uint32(b))
+	}
+	return offset
+}
+
+func output(data []byte) int32 {
+	extismOutputSet(store(data), uint64(len(data)))
+	return 0
+}
+
+func fail(err error) int32 {
+	extismErrorSet(store([]byte(err.Error())))
+	return 1
+}
+
+func config(key, defaultValue string) string {
+	offset := extismConfigGet(store([]byte(key)))
+	if offset == 0 {
+		return defaultValue
+	}
+	data := make([]byte, extismLength(offset))
+	for i := range data {
+		data[i] = byte(extismLoadU8(offset + uint64(i)))
+	}
+	return string(data)
+}
+
+// parse decodes the request and returns the location of the file inside the root folder
+func parse() (request, string, error) {
+	var req request
+	if err := json.Unmarshal(input(), &req); err != nil {
+		return req, "", fmt.Errorf("invalid request: %w", err)
+	}
+	rel := path.Clean("/" + filepath.ToSlash(req.Path))
+	return req, path.Join(config("root", "/data"), rel), nil
+}
+
+//go:wasmexport Write
+func Write() int32 {
+	req, location, err := parse()
+	if err != nil {
+		return fail(err)
+	}
+	if err = os.MkdirAll(path.Dir(location), 0o750); err != nil {
+		return fail(err)
+	}
+	if err = os.WriteFile(location, req.Data, 0o600); err != nil {
+		return fail(err)
+	}
+	return 0
+}
+
+//go:wasmexport Read
+func Read() int32 {
+	_, location, err := parse()
+	if err != nil {
+		return fail(err)
+	}
+	data, err := os.ReadFile(location)
+	if err != nil {
+		return fail(err)
+	}
+	return output(data)
+}
+
+//go:wasmexport List
+func List() int32 {
+	req, location, err := parse()
+	if err != nil {
+		return fail(err)
+	}
+	resp := listResponse{Files: []string{}}
+	err = filepath.WalkDir(location, func(file string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel := strings.TrimPrefix(file, location+"/")
+		resp.Files = append(resp.Files, path.Join(req.Path, rel))
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fail(err)
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fail(err)
+	}
+	return output(data)
+}
+
+//go:wasmexport Delete
+func Delete() int32 {
+	_, location, err := parse()
+	if err != nil {
+		return fail(err)
+	}
+	if err = os.RemoveAll(location); err != nil {
+		return fail(err)
+	}
+	return 0
+}
+
+//go:wasmexport Stat
+func Stat() int32 {
+	_, location, err := parse()
+	if err != nil {
+		return fail(err)
+	}
+	var resp statResponse
+	info, err := os.Stat(location)
+	if err == nil && !info.IsDir() {
+		data, readErr := os.ReadFile(location)
+		if readErr != nil {
+			return fail(readErr)
+		}
+		checksum := sha256.Sum256(data)
+		resp = statResponse{Exists: true, Size: info.Size(), ModTime: info.ModTime(), Checksum: hex.EncodeToString(checksum[:]), Algorithm: "sha256"}
+	} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fail(err)
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fail(err)
+	}
+	return output(data)
+}
+
+func main() {}
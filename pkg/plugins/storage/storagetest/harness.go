@@ -0,0 +1,84 @@
+// Package storagetest provides a conformance suite for storage engines, storage plugin authors can run it against
+// their plugin loaded with storage.NewPluginStorage.
+package storagetest
+
+import (
+	"context"
+	"path"
+	"slices"
+	"testing"
+
+	"github.com/esnet/gdg/pkg/plugins/storage/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Engine the operations of a storage engine covered by the conformance suite, every gdg storage engine implements it
+type Engine interface {
+	WriteFile(ctx context.Context, filename string, data []byte) error
+	ReadFile(ctx context.Context, filename string) ([]byte, error)
+	FindAllFiles(ctx context.Context, folder string, fullPath bool) ([]string, error)
+	Delete(ctx context.Context, name string) error
+	Stat(ctx context.Context, filename string) (*contract.FileInfo, error)
+	Exists(ctx context.Context, filename string) (bool, error)
+}
+
+// RunContractTests exercises every operation of the storage engine using files created under folder, which is expected
+// to be empty.
+func RunContractTests(t *testing.T, engine Engine, folder string) {
+	t.Helper()
+	ctx := t.Context()
+	dashboard := path.Join(folder, "org_main-org/dashboards/General/a.json")
+	user := path.Join(folder, "users/bob.json")
+
+	t.Run("WriteRead", func(t *testing.T) {
+		require.NoError(t, engine.WriteFile(ctx, dashboard, []byte(`{"a":1}`)))
+		require.NoError(t, engine.WriteFile(ctx, user, []byte(`{"login":"bob"}`)))
+		data, err := engine.ReadFile(ctx, dashboard)
+		require.NoError(t, err)
+		assert.Equal(t, `{"a":1}`, string(data))
+		// overwrite
+		require.NoError(t, engine.WriteFile(ctx, dashboard, []byte(`{"a":2}`)))
+		data, err = engine.ReadFile(ctx, dashboard)
+		require.NoError(t, err)
+		assert.Equal(t, `{"a":2}`, string(data))
+		_, err = engine.ReadFile(ctx, path.Join(folder, "missing.json"))
+		assert.Error(t, err)
+	})
+
+	t.Run("FindAllFiles", func(t *testing.T) {
+		files, err := engine.FindAllFiles(ctx, folder, true)
+		require.NoError(t, err)
+		slices.Sort(files)
+		assert.Equal(t, []string{dashboard, user}, files)
+		files, err = engine.FindAllFiles(ctx, path.Join(folder, "users"), false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bob.json"}, files)
+	})
+
+	t.Run("ExistsStat", func(t *testing.T) {
+		exists, err := engine.Exists(ctx, user)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		exists, err = engine.Exists(ctx, path.Join(folder, "missing.json"))
+		require.NoError(t, err)
+		assert.False(t, exists)
+		info, err := engine.Stat(ctx, user)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(`{"login":"bob"}`)), info.Size)
+		assert.NotEmpty(t, info.Checksum)
+		assert.NotEmpty(t, info.Algorithm)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, engine.Delete(ctx, user))
+		exists, err := engine.Exists(ctx, user)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		require.NoError(t, engine.Delete(ctx, user), "deleting a missing file should succeed")
+		require.NoError(t, engine.Delete(ctx, path.Join(folder, "org_main-org")))
+		files, err := engine.FindAllFiles(ctx, folder, true)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
@@ -0,0 +1,12 @@
+package storagetest
+
+import (
+	"testing"
+
+	gdgStorage "github.com/esnet/gdg/internal/storage"
+)
+
+func TestLocalStorageContract(t *testing.T) {
+	t.Chdir(t.TempDir())
+	RunContractTests(t, gdgStorage.NewLocalStorage(t.Context()), "test/data")
+}
@@ -28,10 +28,18 @@ type UserProfileWithAuth struct {
 	Password string
 }
 
+// UserWithPreferences extends the user entry with the user's own preferences and starred dashboards, referenced by UID.
+type UserWithPreferences struct {
+	models.UserSearchHitDTO
+	Preferences       *models.PreferencesSpec `json:"preferences,omitempty"`
+	StarredDashboards []string                `json:"starredDashboards,omitempty"`
+}
+
 // OrgsDTOWithPreferences represents an organization and its preferences.
 type OrgsDTOWithPreferences struct {
 	Organization *models.OrgDTO          `json:"organization"`
 	Preferences  *models.PreferencesSpec `json:"preferences"` // Preferences are preferences associated with a given org.  theme, dashboard, timezone, etc
+	Quotas       []*models.QuotaDTO      `json:"quotas,omitempty"`
 }
 
 // ConnectionPermissionItem holds a connection and its associated permissions.
@@ -46,6 +54,28 @@ type DashboardAndPermissions struct {
 	Permissions []*models.DashboardACLInfoDTO
 }
 
+// RoleWithAssignments holds a custom RBAC role and the principals it is assigned to.  Assignments are recorded by
+// user login, team name and service account login so they can be restored onto a different instance.
+type RoleWithAssignments struct {
+	Role        *models.RoleDTO `json:"role"`
+	Assignments RoleAssignments `json:"assignments"`
+}
+
+// RoleAssignments lists the principals a role is assigned to.
+type RoleAssignments struct {
+	Users           []string `json:"users"`
+	Teams           []string `json:"teams"`
+	ServiceAccounts []string `json:"service_accounts"`
+}
+
+// SSOSettingsDiff describes how the local copy of an SSO provider's settings differs from the grafana instance.
+type SSOSettingsDiff struct {
+	Provider string   `json:"provider"`
+	Status   string   `json:"status"`
+	Fields   []string `json:"fields"`
+}
+
+// AlertRuleWithNestedFolder holds an alert rule and the nested path of its folder.
 type AlertRuleWithNestedFolder struct {
 	*models.ProvisionedAlertRule
 	NestedPath string
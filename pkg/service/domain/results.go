@@ -0,0 +1,16 @@
+package domain
+
+// Outcomes of processing a single entity
+const (
+	ResultSucceeded = "succeeded"
+	ResultSkipped   = "skipped"
+	ResultFailed    = "failed"
+)
+
+// EntityResult the outcome of processing a single entity, reason explains why it was skipped or failed
+type EntityResult struct {
+	Resource string `json:"resource"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Reason   string `json:"reason,omitempty"`
+}
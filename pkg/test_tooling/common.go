@@ -118,7 +118,8 @@ func CreateSimpleClientWithConfig(t *testing.T, cfg *domain.GDGAppConfiguration,
 
 	storageEngine, err := service.ConfigureStorage(cfg)
 	assert.NoError(t, err)
-	client := service.NewTestApiService(storageEngine, cfg)
+	client, err := service.NewTestApiService(storageEngine, cfg)
+	assert.NoError(t, err)
 	currentPath, _ := os.Getwd()
 	if strings.Contains(currentPath, "test") {
 		pathErr := os.Chdir("..")
@@ -154,7 +155,8 @@ func CreateSimpleClient(t *testing.T, cfg *domain.GDGAppConfiguration, cfgName *
 	assert.Equal(t, contextName, "testing")
 	storageEngine, err := service.ConfigureStorage(cfg)
 	assert.NoError(t, err)
-	client := service.NewTestApiService(storageEngine, nil)
+	client, err := service.NewTestApiService(storageEngine, nil)
+	assert.NoError(t, err)
 	currentPath, _ := os.Getwd()
 	if strings.Contains(currentPath, "test") {
 		err := os.Chdir("..")
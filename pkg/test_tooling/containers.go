@@ -81,7 +81,10 @@ func SetupCloudFunctionOpt(cfgObj *domain.GDGAppConfiguration, opts ...CloudTest
 		log.Fatalf("Could not instantiate cloud storage for type: %s", m[storage.CloudType])
 	}
 
-	apiClient := service.NewTestApiService(s, cfgObj)
+	apiClient, err := service.NewTestApiService(s, cfgObj)
+	if err != nil {
+		return ctx, cancel, nil, s, err
+	}
 
 	return ctx, cancel, apiClient, s, nil
 }
@@ -18,7 +18,10 @@ const (
 func InitOrganizations(t *testing.T, cfg *domain.GDGAppConfiguration) (testcontainers.Container, func() error) {
 	props := containers.DefaultGrafanaEnv()
 	r := InitTest(t, cfg, props)
-	newOrgs := r.ApiClient.UploadOrganizations(service.NewOrganizationFilter())
+	filter, err := service.NewOrganizationFilter()
+	assert.NoError(t, err)
+	newOrgs, err := r.ApiClient.UploadOrganizations(filter, nil)
+	assert.NoError(t, err)
 	assert.Equal(t, 4, len(newOrgs))
 	return r.Container, r.CleanUp
 }
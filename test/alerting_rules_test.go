@@ -6,7 +6,7 @@ import (
 	"os"
 	"testing"
 
-	customModels "github.com/esnet/gdg/internal/service/domain"
+	customModels "github.com/esnet/gdg/pkg/service/domain"
 	"github.com/esnet/gdg/internal/service/filters"
 	"github.com/esnet/gdg/internal/tools/ptr"
 	"github.com/samber/lo"
@@ -39,18 +39,23 @@ func TestAlertingRulesCrud(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	slog.Info("Uploading Connections")
-	conn := apiClient.UploadConnections(service.NewConnectionFilter(""))
+	filter, err := service.NewConnectionFilter("")
+	assert.NoError(t, err)
+	conn, err := apiClient.UploadConnections(filter)
+	assert.NoError(t, err)
 	assert.True(t, len(conn) > 0)
 	//
 	slog.Info("Creating Folders")
-	folders := apiClient.UploadFolders(nil)
+	folders, err := apiClient.UploadFolders(nil)
+	assert.NoError(t, err)
 	assert.True(t, len(folders) > 0)
 	//
 	slog.Info("Uploading Connections")
 	_, err = apiClient.UploadContactPoints()
 	assert.NoError(t, err)
 
-	alertFilters := service.NewAlertRuleFilter(cfg, apiClient)
+	alertFilters, err := service.NewAlertRuleFilter(cfg, apiClient)
+	assert.NoError(t, err)
 	rulesList, err := apiClient.ListAlertRules(alertFilters)
 	assert.NoError(t, err)
 	assert.Equal(t, len(rulesList), 0, "Validate initial rules list is empty")
@@ -96,11 +101,15 @@ func TestAlertingRulesNoFilterCrud(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	slog.Info("Uploading Connections")
-	conn := apiClient.UploadConnections(service.NewConnectionFilter(""))
+	filter, err := service.NewConnectionFilter("")
+	assert.NoError(t, err)
+	conn, err := apiClient.UploadConnections(filter)
+	assert.NoError(t, err)
 	assert.True(t, len(conn) > 0)
 	//
 	slog.Info("Creating Folders")
-	folders := apiClient.UploadFolders(nil)
+	folders, err := apiClient.UploadFolders(nil)
+	assert.NoError(t, err)
 	assert.True(t, len(folders) > 0)
 	//
 	slog.Info("Uploading Contact Points")
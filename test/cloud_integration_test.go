@@ -38,11 +38,13 @@ func TestCloudDataSourceCRUD(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	// Wipe all data from grafana
-	dsFilter := service.NewConnectionFilter("")
+	dsFilter, err := service.NewConnectionFilter("")
+	assert.NoError(t, err)
 	apiClient.DeleteAllConnections(dsFilter)
 
 	apiClient.UploadConnections(dsFilter)
-	dsList := apiClient.ListConnections(dsFilter)
+	dsList, err := apiClient.ListConnections(dsFilter)
+	assert.NoError(t, err)
 	assert.True(t, len(dsList) > 0)
 	_, cancel, apiClient, s, err := test_tooling.SetupCloudFunctionOpt(
 		cfg,
@@ -53,16 +55,20 @@ func TestCloudDataSourceCRUD(t *testing.T) {
 	defer cancel()
 
 	slog.Info("Importing DataSources")
-	dsStringList := apiClient.DownloadConnections(dsFilter) // Saving to S3
+	dsStringList, err := apiClient.DownloadConnections(dsFilter) // Saving to S3
+	assert.NoError(t, err)
 	assert.Equal(t, len(dsList), len(dsStringList))
 	slog.Info("Deleting DataSources")
-	deleteDSList := apiClient.DeleteAllConnections(dsFilter) // Cleaning up Grafana
+	deleteDSList, err := apiClient.DeleteAllConnections(dsFilter) // Cleaning up Grafana
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteDSList), len(dsStringList))
-	dsList = apiClient.ListConnections(dsFilter)
+	dsList, err = apiClient.ListConnections(dsFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(dsList), 0)
 	// Load Data from S3
 	apiClient.UploadConnections(dsFilter) // Load data from S3
-	dsList = apiClient.ListConnections(dsFilter)
+	dsList, err = apiClient.ListConnections(dsFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(dsList), len(dsStringList))
 	apiClient.DeleteAllConnections(dsFilter) // Cleaning up Grafana
 }
@@ -93,11 +99,13 @@ func TestDashboardCloudCRUD(t *testing.T) {
 	apiClient := r.ApiClient
 	// defer cleanup, "Failed to cleanup test containers for %s", t.Name())
 	// Wipe all data from grafana
-	dashFilter := service.NewDashboardFilter(cfg, "", "", "")
+	dashFilter, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
 	apiClient.DeleteAllDashboards(dashFilter)
 	// Load data into grafana
 	apiClient.UploadDashboards(dashFilter)
-	boards := apiClient.ListDashboards(dashFilter)
+	boards, err := apiClient.ListDashboards(dashFilter)
+	assert.NoError(t, err)
 	assert.True(t, len(boards) > 0)
 	var cancel context.CancelFunc
 
@@ -111,18 +119,22 @@ func TestDashboardCloudCRUD(t *testing.T) {
 
 	// At this point all operations are reading/writing from Minio
 	slog.Info("Importing Dashboards")
-	list := apiClient.DownloadDashboards(dashFilter) // Saving to S3
+	list, err := apiClient.DownloadDashboards(dashFilter) // Saving to S3
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(boards))
 	slog.Info("Deleting Dashboards") // Clearing Grafana
-	deleteList := apiClient.DeleteAllDashboards(dashFilter)
+	deleteList, err := apiClient.DeleteAllDashboards(dashFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(deleteList))
-	boards = apiClient.ListDashboards(dashFilter)
+	boards, err = apiClient.ListDashboards(dashFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(boards), 0)
 	// Load Data from S3
 	_, err = apiClient.UploadDashboards(dashFilter) // ReLoad data from S3 backup
 	assert.NoError(t, err)
-	boards = apiClient.ListDashboards(dashFilter) // Read data
-	assert.Equal(t, len(list), len(boards))       // verify
+	boards, err = apiClient.ListDashboards(dashFilter) // Read data
+	assert.NoError(t, err)
+	assert.Equal(t, len(list), len(boards)) // verify
 	apiClient.DeleteAllDashboards(dashFilter)
 }
 
@@ -151,12 +163,14 @@ func TestDashboardCloudLeadingSlashCRUD(t *testing.T) {
 	apiClient := r.ApiClient
 	// defer cleanup, "Failed to clean up test containers for %s", t.Name())
 	// Wipe all data from grafana
-	dashFilter := service.NewDashboardFilter(cfg, "", "", "")
+	dashFilter, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
 	apiClient.DeleteAllDashboards(dashFilter)
 	// Load data into grafana
 	_, err = apiClient.UploadDashboards(dashFilter)
 	assert.NoError(t, err)
-	boards := apiClient.ListDashboards(dashFilter)
+	boards, err := apiClient.ListDashboards(dashFilter)
+	assert.NoError(t, err)
 	assert.True(t, len(boards) > 0)
 
 	// Tests all type of combination that can potential break things for cloud + test output config
@@ -227,19 +241,23 @@ func TestDashboardCloudLeadingSlashCRUD(t *testing.T) {
 
 		// At this point all operations are reading/writing from Minio
 		slog.Info("importing Dashboards")
-		list := apiClient.DownloadDashboards(dashFilter) // Saving to S3
+		list, err := apiClient.DownloadDashboards(dashFilter) // Saving to S3
+		assert.NoError(t, err)
 		assert.Equal(t, len(list), len(boards))
 		slog.Info("deleting Dashboards") // Clearing Grafana
-		deleteList := apiClient.DeleteAllDashboards(dashFilter)
+		deleteList, err := apiClient.DeleteAllDashboards(dashFilter)
+		assert.NoError(t, err)
 		assert.Equal(t, len(list), len(deleteList))
-		boards = apiClient.ListDashboards(dashFilter)
+		boards, err = apiClient.ListDashboards(dashFilter)
+		assert.NoError(t, err)
 		assert.Equal(t, len(boards), 0)
 		// Load Data from S3
 		_, err = apiClient.UploadDashboards(dashFilter) // ReLoad data from S3 backup
 		assert.NoError(t, err)
 
-		boards = apiClient.ListDashboards(dashFilter) // Read data
-		assert.Equal(t, len(list), len(boards))       // verify
+		boards, err = apiClient.ListDashboards(dashFilter) // Read data
+		assert.NoError(t, err)
+		assert.Equal(t, len(list), len(boards)) // verify
 
 		cancel()
 	}
@@ -9,7 +9,7 @@ import (
 
 	configDomain "github.com/esnet/gdg/internal/config/domain"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/pkg/test_tooling/common"
 
@@ -57,19 +57,28 @@ func TestConnectionPermissionsCrud(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	// Upload all connections
-	filtersEntity := service.NewConnectionFilter("")
-	connectionsAdded := apiClient.UploadConnections(filtersEntity)
+	filtersEntity, err := service.NewConnectionFilter("")
+	assert.NoError(t, err)
+	connectionsAdded, err := apiClient.UploadConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(connectionsAdded), 3)
 	// Upload all users
-	newUsers := apiClient.UploadUsers(service.NewUserFilter(""))
+	filter, err := service.NewUserFilter("")
+	assert.NoError(t, err)
+	newUsers, err := apiClient.UploadUsers(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(newUsers), 2)
 	// Upload all teams
-	filter := service.NewTeamFilter("")
-	teams := apiClient.UploadTeams(filter)
+	filter, err = service.NewTeamFilter("")
+	assert.NoError(t, err)
+	teams, err := apiClient.UploadTeams(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(teams), 2)
 	// Get current Permissions
-	permissionFilters := service.NewConnectionFilter("")
-	currentPerms := apiClient.ListConnectionPermissions(permissionFilters)
+	permissionFilters, err := service.NewConnectionFilter("")
+	assert.NoError(t, err)
+	currentPerms, err := apiClient.ListConnectionPermissions(permissionFilters)
+	assert.NoError(t, err)
 	assert.Equal(t, len(currentPerms), 3)
 	var entry *domain.ConnectionPermissionItem
 	for ndx, item := range currentPerms {
@@ -81,9 +90,11 @@ func TestConnectionPermissionsCrud(t *testing.T) {
 	assert.NotNil(t, entry)
 	assert.Equal(t, len(entry.Permissions), 4)
 
-	removed := apiClient.DeleteAllConnectionPermissions(permissionFilters)
+	removed, err := apiClient.DeleteAllConnectionPermissions(permissionFilters)
+	assert.NoError(t, err)
 	assert.Equal(t, len(removed), 3)
-	currentPerms = apiClient.ListConnectionPermissions(permissionFilters)
+	currentPerms, err = apiClient.ListConnectionPermissions(permissionFilters)
+	assert.NoError(t, err)
 	for ndx, item := range currentPerms {
 		if item.Connection.Name == "Google Sheets" {
 			entry = &currentPerms[ndx]
@@ -91,9 +102,11 @@ func TestConnectionPermissionsCrud(t *testing.T) {
 		}
 	}
 	assert.Equal(t, 2, len(entry.Permissions))
-	updated := apiClient.UploadConnectionPermissions(permissionFilters)
+	updated, err := apiClient.UploadConnectionPermissions(permissionFilters)
+	assert.NoError(t, err)
 	assert.Equal(t, 3, len(updated))
-	currentPerms = apiClient.ListConnectionPermissions(permissionFilters)
+	currentPerms, err = apiClient.ListConnectionPermissions(permissionFilters)
+	assert.NoError(t, err)
 	for ndx, item := range currentPerms {
 		if item.Connection.Name == "Google Sheets" {
 			entry = &currentPerms[ndx]
@@ -101,7 +114,8 @@ func TestConnectionPermissionsCrud(t *testing.T) {
 		}
 	}
 	assert.Equal(t, len(entry.Permissions), 7)
-	currentPerms = apiClient.ListConnectionPermissions(permissionFilters)
+	currentPerms, err = apiClient.ListConnectionPermissions(permissionFilters)
+	assert.NoError(t, err)
 	var foundTux, foundBob, foundTeam bool
 	for _, item := range entry.Permissions {
 		if item.UserLogin == "tux" {
@@ -145,11 +159,13 @@ func TestConnectionsCRUD(t *testing.T) {
 		}
 	}()
 	apiClient := r.ApiClient
-	filtersEntity := service.NewConnectionFilter("")
+	filtersEntity, err := service.NewConnectionFilter("")
+	assert.NoError(t, err)
 	slog.Info("Exporting all connections")
 	apiClient.UploadConnections(filtersEntity)
 	slog.Info("Listing all connections")
-	dataSources := apiClient.ListConnections(filtersEntity)
+	dataSources, err := apiClient.ListConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(dataSources), 4)
 	dsItem := lo.FirstOrEmpty(lo.Filter(dataSources, func(item models.DataSourceListItemDTO, index int) bool {
 		return item.Name == "netsage"
@@ -158,13 +174,16 @@ func TestConnectionsCRUD(t *testing.T) {
 	validateConnection(t, dsItem)
 	// Import Dashboards
 	slog.Info("Importing connections")
-	list := apiClient.DownloadConnections(filtersEntity)
+	list, err := apiClient.DownloadConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(dataSources))
 	slog.Info("Deleting connections")
-	deleteList := apiClient.DeleteAllConnections(filtersEntity)
+	deleteList, err := apiClient.DeleteAllConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteList), len(dataSources))
 	slog.Info("List connections again")
-	dataSources = apiClient.ListConnections(filtersEntity)
+	dataSources, err = apiClient.ListConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(dataSources), 0)
 }
 
@@ -202,13 +221,16 @@ func TestConnectionFilter(t *testing.T) {
 	testingContext = cfg.GetContexts()[common.TestContextName]
 
 	localEngine := storage.NewLocalStorage(context.Background())
-	apiClient = service.NewTestApiService(localEngine, cfg)
+	apiClient, err = service.NewTestApiService(localEngine, cfg)
+	assert.NoError(t, err)
 
-	filtersEntity := service.NewConnectionFilter("")
+	filtersEntity, err := service.NewConnectionFilter("")
+	assert.NoError(t, err)
 	slog.Info("Exporting all connections")
 	apiClient.UploadConnections(filtersEntity)
 	slog.Info("Listing all connections")
-	dataSources := apiClient.ListConnections(filtersEntity)
+	dataSources, err := apiClient.ListConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(dataSources), 2)
 	var dsItem *models.DataSourceListItemDTO
 	for _, ds := range dataSources {
@@ -221,13 +243,16 @@ func TestConnectionFilter(t *testing.T) {
 	validateConnection(t, *dsItem)
 	// Import Dashboards
 	slog.Info("Importing connections")
-	list := apiClient.DownloadConnections(filtersEntity)
+	list, err := apiClient.DownloadConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(dataSources))
 	slog.Info("Deleting connections")
-	deleteList := apiClient.DeleteAllConnections(filtersEntity)
+	deleteList, err := apiClient.DeleteAllConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteList), len(dataSources))
 	slog.Info("List connections again")
-	dataSources = apiClient.ListConnections(filtersEntity)
+	dataSources, err = apiClient.ListConnections(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(dataSources), 0)
 }
 
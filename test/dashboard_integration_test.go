@@ -8,7 +8,7 @@ import (
 	"strings"
 	"testing"
 
-	customModels "github.com/esnet/gdg/internal/service/domain"
+	customModels "github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/pkg/test_tooling/common"
 
@@ -45,16 +45,21 @@ func TestDashboardCRUDIgnoreFilters(t *testing.T) {
 		}
 	}()
 	apiClient := r.ApiClient
-	filtersEntity := service.NewDashboardFilter(cfg, "", "", "")
+	filtersEntity, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
 	slog.Info("Exporting all dashboards")
 	uploadedFiles, err := apiClient.UploadDashboards(filtersEntity)
 	assert.NoError(t, err)
 	assert.Equal(t, len(uploadedFiles), DashboardCount)
-	folders := apiClient.ListFolders(service.NewFolderFilter(cfg))
+	filter, err := service.NewFolderFilter(cfg)
+	assert.NoError(t, err)
+	folders, err := apiClient.ListFolders(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), FolderCount)
 
 	slog.Info("Listing all dashboards")
-	boards := apiClient.ListDashboards(filtersEntity)
+	boards, err := apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	slog.Info("Imported dashboards", "count", len(boards), "uploadedFiles", len(uploadedFiles))
 	ignoredSkipped := true
 	var generalBoard *customModels.NestedHit
@@ -78,27 +83,36 @@ func TestDashboardCRUDIgnoreFilters(t *testing.T) {
 	validateOtherBoard(t, otherBoard)
 	// Validate filters
 
-	filterFolder := service.NewDashboardFilter(cfg, "linux%2Fgnu", "", "")
-	boards = apiClient.ListDashboards(filterFolder)
+	filterFolder, err := service.NewDashboardFilter(cfg, "linux%2Fgnu", "", "")
+	assert.NoError(t, err)
+	boards, err = apiClient.ListDashboards(filterFolder)
+	assert.NoError(t, err)
 	assert.Equal(t, 8, len(boards))
 	// With Regex filters
-	filterFolder = service.NewDashboardFilter(cfg, "linux%2Fgnu$", "", "")
-	boards = apiClient.ListDashboards(filterFolder)
+	filterFolder, err = service.NewDashboardFilter(cfg, "linux%2Fgnu$", "", "")
+	assert.NoError(t, err)
+	boards, err = apiClient.ListDashboards(filterFolder)
+	assert.NoError(t, err)
 	assert.Equal(t, 4, len(boards))
 	//
-	dashboardFilter := service.NewDashboardFilter(cfg, "", "flow-information", "")
-	boards = apiClient.ListDashboards(dashboardFilter)
+	dashboardFilter, err := service.NewDashboardFilter(cfg, "", "flow-information", "")
+	assert.NoError(t, err)
+	boards, err = apiClient.ListDashboards(dashboardFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, 1, len(boards))
 
 	// Import Dashboards
 	slog.Info("Importing Dashboards")
-	list := apiClient.DownloadDashboards(filtersEntity)
+	list, err := apiClient.DownloadDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), DashboardCount)
 	slog.Info("Deleting Dashboards")
-	deleteList := apiClient.DeleteAllDashboards(filtersEntity)
+	deleteList, err := apiClient.DeleteAllDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteList), DashboardCount)
 	slog.Info("List Dashboards again")
-	boards = apiClient.ListDashboards(filtersEntity)
+	boards, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(boards), 0)
 }
 
@@ -122,13 +136,15 @@ func TestDashboardCleanUpCrud(t *testing.T) {
 		}
 	}()
 	apiClient := r.ApiClient
-	filtersEntity := service.NewDashboardFilter(cfg, "", "", "")
+	filtersEntity, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
 	slog.Info("Exporting all dashboards")
 	uploadedFiles, err := apiClient.UploadDashboards(filtersEntity)
 	assert.NoError(t, err)
 	assert.Equal(t, len(uploadedFiles), IgnoreDashboardCount)
 	slog.Info("Listing all dashboards")
-	boards := apiClient.ListDashboards(filtersEntity)
+	boards, err := apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(boards), IgnoreDashboardCount) // Includes the Ignored folder
 	// Create another copy of the dashboard json
 	// copy file
@@ -143,7 +159,8 @@ func TestDashboardCleanUpCrud(t *testing.T) {
 	apiClient = test_tooling.CreateSimpleClientWithConfig(t, cfg, r.Container)
 	apiClient.DownloadDashboards(filtersEntity)
 	assert.Nil(t, err)
-	boards = apiClient.ListDashboards(filtersEntity)
+	boards, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(boards), IgnoreDashboardCount) // includes the ignored folder
 }
 
@@ -192,16 +209,22 @@ func TestDashListFilters(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, len(uploadedFiles), tc.expectedCount)
 		// folder test
-		filtersEntity := service.NewDashboardFilter(cfg, "linux%2Fgnu/Ot*", "", "")
-		boards := apiClient.ListDashboards(filtersEntity)
+		filtersEntity, err := service.NewDashboardFilter(cfg, "linux%2Fgnu/Ot*", "", "")
+		assert.NoError(t, err)
+		boards, err := apiClient.ListDashboards(filtersEntity)
+		assert.NoError(t, err)
 		assert.Equal(t, len(boards), 4)
 		//
-		filtersEntity = service.NewDashboardFilter(cfg, "", "", encodeTags("flow"))
-		boards = apiClient.ListDashboards(filtersEntity)
+		filtersEntity, err = service.NewDashboardFilter(cfg, "", "", encodeTags("flow"))
+		assert.NoError(t, err)
+		boards, err = apiClient.ListDashboards(filtersEntity)
+		assert.NoError(t, err)
 		assert.Equal(t, len(boards), 8)
 		// Dash filter
-		filtersEntity = service.NewDashboardFilter(cfg, "", "individual-flows-per-country", "")
-		boards = apiClient.ListDashboards(filtersEntity)
+		filtersEntity, err = service.NewDashboardFilter(cfg, "", "individual-flows-per-country", "")
+		assert.NoError(t, err)
+		boards, err = apiClient.ListDashboards(filtersEntity)
+		assert.NoError(t, err)
 		assert.Equal(t, len(boards), 1)
 		func() {
 			err := r.CleanUp()
@@ -230,7 +253,10 @@ func TestUploadDashboardsBehavior(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	cleanupDash := func(count int) {
-		items := apiClient.DeleteAllDashboards(service.NewDashboardFilter(cfg, "", "", ""))
+		filter, err := service.NewDashboardFilter(cfg, "", "", "")
+		assert.NoError(t, err)
+		items, err := apiClient.DeleteAllDashboards(filter)
+		assert.NoError(t, err)
 		assert.Equal(t, len(items), count)
 	}
 
@@ -245,19 +271,22 @@ func TestUploadDashboardsBehavior(t *testing.T) {
 	assert.Equal(t, len(uploadedFiles), IgnoreDashboardCount)
 	cleanupDash(IgnoreDashboardCount)
 	// Tags filter
-	filtersEntity := service.NewDashboardFilter(cfg, "", "", encodeTags("flow"))
+	filtersEntity, err := service.NewDashboardFilter(cfg, "", "", encodeTags("flow"))
+	assert.NoError(t, err)
 	uploadedFiles, err = apiClient.UploadDashboards(filtersEntity)
 	assert.NoError(t, err)
 	assert.Equal(t, len(uploadedFiles), 8)
 	cleanupDash(len(uploadedFiles))
 	// Dash filter
-	filtersEntity = service.NewDashboardFilter(cfg, "", "individual-flows-per-country", "")
+	filtersEntity, err = service.NewDashboardFilter(cfg, "", "individual-flows-per-country", "")
+	assert.NoError(t, err)
 	uploadedFiles, err = apiClient.UploadDashboards(filtersEntity)
 	assert.NoError(t, err)
 	assert.Equal(t, len(uploadedFiles), 1)
 	cleanupDash(len(uploadedFiles))
 	// folder test
-	filtersEntity = service.NewDashboardFilter(cfg, "linux%2Fgnu/Ot*", "", "")
+	filtersEntity, err = service.NewDashboardFilter(cfg, "linux%2Fgnu/Ot*", "", "")
+	assert.NoError(t, err)
 	uploadedFiles, err = apiClient.UploadDashboards(filtersEntity)
 	assert.NoError(t, err)
 	assert.Equal(t, len(uploadedFiles), 4)
@@ -294,58 +323,71 @@ func TestDashboardCRUDTags(t *testing.T) {
 
 	data, err := json.Marshal([]string{"netsage"})
 	assert.NoError(t, err)
-	filtersEntity := service.NewDashboardFilter(cfg, "", "", string(data))
+	filtersEntity, err := service.NewDashboardFilter(cfg, "", "", string(data))
+	assert.NoError(t, err)
 
 	slog.Info("Uploading all dashboards, filtered by tags")
 	uploadedFiles, err := apiClient.UploadDashboards(filtersEntity)
 	assert.Equal(t, len(uploadedFiles), 13)
 	slog.Info("Listing all dashboards")
-	boards := apiClient.ListDashboards(filtersEntity)
+	boards, err := apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	slog.Info("Removing all dashboards")
 	assert.Equal(t, 13, len(boards))
-	deleteList := apiClient.DeleteAllDashboards(filtersEntity)
+	deleteList, err := apiClient.DeleteAllDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, 13, len(deleteList))
 	// Multiple Tags behavior
 	slog.Info("Uploading all dashboards, filtered by tags")
 	data, err = json.Marshal([]string{"flow"})
 	assert.NoError(t, err)
-	filtersEntity = service.NewDashboardFilter(cfg, "", "", string(data))
+	filtersEntity, err = service.NewDashboardFilter(cfg, "", "", string(data))
+	assert.NoError(t, err)
 	uploadedFiles, err = apiClient.UploadDashboards(filtersEntity)
 	assert.NoError(t, err)
 	assert.Equal(t, len(uploadedFiles), 8)
 	slog.Info("Listing all dashboards")
-	boards = apiClient.ListDashboards(filtersEntity)
+	boards, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, 8, len(boards))
 	slog.Info("Removing all dashboards")
-	deleteList = apiClient.DeleteAllDashboards(filtersEntity)
+	deleteList, err = apiClient.DeleteAllDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, 8, len(deleteList))
 	//
 	os.Setenv("GDG_CONTEXTS__TESTING__IGNORE_FILTERS", "true")
 	defer os.Unsetenv("")
 	apiClient, _ = test_tooling.CreateSimpleClient(t, cfg, nil, r.Container)
-	filterNone := service.NewDashboardFilter(cfg, "", "", "")
+	filterNone, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
 	uploadedFiles, err = apiClient.UploadDashboards(filterNone)
 	assert.NoError(t, err)
 	assert.Equal(t, len(uploadedFiles), DashboardCount)
 	// Listing with no filter
-	boards = apiClient.ListDashboards(filterNone)
+	boards, err = apiClient.ListDashboards(filterNone)
+	assert.NoError(t, err)
 	assert.Equal(t, DashboardCount, len(boards))
 
 	data, err = json.Marshal([]string{"flow"})
 	assert.NoError(t, err)
-	filtersEntity = service.NewDashboardFilter(cfg, "", "", string(data))
+	filtersEntity, err = service.NewDashboardFilter(cfg, "", "", string(data))
+	assert.NoError(t, err)
 
 	slog.Info("Listing dashboards by tag")
-	boards = apiClient.ListDashboards(filtersEntity)
+	boards, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, 8, len(deleteList))
 	// Listing with
 	data, err = json.Marshal([]string{"flow", "netsage"})
 	assert.NoError(t, err)
-	filtersEntity = service.NewDashboardFilter(cfg, "", "", string(data))
+	filtersEntity, err = service.NewDashboardFilter(cfg, "", "", string(data))
+	assert.NoError(t, err)
 
-	boards = apiClient.ListDashboards(filtersEntity)
+	boards, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, 13, len(boards))
-	deleteList = apiClient.DeleteAllDashboards(filtersEntity)
+	deleteList, err = apiClient.DeleteAllDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, 13, len(deleteList))
 }
 
@@ -369,14 +411,16 @@ func TestDashboardTagsFilter(t *testing.T) {
 
 	data, err := json.Marshal([]string{"flow", "netsage"})
 	assert.NoError(t, err)
-	filtersEntity := service.NewDashboardFilter(cfg, "", "", string(data))
+	filtersEntity, err := service.NewDashboardFilter(cfg, "", "", string(data))
+	assert.NoError(t, err)
 
 	slog.Info("Exporting all dashboards")
 	_, err = apiClient.UploadDashboards(emptyFilter)
 	assert.NoError(t, err)
 
 	slog.Info("Listing all dashboards")
-	boards := apiClient.ListDashboards(filtersEntity)
+	boards, err := apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 
 	slog.Info("Filtered Count is", "count", len(boards))
 	for _, board := range boards {
@@ -385,15 +429,18 @@ func TestDashboardTagsFilter(t *testing.T) {
 
 	// Import Dashboards
 	slog.Info("Importing Dashboards")
-	list := apiClient.DownloadDashboards(filtersEntity)
+	list, err := apiClient.DownloadDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(boards))
 
 	slog.Info("Deleting Dashboards")
-	deleteList := apiClient.DeleteAllDashboards(filtersEntity)
+	deleteList, err := apiClient.DeleteAllDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteList), len(boards))
 
 	slog.Info("List Dashboards again")
-	boards = apiClient.ListDashboards(filtersEntity)
+	boards, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(boards), 0)
 }
 
@@ -414,11 +461,13 @@ func TestWildcardFilter(t *testing.T) {
 		}
 	}()
 	apiClient := r.ApiClient
-	emptyFilter := service.NewDashboardFilter(cfg, "", "", "")
+	emptyFilter, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
 
 	data, err := json.Marshal([]string{"flow", "netsage"})
 	assert.NoError(t, err)
-	filtersEntity := service.NewDashboardFilter(cfg, "", "", string(data))
+	filtersEntity, err := service.NewDashboardFilter(cfg, "", "", string(data))
+	assert.NoError(t, err)
 
 	// Enable Wildcard
 	testingContext := cfg.GetContexts()[common.TestContextName]
@@ -428,33 +477,40 @@ func TestWildcardFilter(t *testing.T) {
 	// Testing Exporting with Wildcard
 	_, err = apiClient.UploadDashboards(emptyFilter)
 	assert.NoError(t, err)
-	boards := apiClient.ListDashboards(emptyFilter)
+	boards, err := apiClient.ListDashboards(emptyFilter)
+	assert.NoError(t, err)
 
 	_, err = apiClient.UploadDashboards(filtersEntity)
 	assert.NoError(t, err)
-	boards_filtered := apiClient.ListDashboards(emptyFilter)
+	boards_filtered, err := apiClient.ListDashboards(emptyFilter)
+	assert.NoError(t, err)
 
 	assert.Equal(t, len(boards), len(boards_filtered))
 
 	// Testing Listing with Wildcard
 	slog.Info("Listing all dashboards without filter")
-	boards = apiClient.ListDashboards(emptyFilter)
+	boards, err = apiClient.ListDashboards(emptyFilter)
+	assert.NoError(t, err)
 
 	slog.Info("Listing all dashboards ignoring filter")
-	boards_filtered = apiClient.ListDashboards(filtersEntity)
+	boards_filtered, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 
 	assert.Equal(t, 14, len(boards_filtered))
 
 	slog.Info("Importing Dashboards")
-	list := apiClient.DownloadDashboards(emptyFilter)
+	list, err := apiClient.DownloadDashboards(emptyFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(boards))
 
 	slog.Info("Deleting Dashboards")
-	deleteList := apiClient.DeleteAllDashboards(emptyFilter)
+	deleteList, err := apiClient.DeleteAllDashboards(emptyFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteList), len(boards))
 
 	slog.Info("List Dashboards again")
-	boards = apiClient.ListDashboards(filtersEntity)
+	boards, err = apiClient.ListDashboards(filtersEntity)
+	assert.NoError(t, err)
 	assert.Equal(t, len(boards), 0)
 }
 
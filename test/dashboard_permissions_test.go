@@ -6,7 +6,7 @@ import (
 	"os"
 	"testing"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/internal/config"
 	"github.com/esnet/gdg/pkg/test_tooling/common"
@@ -47,17 +47,25 @@ func TestDashboardPermissionsCrud(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	// Upload all dashboards
-	_, err = apiClient.UploadDashboards(service.NewDashboardFilter(cfg, "", "", ""))
+	filter, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
+	_, err = apiClient.UploadDashboards(filter)
 	assert.NoError(t, err)
 	// Upload all users
-	newUsers := apiClient.UploadUsers(service.NewUserFilter(""))
+	filter, err = service.NewUserFilter("")
+	assert.NoError(t, err)
+	newUsers, err := apiClient.UploadUsers(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(newUsers), 2)
 	// Upload all teams
-	filter := service.NewTeamFilter("")
-	teams := apiClient.UploadTeams(filter)
+	filter, err = service.NewTeamFilter("")
+	assert.NoError(t, err)
+	teams, err := apiClient.UploadTeams(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(teams), 2)
 	// Get current Permissions
-	dashFilter := service.NewDashboardFilter(cfg, "", "", "")
+	dashFilter, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
 	currentPerms, err := apiClient.ListDashboardPermissions(dashFilter)
 	assert.Equal(t, len(currentPerms), DashboardCount)
 	entry := ptr.Of(lo.FirstOrEmpty(lo.Filter(currentPerms, func(item domain.DashboardAndPermissions, index int) bool {
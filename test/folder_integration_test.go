@@ -10,7 +10,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/esnet/gdg/internal/service/domain"
+	"github.com/esnet/gdg/pkg/service/domain"
 	"github.com/esnet/gdg/pkg/test_tooling/containers"
 
 	"github.com/esnet/gdg/pkg/test_tooling/common"
@@ -48,7 +48,8 @@ func TestFolderCRUD(t *testing.T) {
 	slog.Info("Exporting all folders")
 	apiClient.UploadFolders(nil)
 	slog.Info("Listing all Folders")
-	folders := apiClient.ListFolders(nil)
+	folders, err := apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 4)
 	firstDsItem := lo.FindOrElse(folders, nil, func(item *domain.NestedHit) bool {
 		return item.Title == "Ignored"
@@ -62,13 +63,16 @@ func TestFolderCRUD(t *testing.T) {
 	assert.Equal(t, secondDsItem.FolderTitle, "linux/gnu")
 	// Import Folders
 	slog.Info("importing folders")
-	list := apiClient.DownloadFolders(nil)
+	list, err := apiClient.DownloadFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(folders))
 	slog.Info("Deleting Folders")
-	deleteList := apiClient.DeleteAllFolders(nil)
+	deleteList, err := apiClient.DeleteAllFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteList), len(folders))
 	slog.Info("List Folders again")
-	folders = apiClient.ListFolders(nil)
+	folders, err = apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 0)
 }
 
@@ -91,12 +95,14 @@ func TestFolderSanityCheck(t *testing.T) {
 	newFolders, err := apiClient.(*service.DashNGoImpl).TestCreatedFolders("linux%2Fgnu/Others")
 	assert.NoError(t, err)
 	assert.Equal(t, len(newFolders), 2)
-	folders := apiClient.ListFolders(nil)
+	folders, err := apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 2)
 	newFolders, err = apiClient.(*service.DashNGoImpl).TestCreatedFolders("linux%2Fgnu/Others/n%2B_%3D23r")
 	assert.NoError(t, err)
 	assert.Equal(t, len(newFolders), 1)
-	folders = apiClient.ListFolders(nil)
+	folders, err = apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 3)
 }
 
@@ -120,9 +126,11 @@ func TestFolderPermissions(t *testing.T) {
 	slog.Info("Exporting all folders")
 	apiClient.UploadFolders(nil)
 	slog.Info("Listing all Folders")
-	folders := apiClient.ListFolders(nil)
+	folders, err := apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 4)
-	result := apiClient.ListFolderPermissions(nil)
+	result, err := apiClient.ListFolderPermissions(nil)
+	assert.NoError(t, err)
 	assert.True(t, len(result) > 0)
 	// this behavior is inconsistent across versions, disabled for now
 	/*
@@ -137,7 +145,8 @@ func TestFolderPermissions(t *testing.T) {
 
 	*/
 
-	data := apiClient.DownloadFolderPermissions(nil)
+	data, err := apiClient.DownloadFolderPermissions(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(data), 4)
 	permissionKeys := lo.Map(slices.Collect(maps.Keys(result)), func(item *domain.NestedHit, index int) string {
 		return fmt.Sprintf("test/data/org_main-org/folders-permissions/%s.json", slug.Make(item.NestedPath))
@@ -165,9 +174,11 @@ func TestFolderNestedPermissions(t *testing.T) {
 	slog.Info("Exporting all folders")
 	apiClient.UploadFolders(nil)
 	slog.Info("Listing all Folders")
-	folders := apiClient.ListFolders(nil)
+	folders, err := apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 4)
-	result := apiClient.ListFolderPermissions(nil)
+	result, err := apiClient.ListFolderPermissions(nil)
+	assert.NoError(t, err)
 	assert.True(t, len(result) > 0)
 	grafanaVersion := getGrafanaVersion(":" + containers.GetGrafanaVersion())
 
@@ -185,7 +196,8 @@ func TestFolderNestedPermissions(t *testing.T) {
 		}
 	}
 
-	data := apiClient.DownloadFolderPermissions(nil)
+	data, err := apiClient.DownloadFolderPermissions(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(data), 4)
 	permissionKeys := lo.Map(slices.Collect(maps.Keys(result)), func(item *domain.NestedHit, index int) string {
 		return fmt.Sprintf("test/data/org_testing/folders-permissions/%s.json", slug.Make(item.NestedPath))
@@ -214,7 +226,8 @@ func TestFolderNestedCRUD(t *testing.T) {
 	slog.Info("Exporting all folders")
 	apiClient.UploadFolders(nil)
 	slog.Info("Listing all Folders")
-	folders := apiClient.ListFolders(nil)
+	folders, err := apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 4)
 	firstDsItem := lo.FirstOrEmpty(lo.Filter(folders, func(item *domain.NestedHit, index int) bool {
 		return item.NestedPath == "Others/dummy"
@@ -229,12 +242,15 @@ func TestFolderNestedCRUD(t *testing.T) {
 	assert.Equal(t, secondDsItem.FolderTitle, "")
 	// Import Folders
 	slog.Info("Importing folders")
-	list := apiClient.DownloadFolders(nil)
+	list, err := apiClient.DownloadFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(folders))
 	slog.Info("Deleting Folders")
-	deleteList := apiClient.DeleteAllFolders(nil)
+	deleteList, err := apiClient.DeleteAllFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(deleteList), len(folders))
 	slog.Info("List Folders again")
-	folders = apiClient.ListFolders(nil)
+	folders, err = apiClient.ListFolders(nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(folders), 0)
 }
@@ -5,7 +5,7 @@ import (
 	"log/slog"
 	"testing"
 
-	customModels "github.com/esnet/gdg/internal/service/domain"
+	customModels "github.com/esnet/gdg/pkg/service/domain"
 
 	"github.com/esnet/gdg/pkg/test_tooling/common"
 
@@ -56,13 +56,17 @@ func TestLibraryElementsCRUD(t *testing.T) {
 		assert.NoError(t, err)
 		apiClient = r.ApiClient
 		cleanup = r.CleanUp
-		filtersEntity := service.NewLibraryElementFilter(cfg)
-		dashFilter := service.NewDashboardFilter(cfg, "", "", "")
+		filtersEntity, err := service.NewLibraryElementFilter(cfg)
+		assert.NoError(t, err)
+		dashFilter, err := service.NewDashboardFilter(cfg, "", "", "")
+		assert.NoError(t, err)
 		slog.Info("Exporting all Library Elements")
-		uploadCount := apiClient.UploadLibraryElements(filtersEntity)
+		uploadCount, err := apiClient.UploadLibraryElements(filtersEntity)
+		assert.NoError(t, err)
 		assert.Equal(t, len(uploadCount), tc.expectedCount)
 		slog.Info("Listing all library elements")
-		boards := apiClient.ListLibraryElements(filtersEntity)
+		boards, err := apiClient.ListLibraryElements(filtersEntity)
+		assert.NoError(t, err)
 		slog.Info("Imported library elements", "count", len(boards))
 		assert.Equal(t, len(boards), tc.expectedCount)
 		var generalBoard *customModels.WithNested[models.LibraryElementDTO]
@@ -103,13 +107,15 @@ func TestLibraryElementsCRUD(t *testing.T) {
 
 		// Import Library Elements
 		slog.Info("Importing Library Elements")
-		list := apiClient.DownloadLibraryElements(filtersEntity)
+		list, err := apiClient.DownloadLibraryElements(filtersEntity)
+		assert.NoError(t, err)
 		assert.Equal(t, len(list), len(boards))
 		// Export all Dashboards
 		_, dashErr := apiClient.UploadDashboards(dashFilter)
 		assert.NoError(t, dashErr)
 		// List connection
-		connections := apiClient.ListLibraryElementsConnections(filtersEntity, "T47RSwQnz")
+		connections, err := apiClient.ListLibraryElementsConnections(filtersEntity, "T47RSwQnz")
+		assert.NoError(t, err)
 		assert.Equal(t, len(connections), 1)
 		connection := connections[0]
 
@@ -122,10 +128,12 @@ func TestLibraryElementsCRUD(t *testing.T) {
 		// Delete All Dashboards
 		apiClient.DeleteAllDashboards(dashFilter)
 		slog.Info("Deleting Library Elements")
-		deleteList := apiClient.DeleteAllLibraryElements(filtersEntity)
+		deleteList, err := apiClient.DeleteAllLibraryElements(filtersEntity)
+		assert.NoError(t, err)
 		assert.Equal(t, len(deleteList), len(boards))
 		slog.Info("List Dashboards again")
-		boards = apiClient.ListLibraryElements(filtersEntity)
+		boards, err = apiClient.ListLibraryElements(filtersEntity)
+		assert.NoError(t, err)
 		assert.Equal(t, len(boards), 0)
 
 		assert.NoError(t, cleanup(), "Failed to cleanup container for test"+tc.name)
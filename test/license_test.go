@@ -48,7 +48,9 @@ func TestLicenseEnterpriseCheck(t *testing.T) {
 		})
 		assert.NotNil(t, r)
 		assert.NoError(t, err)
-		assert.Equal(t, r.ApiClient.IsEnterprise(), tc.enterprise)
+		isEnterprise, err := r.ApiClient.IsEnterprise()
+		assert.NoError(t, err)
+		assert.Equal(t, isEnterprise, tc.enterprise)
 		func() {
 			err := r.CleanUp()
 			if err != nil {
@@ -39,18 +39,27 @@ func TestOrganizationCrud(t *testing.T) {
 		}
 	}()
 	apiClient := r.ApiClient
-	orgs := apiClient.ListOrganizations(service.NewOrganizationFilter(), true)
+	filter, err := service.NewOrganizationFilter()
+	assert.NoError(t, err)
+	orgs, err := apiClient.ListOrganizations(filter, true)
+	assert.NoError(t, err)
 	assert.Equal(t, len(orgs), 1)
 	mainOrg := orgs[0]
 	assert.Equal(t, mainOrg.Organization.ID, int64(1))
 	assert.Equal(t, mainOrg.Organization.Name, "Main Org.")
-	newOrgs := apiClient.UploadOrganizations(service.NewOrganizationFilter())
+	filter, err = service.NewOrganizationFilter()
+	assert.NoError(t, err)
+	newOrgs, err := apiClient.UploadOrganizations(filter, nil)
+	assert.NoError(t, err)
 	assert.Equal(t, len(newOrgs), 4)
 	assert.True(t, slices.Contains(newOrgs, "DumbDumb"))
 	assert.True(t, slices.Contains(newOrgs, "Moo"))
 	assert.True(t, slices.Contains(newOrgs, "testing"))
 	// Filter Org
-	orgs = apiClient.ListOrganizations(service.NewOrganizationFilter("DumbDumb"), true)
+	filter, err = service.NewOrganizationFilter("DumbDumb")
+	assert.NoError(t, err)
+	orgs, err = apiClient.ListOrganizations(filter, true)
+	assert.NoError(t, err)
 	assert.Equal(t, len(orgs), 1)
 	assert.Equal(t, orgs[0].Organization.Name, "DumbDumb")
 }
@@ -75,16 +84,26 @@ func TestOrganizationUserMembership(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	// Create Orgs in case they aren't already present.
-	apiClient.UploadOrganizations(service.NewOrganizationFilter())
-	orgs := apiClient.ListOrganizations(service.NewOrganizationFilter(), true)
+	filter, err := service.NewOrganizationFilter()
+	assert.NoError(t, err)
+	apiClient.UploadOrganizations(filter, nil)
+	filter, err = service.NewOrganizationFilter()
+	assert.NoError(t, err)
+	orgs, err := apiClient.ListOrganizations(filter, true)
+	assert.NoError(t, err)
 	sort.Slice(orgs, func(a, b int) bool {
 		return orgs[a].Organization.ID < orgs[b].Organization.ID
 	})
 	newOrg := orgs[2]
 	// Create Users in case they aren't already present.
-	apiClient.UploadUsers(service.NewUserFilter(""))
+	filter, err = service.NewUserFilter("")
+	assert.NoError(t, err)
+	apiClient.UploadUsers(filter)
 	// get users
-	users := apiClient.ListUsers(service.NewUserFilter(""))
+	filter, err = service.NewUserFilter("")
+	assert.NoError(t, err)
+	users, err := apiClient.ListUsers(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(users), 3)
 	var orgUser *models.UserSearchHitDTO
 	for _, u := range users {
@@ -98,22 +117,26 @@ func TestOrganizationUserMembership(t *testing.T) {
 	err = apiClient.DeleteUserFromOrg(slug.Make(newOrg.Organization.Name), orgUser.ID)
 	assert.Nil(t, err)
 	// Start CRUD test
-	orgUsers := apiClient.ListOrgUsers(newOrg.Organization.ID)
+	orgUsers, err := apiClient.ListOrgUsers(newOrg.Organization.ID)
+	assert.NoError(t, err)
 	assert.Equal(t, len(orgUsers), 1)
 	assert.Equal(t, orgUsers[0].Login, "admin")
 	assert.Equal(t, orgUsers[0].Role, "Admin")
 
 	err = apiClient.AddUserToOrg("Admin", slug.Make(newOrg.Organization.Name), orgUser.ID)
 	assert.Nil(t, err)
-	orgUsers = apiClient.ListOrgUsers(newOrg.Organization.ID)
+	orgUsers, err = apiClient.ListOrgUsers(newOrg.Organization.ID)
+	assert.NoError(t, err)
 	assert.Equal(t, len(orgUsers), 2)
 	assert.Equal(t, orgUsers[1].Role, "Admin")
 	err = apiClient.UpdateUserInOrg("Viewer", slug.Make(newOrg.Organization.Name), orgUser.ID)
-	orgUsers = apiClient.ListOrgUsers(newOrg.Organization.ID)
+	orgUsers, err = apiClient.ListOrgUsers(newOrg.Organization.ID)
+	assert.NoError(t, err)
 	assert.Nil(t, err)
 	assert.Equal(t, orgUsers[1].Role, "Viewer")
 	err = apiClient.DeleteUserFromOrg(slug.Make(newOrg.Organization.Name), orgUser.ID)
-	orgUsers = apiClient.ListOrgUsers(newOrg.Organization.ID)
+	orgUsers, err = apiClient.ListOrgUsers(newOrg.Organization.ID)
+	assert.NoError(t, err)
 	assert.Equal(t, len(orgUsers), 1)
 	assert.Nil(t, err)
 }
@@ -137,7 +160,9 @@ func TestOrganizationProperties(t *testing.T) {
 		}
 	}()
 	apiClient := r.ApiClient
-	_, err = apiClient.UploadDashboards(service.NewDashboardFilter(cfg, "", "", ""))
+	filter, err := service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
+	_, err = apiClient.UploadDashboards(filter)
 	assert.NoError(t, err)
 	prefs, err := apiClient.GetOrgPreferences("Main Org.")
 	assert.Nil(t, err)
@@ -152,5 +177,7 @@ func TestOrganizationProperties(t *testing.T) {
 	assert.Equal(t, prefs.Theme, "dark")
 	assert.Equal(t, prefs.WeekStart, "Saturday")
 	assert.Equal(t, prefs.HomeDashboardUID, "000000003")
-	apiClient.DeleteAllDashboards(service.NewDashboardFilter(cfg, "", "", ""))
+	filter, err = service.NewDashboardFilter(cfg, "", "", "")
+	assert.NoError(t, err)
+	apiClient.DeleteAllDashboards(filter)
 }
@@ -34,12 +34,15 @@ func TestServerInfo(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	assert := assert.New(t)
-	res := apiClient.GetServerInfo()
+	res, err := apiClient.GetServerInfo()
+	assert.NoError(err)
 	assert.NotNil(res)
 	assert.NotEmpty(res[service.SrvInfoDBKey])
 	assert.NotEmpty(res[service.SrvInfoVersionKey])
 	assert.NotEmpty(res[service.SrvInfoCommitKey])
-	if apiClient.IsEnterprise() {
+	isEnterprise, err := apiClient.IsEnterprise()
+	assert.NoError(err)
+	if isEnterprise {
 		assert.NotEmpty(res[service.SrvInfoEnterpriseCommitKey])
 	} else {
 		assert.Empty(res[service.SrvInfoEnterpriseCommitKey])
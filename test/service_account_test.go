@@ -41,7 +41,8 @@ func TestServiceAccountCrud(t *testing.T) {
 	assert.Equal(t, account.Name, name)
 	// another svc
 	_, err = apiClient.CreateServiceAccount(gofakeit.Name(), "admin", 0)
-	serviceAccounts := apiClient.ListServiceAccounts()
+	serviceAccounts, err := apiClient.ListServiceAccounts()
+	assert.NoError(t, err)
 	assert.Equal(t, len(serviceAccounts), 2)
 	tokenName := gofakeit.Name()
 	token, err := apiClient.CreateServiceAccountToken(account.ID, tokenName, 0)
@@ -53,10 +54,13 @@ func TestServiceAccountCrud(t *testing.T) {
 	assert.Equal(t, len(tokens), 1)
 	assert.Equal(t, tokens[0].Name, tokenName)
 	assert.NoError(t, apiClient.DeleteServiceAccount(account.ID))
-	serviceAccounts = apiClient.ListServiceAccounts()
+	serviceAccounts, err = apiClient.ListServiceAccounts()
+	assert.NoError(t, err)
 	assert.Equal(t, len(serviceAccounts), 1)
-	names := apiClient.DeleteAllServiceAccounts()
+	names, err := apiClient.DeleteAllServiceAccounts()
+	assert.NoError(t, err)
 	assert.Equal(t, len(names), 1)
-	serviceAccounts = apiClient.ListServiceAccounts()
+	serviceAccounts, err = apiClient.ListServiceAccounts()
+	assert.NoError(t, err)
 	assert.Equal(t, len(serviceAccounts), 0)
 }
@@ -38,15 +38,22 @@ func TestTeamCRUD(t *testing.T) {
 		}
 	}()
 	apiClient := r.ApiClient
-	filter := service.NewTeamFilter("")
+	filter, err := service.NewTeamFilter("")
+	assert.NoError(t, err)
 	slog.Info("Exporting current user list")
-	apiClient.UploadUsers(service.NewUserFilter(""))
-	users := apiClient.ListUsers(service.NewUserFilter(""))
+	filter, err = service.NewUserFilter("")
+	assert.NoError(t, err)
+	apiClient.UploadUsers(filter)
+	filter, err = service.NewUserFilter("")
+	assert.NoError(t, err)
+	users, err := apiClient.ListUsers(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(users), 3)
 	slog.Info("Exporting all teams")
 	apiClient.UploadTeams(filter)
 	slog.Info("Listing all Teams")
-	teamsMap := apiClient.ListTeams(filter)
+	teamsMap, err := apiClient.ListTeams(filter)
+	assert.NoError(t, err)
 	teams := maps.Keys(teamsMap)
 	assert.Equal(t, len(teams), 2)
 	var engineerTeam *models.TeamDTO
@@ -66,7 +73,8 @@ func TestTeamCRUD(t *testing.T) {
 	assert.Equal(t, ptr.ValueOrDefault(musicianTeam.Name, ""), "musicians")
 	// Import Teams
 	slog.Info("Importing teams")
-	list := apiClient.DownloadTeams(filter)
+	list, err := apiClient.DownloadTeams(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(list), len(teams))
 	for _, members := range list {
 		assert.True(t, len(members) > 0)
@@ -76,5 +84,7 @@ func TestTeamCRUD(t *testing.T) {
 	_, err = apiClient.DeleteTeam(filter)
 	assert.Nil(t, err)
 	// Remove Users
-	apiClient.DeleteAllUsers(service.NewUserFilter(""))
+	filter, err = service.NewUserFilter("")
+	assert.NoError(t, err)
+	apiClient.DeleteAllUsers(filter)
 }
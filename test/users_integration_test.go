@@ -21,10 +21,11 @@ func TestUsers(t *testing.T) {
 	if os.Getenv(test_tooling.EnableTokenTestsEnv) == test_tooling.FeatureEnabled {
 		t.Skip("Skipping Token configuration, Team and User CRUD requires Basic SecureData")
 	}
-	userFilter := service.NewUserFilter("")
+	userFilter, err := service.NewUserFilter("")
+	assert.NoError(t, err)
 	cfg := config.InitGdgConfig(common.DefaultTestConfig)
 	var r *test_tooling.InitContainerResult
-	err := Retry(context.Background(), DefaultRetryAttempts, func() error {
+	err = Retry(context.Background(), DefaultRetryAttempts, func() error {
 		r = test_tooling.InitTest(t, cfg, nil)
 		return r.Err
 	})
@@ -38,21 +39,27 @@ func TestUsers(t *testing.T) {
 	}()
 	apiClient := r.ApiClient
 	apiClient.DeleteAllUsers(userFilter) // clear any previous state
-	users := apiClient.ListUsers(userFilter)
+	users, err := apiClient.ListUsers(userFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(users), 1)
 	adminUser := users[0]
 	assert.Equal(t, adminUser.ID, int64(1))
 	assert.Equal(t, adminUser.Login, "admin")
 	assert.Equal(t, adminUser.IsAdmin, true)
 	// Only upload users matching filter
-	newUsers := apiClient.UploadUsers(service.NewUserFilter("foobar"))
+	filter, err := service.NewUserFilter("foobar")
+	assert.NoError(t, err)
+	newUsers, err := apiClient.UploadUsers(filter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(newUsers), 1)
 	assert.Equal(t, newUsers[0].Email, "s@s.com")
 	// upload remaining user that do not already exist
-	newUsers = apiClient.UploadUsers(userFilter)
+	newUsers, err = apiClient.UploadUsers(userFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(newUsers), 1)
 	assert.Equal(t, newUsers[0].Email, "bob@aol.com")
-	users = apiClient.ListUsers(userFilter)
+	users, err = apiClient.ListUsers(userFilter)
+	assert.NoError(t, err)
 	assert.Equal(t, len(users), 3)
 	var user *models.UserSearchHitDTO
 	user = lo.FirstOrEmpty(lo.Filter(users, func(userItem *models.UserSearchHitDTO, index int) bool {